@@ -0,0 +1,275 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package asm
+
+import (
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/andreas-jonsson/spirv"
+
+	// The extended instruction sets are needed to look up their
+	// instructions by name.
+	_ "github.com/andreas-jonsson/spirv/glslstd450"
+	_ "github.com/andreas-jonsson/spirv/openclstd"
+)
+
+// Read assembles the text read from r into a module.
+func Read(r io.Reader) (*spirv.Module, error) {
+	src, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(string(src))
+}
+
+// Parse assembles the given text into a module. The version and generator
+// are read from the "; Version:" and "; Generator:" header comments, as
+// written by package disasm. Without them, the module targets the latest
+// version of the specification supported by package spirv. Its Id bound
+// is set to one past the highest Id it uses.
+func Parse(src string) (*spirv.Module, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	mod := spirv.NewModule()
+	err = header(src, &mod.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	a := newAssembler(tokens)
+	for len(a.tokens) > 0 {
+		instr, err := a.instruction()
+		if err != nil {
+			return nil, err
+		}
+
+		mod.Code = append(mod.Code, instr)
+	}
+
+	mod.Header.Bound = uint32(a.bound)
+	return mod, nil
+}
+
+// assembler holds the state needed to assemble a single module.
+type assembler struct {
+	tokens []token
+
+	ids   map[string]spirv.Id // Named Ids.
+	used  map[spirv.Id]bool   // Numeric Ids.
+	next  spirv.Id            // Candidate for the next named Id.
+	bound spirv.Id
+
+	types  map[spirv.Id]scalar   // Integer and float types.
+	values map[spirv.Id]spirv.Id // Result Id -> ResultType.
+	sets   map[spirv.Id]string   // Imported extended instruction sets.
+}
+
+func newAssembler(tokens []token) *assembler {
+	a := &assembler{
+		tokens: tokens,
+		ids:    make(map[string]spirv.Id),
+		used:   make(map[spirv.Id]bool),
+		next:   1,
+		bound:  1,
+		types:  make(map[spirv.Id]scalar),
+		values: make(map[spirv.Id]spirv.Id),
+		sets:   make(map[spirv.Id]string),
+	}
+
+	// Named Ids must not take the numbers of numeric ones.
+	for _, tok := range tokens {
+		if id, err := strconv.ParseUint(tok.text, 10, 32); tok.kind == tokenId && err == nil {
+			a.used[spirv.Id(id)] = true
+		}
+	}
+
+	return a
+}
+
+// id returns the Id referred to by the given token.
+func (a *assembler) id(tok *token) (spirv.Id, error) {
+	if tok.kind != tokenId {
+		return 0, tok.errorf("expected Id; have %s %q", tok.kind, tok.text)
+	}
+
+	id, ok := a.ids[tok.text]
+	if !ok {
+		if n, err := strconv.ParseUint(tok.text, 10, 32); err == nil {
+			if n == 0 {
+				return 0, tok.errorf("invalid Id %%0")
+			}
+			id = spirv.Id(n)
+		} else {
+			for a.used[a.next] {
+				a.next++
+			}
+			id = a.next
+			a.used[id] = true
+		}
+
+		a.ids[tok.text] = id
+	}
+
+	if id >= a.bound {
+		a.bound = id + 1
+	}
+
+	return id, nil
+}
+
+// instruction assembles the next instruction.
+func (a *assembler) instruction() (spirv.Instruction, error) {
+	var result *token
+
+	if len(a.tokens) > 1 && a.tokens[0].kind == tokenId && a.tokens[1].kind == tokenEquals {
+		result = &a.tokens[0]
+		a.tokens = a.tokens[2:]
+
+		if len(a.tokens) == 0 {
+			return nil, result.errorf("missing opcode after %%%s =", result.text)
+		}
+	}
+
+	op := &a.tokens[0]
+	if !op.isOpcode() {
+		return nil, op.errorf("expected opcode; have %s %q", op.kind, op.text)
+	}
+
+	// The operands run up to the start of the next instruction.
+	n := 1
+	for n < len(a.tokens) {
+		tok := &a.tokens[n]
+		if tok.isOpcode() || tok.kind == tokenId && n+1 < len(a.tokens) && a.tokens[n+1].kind == tokenEquals {
+			break
+		}
+		n++
+	}
+
+	p := &operands{tokens: a.tokens[1:n], end: op}
+	a.tokens = a.tokens[n:]
+
+	fun, ok := lookupOpcode(op.text)
+	if !ok {
+		return nil, op.errorf("unknown instruction %s", op.text)
+	}
+
+	instr := fun()
+	rv := reflect.Indirect(reflect.ValueOf(instr))
+	rt := rv.Type()
+
+	field := rv.FieldByName("ResultId")
+	switch {
+	case field.IsValid() && result == nil:
+		return nil, op.errorf("%s must be assigned to an Id", op.text)
+	case !field.IsValid() && result != nil:
+		return nil, result.errorf("%s does not define an Id", op.text)
+	case field.IsValid():
+		id, err := a.id(result)
+		if err != nil {
+			return nil, err
+		}
+		field.SetUint(uint64(id))
+	}
+
+	for i := 0; i < rv.NumField(); i++ {
+		ft := rt.Field(i)
+		if ft.Name == "ResultId" {
+			continue
+		}
+
+		// Optional operands may be left out.
+		if !p.more() && ft.Tag.Get("spirv") == "optional" {
+			continue
+		}
+
+		err := a.special(p, instr, ft.Name)
+		if err == errNotSpecial {
+			err = a.value(p, rv.Field(i), ft.Name)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.more() {
+		return nil, p.peek().errorf("%s: unexpected operand %q", op.text, p.peek().text)
+	}
+
+	a.record(instr)
+	return instr, nil
+}
+
+// record remembers the details of the given instruction, which later
+// instructions need to read their operands.
+func (a *assembler) record(instr spirv.Instruction) {
+	switch v := instr.(type) {
+	case *spirv.OpTypeInt:
+		a.types[v.ResultId] = scalar{width: v.Width, signed: v.Signedness != 0}
+	case *spirv.OpTypeFloat:
+		a.types[v.ResultId] = scalar{width: v.Width, float: true}
+	case *spirv.OpExtInstImport:
+		a.sets[v.ResultId] = string(v.Name)
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(instr))
+	rid, typ := rv.FieldByName("ResultId"), rv.FieldByName("ResultType")
+	if rid.IsValid() && typ.IsValid() {
+		a.values[spirv.Id(rid.Uint())] = spirv.Id(typ.Uint())
+	}
+}
+
+// operands holds the operand tokens of a single instruction.
+type operands struct {
+	tokens []token
+	end    *token // Where missing operands are reported.
+}
+
+func (p *operands) more() bool {
+	return len(p.tokens) > 0
+}
+
+func (p *operands) peek() *token {
+	return &p.tokens[0]
+}
+
+// next returns the next operand. Returns an error if there is none.
+func (p *operands) next(field string) (*token, error) {
+	if len(p.tokens) == 0 {
+		return nil, p.end.errorf("%s: missing operand %s", p.end.text, field)
+	}
+
+	tok := &p.tokens[0]
+	p.tokens = p.tokens[1:]
+	return tok, nil
+}
+
+var (
+	opcodesOnce sync.Once
+	opcodes     map[string]spirv.InstructionFunc
+)
+
+// lookupOpcode returns the constructor for the instruction with the
+// given name.
+func lookupOpcode(name string) (spirv.InstructionFunc, bool) {
+	opcodesOnce.Do(func() {
+		opcodes = make(map[string]spirv.InstructionFunc)
+
+		for _, opcode := range spirv.Opcodes() {
+			fun, _ := spirv.Lookup(uint32(opcode))
+			opcodes[reflect.Indirect(reflect.ValueOf(fun())).Type().Name()] = fun
+		}
+	})
+
+	fun, ok := opcodes[name]
+	return fun, ok
+}
@@ -0,0 +1,243 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package asm
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/andreas-jonsson/spirv"
+	"github.com/andreas-jonsson/spirv/disasm"
+)
+
+const testSource = `
+; A fragment shader.
+               OpCapability Shader
+          %1 = OpExtInstImport "GLSL.std.450"
+               OpMemoryModel Logical GLSL450
+               OpEntryPoint Fragment %main "main" %color
+               OpExecutionMode %main OriginUpperLeft
+               OpSource GLSL 450
+               OpName %main "main"
+               OpName %color "color"
+               OpDecorate %color Location 0
+               OpDecorate %color LinkageAttributes "a \"b\"" Export
+       %void = OpTypeVoid
+          %3 = OpTypeFunction %void
+      %float = OpTypeFloat 32
+     %double = OpTypeFloat 64
+       %half = OpTypeFloat 16
+        %int = OpTypeInt 32 1
+      %ulong = OpTypeInt 64 0
+    %v4float = OpTypeVector %float 4
+%_ptr_Output_v4float = OpTypePointer Output %v4float
+ %float_n0_5 = OpConstant %float -0.5
+%float_0x1_8p_128 = OpConstant %float 0x1.8p+128
+ %double_1_5 = OpConstant %double 1.5
+     %half_1 = OpConstant %half 1
+     %int_n1 = OpConstant %int -1
+%ulong_4294967296 = OpConstant %ulong 4294967296
+      %color = OpVariable %_ptr_Output_v4float Output
+       %main = OpFunction %void None %3
+         %16 = OpLabel
+         %17 = OpExtInst %float %1 FAbs %float_n0_5
+         %18 = OpCompositeConstruct %v4float %17 %17 %17 %float_n0_5
+               OpStore %color %18 Volatile|Aligned 16
+               OpSelectionMerge %19 None
+               OpSwitch %int_n1 %19 -1 %20 7 %19
+         %20 = OpLabel
+               OpBranch %19
+         %19 = OpLabel
+               OpReturn
+               OpFunctionEnd
+`
+
+func TestParse(t *testing.T) {
+	mod, err := Parse(testSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mod.Header.Bound != 24 {
+		t.Fatalf("bound mismatch: Have %d, want 24", mod.Header.Bound)
+	}
+
+	for _, want := range []spirv.Instruction{
+		&spirv.OpEntryPoint{ExecutionModel: spirv.ExecutionModelFragment, EntryPoint: 2, Name: "main", Interface: []spirv.Id{4}},
+		&spirv.OpDecorate{Target: 4, Decoration: spirv.DecorationLinkageAttributes, Argv: []uint32{0x62222061, 0x22, spirv.LinkageTypeExport}},
+		&spirv.OpConstant{ResultType: 6, ResultId: 13, Value: []uint32{0xbf000000}},
+		&spirv.OpConstant{ResultType: 6, ResultId: 14, Value: []uint32{0x7fc00000}},
+		&spirv.OpConstant{ResultType: 7, ResultId: 15, Value: []uint32{0, 0x3ff80000}},
+		&spirv.OpConstant{ResultType: 8, ResultId: 21, Value: []uint32{0x3c00}},
+		&spirv.OpConstant{ResultType: 9, ResultId: 22, Value: []uint32{0xffffffff}},
+		&spirv.OpConstant{ResultType: 10, ResultId: 23, Value: []uint32{0, 1}},
+		&spirv.OpExtInst{ResultType: 6, ResultId: 17, Set: 1, Instruction: 4, Operands: []spirv.Id{13}},
+		&spirv.OpSwitch{Selector: 22, Default: 19, Target: []uint32{0xffffffff, 20, 7, 19}},
+	} {
+		var found bool
+		for _, have := range mod.Code {
+			if reflect.DeepEqual(have, want) {
+				found = true
+			}
+		}
+
+		if !found {
+			t.Fatalf("instruction not found: %#v", want)
+		}
+	}
+
+	// The memory access operands follow the mask.
+	store := mod.Code.First((&spirv.OpStore{}).Opcode())
+	if have := store.(*spirv.OpStore); *have.MemoryAccess != spirv.MemoryAccessAligned|spirv.MemoryAccessVolatile || !reflect.DeepEqual(have.Argv, []uint32{16}) {
+		t.Fatalf("memory access mismatch: %v %v", *have.MemoryAccess, have.Argv)
+	}
+
+	// Disassembling the module yields the same text.
+	var buf bytes.Buffer
+	err = disasm.WriteWithOptions(&buf, mod, disasm.Options{NoHeader: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := strings.Join(strings.Split(testSource, "\n")[2:], "\n")
+	if have := buf.String(); have != want {
+		t.Fatalf("disassembly mismatch:\nHave:\n%s\nWant:\n%s", have, want)
+	}
+}
+
+func TestParseImage(t *testing.T) {
+	// Some enumerants start with a digit, and are lexed as numbers.
+	mod, err := Parse("%float = OpTypeFloat 32\n%image = OpTypeImage %float 2D 0 0 0 1 Unknown\n%cube = OpTypeImage %float 3 0 0 0 1 Unknown")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := spirv.InstructionList{
+		&spirv.OpTypeFloat{ResultId: 1, Width: 32},
+		&spirv.OpTypeImage{ResultId: 2, SampledType: 1, Dim: spirv.Dim2D, Sampled: 1, ImageFormat: spirv.ImageFormatUnknown},
+		&spirv.OpTypeImage{ResultId: 3, SampledType: 1, Dim: spirv.DimCube, Sampled: 1, ImageFormat: spirv.ImageFormatUnknown},
+	}
+
+	if !reflect.DeepEqual(mod.Code, want) {
+		t.Fatalf("code mismatch:\nHave: %v\nWant: %v", mod.Code, want)
+	}
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	for _, file := range []string{
+		"../cmd/dump/test.spirv",
+		"../testdata/image.spirv",
+	} {
+		testParseRoundTrip(t, file)
+	}
+}
+
+func testParseRoundTrip(t *testing.T, file string) {
+	fd, err := os.Open(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer fd.Close()
+
+	want, err := spirv.Load(fd)
+	if err != nil {
+		t.Fatalf("%s: %v", file, err)
+	}
+
+	var buf bytes.Buffer
+	err = disasm.WriteWithOptions(&buf, want, disasm.Options{RawIds: true})
+	if err != nil {
+		t.Fatalf("%s: %v", file, err)
+	}
+
+	have, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("%s: %v", file, err)
+	}
+
+	if !reflect.DeepEqual(have.Code, want.Code) {
+		t.Fatalf("%s: code mismatch:\nHave: %v\nWant: %v", file, have.Code, want.Code)
+	}
+
+	// The version and generator are read from the header comments.
+	if have.Header != want.Header {
+		t.Fatalf("%s: header mismatch:\nHave: %+v\nWant: %+v", file, have.Header, want.Header)
+	}
+}
+
+func TestParseHeader(t *testing.T) {
+	for _, st := range []struct {
+		src       string
+		version   uint32
+		generator uint32
+	}{
+		{"OpCapability Shader", spirv.SpecificationVersion, 0},
+		{"; SPIR-V\n; Version: 1.3\n; Generator: Khronos; 0\n; Bound: 4\nOpCapability Shader", spirv.Version13, 0},
+		{"\n  ; Generator: Google Tint Compiler; 1\n; Version: 1.0", spirv.Version10, 23<<16 | 1},
+		{"; Generator: Unknown(1234); 5", spirv.SpecificationVersion, 1234<<16 | 5},
+
+		// Comments after the first instruction are not part of the header.
+		{"OpCapability Shader\n; Version: 1.0", spirv.SpecificationVersion, 0},
+	} {
+		mod, err := Parse(st.src)
+		if err != nil {
+			t.Fatalf("%q: %v", st.src, err)
+		}
+
+		if mod.Header.Version != st.version || mod.Header.GeneratorMagic != st.generator {
+			t.Fatalf("%q: header mismatch:\nHave: %08x %08x\nWant: %08x %08x", st.src,
+				mod.Header.Version, mod.Header.GeneratorMagic, st.version, st.generator)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, st := range []struct {
+		src  string
+		want string
+	}{
+		{"OpCapability Shader\n  OpCapability Foo", "2:16: unknown Capability \"Foo\""},
+		{"OpCapability", "1:1: OpCapability: missing operand Capability"},
+		{"OpCapability Shader Shader", "1:21: OpCapability: unexpected operand \"Shader\""},
+		{"%1 = OpCapability Shader", "1:1: OpCapability does not define an Id"},
+		{"OpTypeVoid", "1:1: OpTypeVoid must be assigned to an Id"},
+		{"%x = OpTypeFoo", "1:6: unknown instruction OpTypeFoo"},
+		{"%x =", "1:1: missing opcode after %x ="},
+		{"Capability Shader", "1:1: expected opcode; have name \"Capability\""},
+		{"OpName %1 \"abc", "1:11: unterminated string"},
+		{"OpName %1 abc", "1:11: Name: expected string; have name \"abc\""},
+		{"OpName 1 \"a\"", "1:8: expected Id; have number \"1\""},
+		{"OpName %0 \"a\"", "1:8: invalid Id %0"},
+		{"OpName % \"a\"", "1:8: missing name after %"},
+		{"  OpSource GLSL 4x", "1:17: Version: invalid number \"4x\""},
+		{"OpSource GLSL 450 ; comment\n  # \"x\"", "2:3: unexpected character '#'"},
+		{"%i = OpTypeInt 32 0\n%c = OpConstant %i -1", "2:20: Value: invalid uint32 literal \"-1\""},
+		{"%i = OpTypeInt 8 1\n%c = OpConstant %i 128", "2:20: Value: invalid int8 literal \"128\""},
+		{"%f = OpTypeFloat 16\n%c = OpConstant %f 1e6", "2:20: Value: invalid float16 literal \"1e6\""},
+		{"%1 = OpExtInstImport \"GLSL.std.450\"\n%2 = OpExtInst %3 %1 Foo", "2:22: unknown GLSL.std.450 instruction \"Foo\""},
+		{"%2 = OpSpecConstantOp %3 Foo %4", "1:26: unknown operation \"Foo\""},
+		{"OpDecorate %1 BuiltIn Foo", "1:23: unknown BuiltIn \"Foo\""},
+		{"%1 = OpTypeImage %2 4D 0 0 0 1 Unknown", "1:21: Dim: invalid number \"4D\""},
+		{"OpLoopMerge %1 %2 Unroll|Foo", "1:19: unknown LoopControl \"Unroll|Foo\""},
+		{"; Version: 1", "1:12: Version: invalid version \"1\""},
+		{"; Version: 1.9", "1:12: Version: unsupported version \"1.9\""},
+		{"; Version: pre-release", "1:12: Version: unsupported version \"pre-release\""},
+		{"; SPIR-V\n; Generator: Khronos", "2:14: Generator: invalid generator \"Khronos\""},
+		{"; Generator: Foo; 1", "1:14: Generator: unknown vendor \"Foo\""},
+		{"; Generator: Khronos; 65536", "1:14: Generator: invalid version \"65536\""},
+	} {
+		_, err := Parse(st.src)
+		if err == nil {
+			t.Fatalf("%q: expected an error", st.src)
+		}
+
+		if _, ok := err.(*Error); !ok || err.Error() != st.want {
+			t.Fatalf("%q: error mismatch:\nHave: %v\nWant: %s", st.src, err, st.want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+/*
+Package asm turns SPIR-V assembly text into modules. It accepts the syntax
+used by the Khronos SPIR-V tools, as written by spirv-dis and package
+disasm:
+
+	; A comment runs to the end of the line.
+	               OpCapability Shader
+	          %1 = OpExtInstImport "GLSL.std.450"
+	               OpMemoryModel Logical GLSL450
+	               OpEntryPoint GLCompute %main "main"
+	       %void = OpTypeVoid
+	         %fn = OpTypeFunction %void
+	      %float = OpTypeFloat 32
+	    %float_2 = OpConstant %float 2
+	       %main = OpFunction %void None %fn
+	      %entry = OpLabel
+	          %x = OpExtInst %float %1 Sqrt %float_2
+	               OpReturn
+	               OpFunctionEnd
+
+Each instruction starts with its opcode, or with the Id it defines,
+followed by "=". Ids are written with a "%" prefix. Numeric Ids, like %1,
+keep their number. Named Ids are assigned the lowest free numbers, in the
+order they first appear. Enumerated operands are spelled as in the
+specification; bit masks combine their names with "|". Numeric literals
+are decimal or hexadecimal, and take the type of the constant or OpSwitch
+selector they belong to. String literals are quoted, and a backslash
+escapes the character following it.
+
+The version and generator of the module are read from the header comments
+written by disasm, like "; Version: 1.0". Modules without them target the
+latest version of the specification.
+
+A module is assembled with:
+
+	module, err := asm.Parse(src)
+	...
+
+Errors are returned as an *Error, which carries the line and column where
+the problem was found. The module is not verified.
+*/
+package asm
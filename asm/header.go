@@ -0,0 +1,103 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package asm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/andreas-jonsson/spirv"
+	"github.com/andreas-jonsson/spirv/disasm"
+)
+
+// header reads the version and generator of the module from the header
+// comments written by package disasm, into hdr. Only the comments which
+// precede the first instruction are considered. The bound and schema are
+// not read; the former follows from the Ids in use.
+func header(src string, hdr *spirv.Header) error {
+	for n, line := range strings.Split(src, "\n") {
+		text := strings.TrimSpace(line)
+		if text == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(text, ";") {
+			return nil
+		}
+
+		i := strings.Index(text, ":")
+		if i < 0 {
+			continue
+		}
+
+		key := strings.TrimSpace(text[1:i])
+		value := strings.TrimSpace(text[i+1:])
+		tok := token{
+			kind:   tokenWord,
+			text:   value,
+			line:   n + 1,
+			column: utf8.RuneCountInString(line[:strings.LastIndex(line, value)]) + 1,
+		}
+
+		var err error
+		switch key {
+		case "Version":
+			hdr.Version, err = headerVersion(&tok)
+		case "Generator":
+			hdr.GeneratorMagic, err = headerGenerator(&tok)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// headerVersion parses a specification version of the form "1.0".
+func headerVersion(tok *token) (uint32, error) {
+	var major, minor uint32
+
+	// Pre-release modules use other opcodes, which are not assembled.
+	if tok.text == "pre-release" {
+		return 0, tok.errorf("Version: unsupported version %q", tok.text)
+	}
+
+	_, err := fmt.Sscanf(tok.text, "%d.%d", &major, &minor)
+	if err != nil || fmt.Sprintf("%d.%d", major, minor) != tok.text {
+		return 0, tok.errorf("Version: invalid version %q", tok.text)
+	}
+
+	hdr := spirv.Header{Magic: spirv.MagicLE, Version: major<<16 | minor<<8}
+	if major > 0xff || minor > 0xff || hdr.Verify() != nil {
+		return 0, tok.errorf("Version: unsupported version %q", tok.text)
+	}
+
+	return hdr.Version, nil
+}
+
+// headerGenerator parses a generator of the form "Khronos; 0", which
+// names the tool vendor and holds the version of the tool.
+func headerGenerator(tok *token) (uint32, error) {
+	i := strings.LastIndex(tok.text, ";")
+	if i < 0 {
+		return 0, tok.errorf("Generator: invalid generator %q", tok.text)
+	}
+
+	name := strings.TrimSpace(tok.text[:i])
+	vendor, ok := disasm.LookupGenerator(name)
+	if !ok {
+		return 0, tok.errorf("Generator: unknown vendor %q", name)
+	}
+
+	version, err := strconv.ParseUint(strings.TrimSpace(tok.text[i+1:]), 10, 16)
+	if err != nil {
+		return 0, tok.errorf("Generator: invalid version %q", strings.TrimSpace(tok.text[i+1:]))
+	}
+
+	return vendor<<16 | uint32(version), nil
+}
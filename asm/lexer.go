@@ -0,0 +1,198 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package asm
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Error describes a problem in the assembly text.
+type Error struct {
+	Line   int // 1-based line number.
+	Column int // 1-based column, in characters.
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Msg)
+}
+
+// tokenKind defines the type of a token.
+type tokenKind uint8
+
+const (
+	tokenId     tokenKind = iota // %name
+	tokenWord                    // Opcodes, enumerants and instruction names.
+	tokenNumber                  // Numeric literals.
+	tokenString                  // Quoted string literals, without escapes.
+	tokenEquals                  // =
+)
+
+var tokenNames = [...]string{
+	tokenId:     "Id",
+	tokenWord:   "name",
+	tokenNumber: "number",
+	tokenString: "string",
+	tokenEquals: "\"=\"",
+}
+
+func (k tokenKind) String() string {
+	return tokenNames[k]
+}
+
+// token is a single lexical element of the assembly text.
+type token struct {
+	kind   tokenKind
+	text   string
+	line   int
+	column int
+}
+
+// errorf returns an error located at the token.
+func (t *token) errorf(format string, argv ...interface{}) *Error {
+	return &Error{
+		Line:   t.line,
+		Column: t.column,
+		Msg:    fmt.Sprintf(format, argv...),
+	}
+}
+
+// isOpcode returns true if the token names an opcode. These start a new
+// instruction, unless they follow "=".
+func (t *token) isOpcode() bool {
+	return t.kind == tokenWord && len(t.text) > 2 &&
+		strings.HasPrefix(t.text, "Op") && t.text[2] >= 'A' && t.text[2] <= 'Z'
+}
+
+// lex splits the given source into tokens.
+func lex(src string) ([]token, error) {
+	var tokens []token
+
+	line, column := 1, 1
+	for i := 0; i < len(src); {
+		c := src[i]
+
+		switch {
+		case c == '\n':
+			line, column = line+1, 1
+			i++
+			continue
+
+		case c == ' ', c == '\t', c == '\r':
+			column++
+			i++
+			continue
+
+		case c == ';':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		}
+
+		tok := token{line: line, column: column}
+		start := i
+
+		switch {
+		case c == '=':
+			tok.kind = tokenEquals
+			i++
+
+		case c == '"':
+			tok.kind = tokenString
+
+			var sb strings.Builder
+			for i++; i < len(src) && src[i] != '"'; i++ {
+				if src[i] == '\\' && i+1 < len(src) {
+					i++
+				}
+				sb.WriteByte(src[i])
+			}
+
+			if i >= len(src) {
+				return nil, tok.errorf("unterminated string")
+			}
+
+			i++
+			tok.text = sb.String()
+
+		case c == '%':
+			tok.kind = tokenId
+			i = scanName(src, i+1)
+			tok.text = src[start+1 : i]
+
+			if tok.text == "" {
+				return nil, tok.errorf("missing name after %%")
+			}
+
+		case isDigit(c), c == '-', c == '+', c == '.':
+			tok.kind = tokenNumber
+			i = scanNumber(src, i)
+			tok.text = src[start:i]
+
+		case isLetter(c):
+			tok.kind = tokenWord
+			i = scanName(src, i)
+			tok.text = src[start:i]
+
+		default:
+			r, _ := utf8.DecodeRuneInString(src[i:])
+			return nil, tok.errorf("unexpected character %q", r)
+		}
+
+		// Strings can span lines.
+		for _, r := range src[start:i] {
+			if r == '\n' {
+				line, column = line+1, 1
+			} else {
+				column++
+			}
+		}
+
+		tokens = append(tokens, tok)
+	}
+
+	return tokens, nil
+}
+
+// scanName returns the end of the name starting at src[i]. Names of bit
+// mask values can be combined with "|".
+func scanName(src string, i int) int {
+	for i < len(src) && (isLetter(src[i]) || isDigit(src[i]) || src[i] == '|') {
+		i++
+	}
+	return i
+}
+
+// scanNumber returns the end of the number starting at src[i]. Signs are
+// only part of a number at its start, or in an exponent.
+func scanNumber(src string, i int) int {
+	start := i
+	hex := strings.HasPrefix(strings.TrimLeft(src[i:], "+-"), "0x")
+
+	for ; i < len(src); i++ {
+		c := src[i]
+
+		switch {
+		case isDigit(c), isLetter(c), c == '.':
+		case (c == '-' || c == '+') && i == start:
+		case (c == '-' || c == '+') && (!hex && (src[i-1] == 'e' || src[i-1] == 'E') ||
+			hex && (src[i-1] == 'p' || src[i-1] == 'P')):
+		default:
+			return i
+		}
+	}
+
+	return i
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
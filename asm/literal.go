@@ -0,0 +1,220 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package asm
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/andreas-jonsson/spirv"
+)
+
+// scalar defines an integer or floating point type.
+type scalar struct {
+	width  uint32
+	signed bool
+	float  bool
+}
+
+// number returns the value of a literal number operand.
+func number(tok *token, field string) (uint32, error) {
+	if tok.kind != tokenNumber {
+		return 0, tok.errorf("%s: expected number; have %s %q", field, tok.kind, tok.text)
+	}
+
+	v, err := strconv.ParseUint(tok.text, 0, 32)
+	if err != nil {
+		return 0, tok.errorf("%s: invalid number %q", field, tok.text)
+	}
+
+	return uint32(v), nil
+}
+
+// literal reads a numeric literal of the given type, and returns the
+// words encoding it. Literals of unknown types must fit in a single word.
+func (a *assembler) literal(p *operands, typ spirv.Id, field string) ([]uint32, error) {
+	tok, err := p.next(field)
+	if err != nil {
+		return nil, err
+	}
+
+	if tok.kind != tokenNumber {
+		return nil, tok.errorf("%s: expected number; have %s %q", field, tok.kind, tok.text)
+	}
+
+	t, ok := a.types[typ]
+	if !ok {
+		v, err := number(tok, field)
+		return []uint32{v}, err
+	}
+
+	var bits uint64
+	if t.float {
+		bits, ok = parseFloat(tok.text, t.width)
+	} else {
+		bits, ok = parseInt(tok.text, t.width, t.signed)
+	}
+
+	if !ok {
+		return nil, tok.errorf("%s: invalid %s literal %q", field, t, tok.text)
+	}
+
+	if t.width > 32 {
+		return []uint32{uint32(bits), uint32(bits >> 32)}, nil
+	}
+
+	return []uint32{uint32(bits)}, nil
+}
+
+func (t scalar) String() string {
+	switch {
+	case t.float:
+		return "float" + strconv.Itoa(int(t.width))
+	case t.signed:
+		return "int" + strconv.Itoa(int(t.width))
+	}
+	return "uint" + strconv.Itoa(int(t.width))
+}
+
+// parseInt parses an integer of the given width. Negative values of
+// signed types are sign extended to the full word.
+func parseInt(s string, width uint32, signed bool) (uint64, bool) {
+	if width == 0 || width > 64 {
+		return 0, false
+	}
+
+	if signed {
+		v, err := strconv.ParseInt(s, 0, int(width))
+		if err != nil {
+			return 0, false
+		}
+
+		if width <= 32 {
+			return uint64(uint32(int32(v))), true
+		}
+		return uint64(v), true
+	}
+
+	v, err := strconv.ParseUint(s, 0, int(width))
+	return v, err == nil
+}
+
+// parseFloat parses a float of the given width, and returns its bits.
+// Infinities and NaNs are written as hexadecimal floats with the maximum
+// exponent, for example 0x1.8p+128 for a 32-bit quiet NaN.
+func parseFloat(s string, width uint32) (uint64, bool) {
+	var mantBits, expBits uint
+	switch width {
+	case 16:
+		mantBits, expBits = 10, 5
+	case 32:
+		mantBits, expBits = 23, 8
+	case 64:
+		mantBits, expBits = 52, 11
+	default:
+		return 0, false
+	}
+
+	if bits, ok := parseHexSpecial(s, mantBits, expBits); ok {
+		return bits, true
+	}
+
+	size := 64
+	if width < 64 {
+		size = 32
+	}
+
+	f, err := strconv.ParseFloat(s, size)
+	if err != nil {
+		return 0, false
+	}
+
+	switch width {
+	case 16:
+		h, ok := halfBits(float32(f))
+		return uint64(h), ok
+	case 32:
+		return uint64(math.Float32bits(float32(f))), true
+	}
+
+	return math.Float64bits(f), true
+}
+
+// parseHexSpecial parses a hexadecimal float whose exponent marks it as
+// an infinity or NaN. Returns false for all other numbers.
+func parseHexSpecial(s string, mantBits, expBits uint) (uint64, bool) {
+	var sign uint64
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign, s = 1, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	bias := 1 << (expBits - 1)
+	if !strings.HasPrefix(s, "0x1") || !strings.HasSuffix(s, "p+"+strconv.Itoa(bias)) {
+		return 0, false
+	}
+
+	frac := strings.TrimSuffix(s[3:], "p+"+strconv.Itoa(bias))
+	if frac != "" {
+		if !strings.HasPrefix(frac, ".") {
+			return 0, false
+		}
+		frac = frac[1:]
+	}
+
+	// The fraction is written in whole hex digits.
+	digits := int(mantBits+3) / 4
+	if len(frac) > digits {
+		return 0, false
+	}
+
+	mant, err := strconv.ParseUint(frac+strings.Repeat("0", digits-len(frac)), 16, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	mant >>= uint(digits)*4 - mantBits
+	return sign<<(mantBits+expBits) | (1<<expBits-1)<<mantBits | mant, true
+}
+
+// halfBits converts f to a 16-bit float, rounding to the nearest value.
+// Returns false if f is out of range.
+func halfBits(f float32) (uint16, bool) {
+	b := math.Float32bits(f)
+	sign := uint16(b>>16) & 0x8000
+	exp := int(b>>23&0xff) - 127 + 15
+	mant := b & 0x7fffff
+
+	if exp >= 0x1f {
+		return 0, false
+	}
+
+	// Numbers too small for a normal half become subnormal.
+	shift := uint(13)
+	if exp <= 0 {
+		if exp < -10 {
+			return sign, true
+		}
+
+		mant |= 0x800000
+		shift = uint(14 - exp)
+		exp = 0
+	}
+
+	half := uint32(exp)<<10 | mant>>shift
+
+	rem, halfway := mant&(1<<shift-1), uint32(1)<<(shift-1)
+	if rem > halfway || rem == halfway && half&1 == 1 {
+		half++
+	}
+
+	if half >= 0x7c00 {
+		return 0, false
+	}
+
+	return sign | uint16(half), true
+}
@@ -0,0 +1,388 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package asm
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/andreas-jonsson/spirv"
+)
+
+// errNotSpecial is returned by assembler.special for fields which are
+// read according to their type.
+var errNotSpecial = errors.New("not special")
+
+// value reads the operands held by a single field, according to the
+// type of the field.
+func (a *assembler) value(p *operands, fv reflect.Value, field string) error {
+	switch fv.Kind() {
+	case reflect.Ptr:
+		v := reflect.New(fv.Type().Elem())
+		if err := a.value(p, v.Elem(), field); err != nil {
+			return err
+		}
+		fv.Set(v)
+		return nil
+
+	case reflect.Slice:
+		// Lists take all remaining operands. Empty lists are left nil,
+		// as the decoder does.
+		if !p.more() {
+			return nil
+		}
+
+		list := reflect.MakeSlice(fv.Type(), len(p.tokens), len(p.tokens))
+		for i := 0; i < list.Len(); i++ {
+			if err := a.value(p, list.Index(i), field); err != nil {
+				return err
+			}
+		}
+		fv.Set(list)
+		return nil
+	}
+
+	tok, err := p.next(field)
+	if err != nil {
+		return err
+	}
+
+	if fv.Kind() == reflect.String {
+		if tok.kind != tokenString {
+			return tok.errorf("%s: expected string; have %s %q", field, tok.kind, tok.text)
+		}
+		fv.SetString(tok.text)
+		return nil
+	}
+
+	var v uint32
+
+	switch {
+	case fv.Type().Name() == "Id":
+		var id spirv.Id
+		id, err = a.id(tok)
+		v = uint32(id)
+	case fv.Type().Implements(reflect.TypeOf((*spirv.Enum)(nil)).Elem()):
+		v, err = enumValue(tok, fv.Type().Name())
+	default:
+		v, err = number(tok, field)
+	}
+
+	fv.SetUint(uint64(v))
+	return err
+}
+
+// special reads the operands of fields whose meaning depends on the rest
+// of the instruction. Returns errNotSpecial for all other fields.
+func (a *assembler) special(p *operands, instr spirv.Instruction, field string) error {
+	var err error
+
+	switch v := instr.(type) {
+	case *spirv.OpConstant:
+		if field == "Value" {
+			v.Value, err = a.literal(p, v.ResultType, field)
+			return err
+		}
+
+	case *spirv.OpSpecConstant:
+		if field == "Value" {
+			v.Value, err = a.literal(p, v.ResultType, field)
+			return err
+		}
+
+	case *spirv.OpSwitch:
+		if field == "Target" {
+			v.Target, err = a.switchTargets(p, v.Selector)
+			return err
+		}
+
+	case *spirv.OpLoad:
+		if field == "Argv" {
+			v.Argv, err = a.memoryAccess(p, v.MemoryAccess)
+			return err
+		}
+
+	case *spirv.OpStore:
+		if field == "Argv" {
+			v.Argv, err = a.memoryAccess(p, v.MemoryAccess)
+			return err
+		}
+
+	case *spirv.OpCopyMemory:
+		if field == "Argv" {
+			v.Argv, err = a.memoryAccess(p, v.MemoryAccess)
+			return err
+		}
+
+	case *spirv.OpCopyMemorySized:
+		if field == "Argv" {
+			v.Argv, err = a.memoryAccess(p, v.MemoryAccess)
+			return err
+		}
+
+	case *spirv.OpDecorate:
+		if field == "Argv" {
+			v.Argv, err = decoration(p, v.Decoration)
+			return err
+		}
+
+	case *spirv.OpMemberDecorate:
+		if field == "Argv" {
+			v.Argv, err = decoration(p, v.Decoration)
+			return err
+		}
+
+	case *spirv.OpGroupMemberDecorate:
+		if field == "Targets" {
+			for p.more() {
+				tok, _ := p.next(field)
+				id, err := a.id(tok)
+				if err != nil {
+					return err
+				}
+
+				tok, err = p.next(field)
+				if err != nil {
+					return err
+				}
+
+				member, err := number(tok, field)
+				if err != nil {
+					return err
+				}
+
+				v.Targets = append(v.Targets, uint32(id), member)
+			}
+			return nil
+		}
+
+	case *spirv.OpExtInst:
+		if field == "Instruction" {
+			v.Instruction, err = a.extInst(p, v.Set)
+			return err
+		}
+
+	case *spirv.OpSpecConstantOp:
+		if field == "Operation" {
+			v.Operation, err = operation(p)
+			return err
+		}
+	}
+
+	return errNotSpecial
+}
+
+// enumValue returns the value of the given token, for the enum type
+// with the given name. Numbers are accepted as well.
+//
+// Names are tried first, as some start with a digit and are lexed as
+// numbers, like the "2D" of Dim.
+func enumValue(tok *token, kind string) (uint32, error) {
+	if tok.kind == tokenWord || tok.kind == tokenNumber {
+		if v, ok := spirv.EnumValue(kind, tok.text); ok {
+			return v, nil
+		}
+	}
+
+	if tok.kind == tokenNumber {
+		return number(tok, kind)
+	}
+
+	return 0, tok.errorf("unknown %s %q", kind, tok.text)
+}
+
+// switchTargets reads the (literal, label) pairs of an OpSwitch. The
+// literals take the type of the selector.
+func (a *assembler) switchTargets(p *operands, selector spirv.Id) ([]uint32, error) {
+	var out []uint32
+
+	for p.more() {
+		words, err := a.literal(p, a.values[selector], "Target")
+		if err != nil {
+			return nil, err
+		}
+
+		tok, err := p.next("Target")
+		if err != nil {
+			return nil, err
+		}
+
+		label, err := a.id(tok)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(append(out, words...), uint32(label))
+	}
+
+	return out, nil
+}
+
+// memoryAccess reads the extra operands of a memory access mask. For
+// the copy instructions, these can be followed by a second mask, which
+// applies to the source.
+func (a *assembler) memoryAccess(p *operands, mask *spirv.MemoryAccess) ([]uint32, error) {
+	var out []uint32
+
+	for mask != nil {
+		var err error
+		spirv.ForEachMemoryAccessOperand(*mask, func(_ int, bit spirv.MemoryAccess, id bool) {
+			if err != nil {
+				return
+			}
+
+			var tok *token
+			tok, err = p.next(bit.String())
+			if err != nil {
+				return
+			}
+
+			var v uint32
+			if id {
+				var i spirv.Id
+				i, err = a.id(tok)
+				v = uint32(i)
+			} else {
+				v, err = number(tok, bit.String())
+			}
+
+			out = append(out, v)
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		mask = nil
+		if p.more() {
+			tok, _ := p.next("MemoryAccess")
+			v, err := enumValue(tok, "MemoryAccess")
+			if err != nil {
+				return nil, err
+			}
+
+			m := spirv.MemoryAccess(v)
+			out = append(out, v)
+			mask = &m
+		}
+	}
+
+	return out, nil
+}
+
+// decoration reads the extra operands of a decoration.
+func decoration(p *operands, dec spirv.Decoration) ([]uint32, error) {
+	var kinds []string
+
+	switch dec {
+	case spirv.DecorationBuiltIn:
+		kinds = []string{"BuiltIn"}
+	case spirv.DecorationFuncParamAttr:
+		kinds = []string{"FunctionParameterAttribute"}
+	case spirv.DecorationFPRoundingMode:
+		kinds = []string{"FPRoundingMode"}
+	case spirv.DecorationFPFastMathMode:
+		kinds = []string{"FPFastMathMode"}
+	case spirv.DecorationLinkageAttributes:
+		kinds = []string{"string", "LinkageType"}
+	}
+
+	var out []uint32
+
+	for i := 0; p.more(); i++ {
+		tok, _ := p.next(dec.String())
+
+		kind := "number"
+		if i < len(kinds) {
+			kind = kinds[i]
+		}
+
+		switch kind {
+		case "number":
+			v, err := number(tok, dec.String())
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, v)
+
+		case "string":
+			if tok.kind != tokenString {
+				return nil, tok.errorf("%s: expected string; have %s %q", dec, tok.kind, tok.text)
+			}
+
+			s := spirv.String(tok.text)
+			words := make([]uint32, s.EncodedLen())
+			s.Encode(words)
+			out = append(out, words...)
+
+		default:
+			v, err := enumValue(tok, kind)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, v)
+		}
+	}
+
+	return out, nil
+}
+
+// extInst reads the name of an instruction from the extended instruction
+// set imported as set.
+func (a *assembler) extInst(p *operands, set spirv.Id) (uint32, error) {
+	tok, err := p.next("Instruction")
+	if err != nil {
+		return 0, err
+	}
+
+	if tok.kind == tokenNumber {
+		return number(tok, "Instruction")
+	}
+
+	s, ok := spirv.LookupExtInstSet(a.sets[set])
+	if !ok {
+		return 0, tok.errorf("%q: unknown extended instruction set", a.sets[set])
+	}
+
+	// The sets in this repository name their instructions as the
+	// specification spells them.
+	named, _ := s.(interface {
+		OpcodeName(uint32) (string, bool)
+	})
+
+	for _, opcode := range s.Opcodes() {
+		var name string
+		if named != nil {
+			name, _ = named.OpcodeName(uint32(opcode))
+		} else if fun, ok := s.Lookup(uint32(opcode)); ok {
+			name = reflect.Indirect(reflect.ValueOf(fun())).Type().Name()
+		}
+
+		if name == tok.text {
+			return uint32(opcode), nil
+		}
+	}
+
+	return 0, tok.errorf("unknown %s instruction %q", s.Name(), tok.text)
+}
+
+// operation reads the opcode of an OpSpecConstantOp. It is named without
+// the "Op" prefix.
+func operation(p *operands) (uint32, error) {
+	tok, err := p.next("Operation")
+	if err != nil {
+		return 0, err
+	}
+
+	if tok.kind == tokenNumber {
+		return number(tok, "Operation")
+	}
+
+	if fun, ok := lookupOpcode("Op" + tok.text); ok && !strings.HasPrefix(tok.text, "Op") {
+		return fun().Opcode(), nil
+	}
+
+	return 0, tok.errorf("unknown operation %q", tok.text)
+}
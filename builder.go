@@ -0,0 +1,558 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Builder constructs a module one instruction at a time.
+//
+// It allocates result Ids and keeps Header.Bound in sync with them. Each
+// instruction is placed in the section of the logical layout it belongs
+// to, regardless of the order in which instructions are added. This means
+// names, decorations and types can be declared while a function is being
+// written.
+//
+// Types, constants, capabilities, extensions and extended instruction
+// set imports are only declared once. Adding an identical declaration
+// yields the Id of the existing one. Structure, array and pointer types
+// and specialization constants are exempt from this, as identical
+// declarations of these may be decorated differently.
+//
+// Instructions belonging to functions are appended to the function being
+// written, in the order they are added. Function-local variables are moved
+// to the start of the function's first block.
+type Builder struct {
+	sections  [layoutGlobal + 1]InstructionList // Code outside of functions.
+	functions InstructionList                   // Function declarations and definitions.
+
+	header Header
+	next   Id            // Next free Id.
+	decls  map[string]Id // Declarations by their opcode and operands.
+
+	inFunction bool
+	entry      int           // Where the next local variable goes; -1 before the first block.
+	locals     []Instruction // Local variables added before the first block.
+}
+
+// NewBuilder creates a builder for a new, default module.
+func NewBuilder() *Builder {
+	return &Builder{
+		header: NewModule().Header,
+		next:   1,
+		decls:  make(map[string]Id),
+		entry:  -1,
+	}
+}
+
+// Module returns the module built so far. Its Bound is one past the
+// highest Id allocated by the builder.
+//
+// Each call yields a new module, so the builder can be used to add more
+// instructions afterwards.
+func (b *Builder) Module() *Module {
+	mod := &Module{Header: b.header}
+	mod.Header.Bound = uint32(b.next)
+
+	for _, list := range b.sections {
+		mod.Code = append(mod.Code, list...)
+	}
+
+	mod.Code = append(mod.Code, b.functions...)
+	return mod
+}
+
+// Id allocates a new Id. This is needed for forward references, like
+// branches to blocks which are yet to be added.
+func (b *Builder) Id() Id {
+	id := b.next
+	b.next++
+	return id
+}
+
+// Add places the given instruction in the module, and returns its result
+// Id. Instructions without a result yield 0.
+//
+// If the instruction defines a result Id which is 0, a new one is
+// allocated and assigned to it. Instructions which already carry a
+// result Id keep it, and are never merged with an existing declaration.
+func (b *Builder) Add(instr Instruction) Id {
+	rid := resultIdField(instr)
+	if rid.IsValid() && rid.Uint() != 0 {
+		id := Id(rid.Uint())
+		if id >= b.next {
+			b.next = id + 1
+		}
+
+		if key, ok := declarationKey(instr); ok {
+			if _, ok := b.decls[key]; !ok {
+				b.decls[key] = id
+			}
+		}
+
+		b.place(instr)
+		return id
+	}
+
+	key, ok := declarationKey(instr)
+	if ok {
+		if id, ok := b.decls[key]; ok {
+			return id
+		}
+	}
+
+	var id Id
+	if rid.IsValid() {
+		id = b.Id()
+		rid.SetUint(uint64(id))
+	}
+
+	if ok {
+		b.decls[key] = id
+	}
+
+	b.place(instr)
+	return id
+}
+
+// place appends the instruction to the section it belongs to.
+func (b *Builder) place(instr Instruction) {
+	opcode := instr.Opcode()
+
+	switch opcode {
+	case opcodeFunction:
+		b.inFunction = true
+		b.entry = -1
+		b.locals = nil
+		b.functions = append(b.functions, instr)
+		return
+
+	case opcodeFunctionEnd:
+		// Local variables without a block to go to are kept, so they
+		// are reported by Module.Verify.
+		b.functions = append(b.functions, b.locals...)
+		b.functions = append(b.functions, instr)
+		b.inFunction = false
+		b.locals = nil
+		return
+
+	case opcodeLabel:
+		b.functions = append(b.functions, instr)
+
+		if b.inFunction && b.entry < 0 {
+			b.functions = append(b.functions, b.locals...)
+			b.entry = len(b.functions)
+			b.locals = nil
+		}
+		return
+
+	case opcodeVariable:
+		if b.inFunction && instr.(*OpVariable).StorageClass == StorageClassFunction {
+			b.addLocal(instr)
+			return
+		}
+
+	case opcodeLine, opcodeNoLine, opcodeUndef:
+		if b.inFunction {
+			b.functions = append(b.functions, instr)
+			return
+		}
+	}
+
+	state, ok := moduleLayoutState(opcode)
+	if !ok {
+		b.functions = append(b.functions, instr)
+		return
+	}
+
+	b.sections[state] = append(b.sections[state], instr)
+}
+
+// addLocal adds a function-local variable to the start of the first block
+// of the current function.
+func (b *Builder) addLocal(instr Instruction) {
+	if b.entry < 0 {
+		b.locals = append(b.locals, instr)
+		return
+	}
+
+	b.functions = append(b.functions, nil)
+	copy(b.functions[b.entry+1:], b.functions[b.entry:])
+	b.functions[b.entry] = instr
+	b.entry++
+}
+
+// resultIdField returns the ResultId field of the instruction. The value
+// is invalid if the instruction has none.
+func resultIdField(instr Instruction) reflect.Value {
+	return reflect.Indirect(reflect.ValueOf(instr)).FieldByName("ResultId")
+}
+
+// declarationKey returns the key under which a declaration is merged with
+// identical ones. Returns false for all other instructions.
+func declarationKey(instr Instruction) (string, bool) {
+	switch instr.Opcode() {
+	case opcodeCapability, opcodeExtension, opcodeExtInstImport,
+		opcodeTypeVoid, opcodeTypeBool, opcodeTypeInt, opcodeTypeFloat,
+		opcodeTypeVector, opcodeTypeMatrix, opcodeTypeImage,
+		opcodeTypeSampler, opcodeTypeSampledImage, opcodeTypeOpaque,
+		opcodeTypeFunction, opcodeTypeEvent, opcodeTypeDeviceEvent,
+		opcodeTypeReserveId, opcodeTypeQueue, opcodeTypePipe,
+		opcodeTypePipeStorage, opcodeTypeNamedBarrier,
+		opcodeConstantTrue, opcodeConstantFalse, opcodeConstant,
+		opcodeConstantComposite, opcodeConstantSampler, opcodeConstantNull:
+	default:
+		return "", false
+	}
+
+	// Compare the encoded operands, without the result Id.
+	rv := reflect.New(reflect.Indirect(reflect.ValueOf(instr)).Type())
+	rv.Elem().Set(reflect.Indirect(reflect.ValueOf(instr)))
+
+	dup := rv.Interface().(Instruction)
+	if rid := resultIdField(dup); rid.IsValid() {
+		rid.SetUint(0)
+	}
+
+	words := make([]uint32, operandLen(dup))
+	if _, err := encodeOperands(dup, words); err != nil {
+		return "", false
+	}
+
+	return fmt.Sprint(instr.Opcode(), words), true
+}
+
+// Capability declares the given capability.
+func (b *Builder) Capability(c Capability) {
+	b.Add(&OpCapability{Capability: c})
+}
+
+// Extension declares the use of the given extension.
+func (b *Builder) Extension(name string) {
+	b.Add(&OpExtension{Name: String(name)})
+}
+
+// ExtInstImport imports the extended instruction set with the given name.
+func (b *Builder) ExtInstImport(name string) Id {
+	return b.Add(&OpExtInstImport{Name: String(name)})
+}
+
+// MemoryModel sets the addressing and memory model of the module.
+func (b *Builder) MemoryModel(a AddressingModel, m MemoryModel) {
+	b.Add(&OpMemoryModel{AddressingModel: a, MemoryModel: m})
+}
+
+// EntryPoint declares the given function as an entry point.
+func (b *Builder) EntryPoint(model ExecutionModel, function Id, name string, iface ...Id) {
+	b.Add(&OpEntryPoint{
+		ExecutionModel: model,
+		EntryPoint:     function,
+		Name:           String(name),
+		Interface:      iface,
+	})
+}
+
+// ExecutionMode declares an execution mode for the given entry point.
+func (b *Builder) ExecutionMode(function Id, mode ExecutionMode, argv ...uint32) {
+	b.Add(&OpExecutionMode{EntryPoint: function, Mode: mode, Argv: argv})
+}
+
+// Name assigns a debug name to the given Id.
+func (b *Builder) Name(target Id, name string) {
+	b.Add(&OpName{Target: target, Name: String(name)})
+}
+
+// MemberName assigns a debug name to a member of the given structure type.
+func (b *Builder) MemberName(typ Id, member uint32, name string) {
+	b.Add(&OpMemberName{Type: typ, Member: member, Name: String(name)})
+}
+
+// Decorate adds a decoration to the given Id.
+func (b *Builder) Decorate(target Id, dec Decoration, argv ...uint32) {
+	b.Add(&OpDecorate{Target: target, Decoration: dec, Argv: argv})
+}
+
+// MemberDecorate adds a decoration to a member of the given structure type.
+func (b *Builder) MemberDecorate(typ Id, member uint32, dec Decoration, argv ...uint32) {
+	b.Add(&OpMemberDecorate{StructureType: typ, Member: member, Decoration: dec, Argv: argv})
+}
+
+// TypeVoid declares the void type.
+func (b *Builder) TypeVoid() Id {
+	return b.Add(&OpTypeVoid{})
+}
+
+// TypeBool declares the boolean type.
+func (b *Builder) TypeBool() Id {
+	return b.Add(&OpTypeBool{})
+}
+
+// TypeInt declares an integer type of the given width.
+func (b *Builder) TypeInt(width uint32, signed bool) Id {
+	var signedness uint32
+	if signed {
+		signedness = 1
+	}
+	return b.Add(&OpTypeInt{Width: width, Signedness: signedness})
+}
+
+// TypeFloat declares a floating point type of the given width.
+func (b *Builder) TypeFloat(width uint32) Id {
+	return b.Add(&OpTypeFloat{Width: width})
+}
+
+// TypeVector declares a vector of count components of the given type.
+func (b *Builder) TypeVector(component Id, count uint32) Id {
+	return b.Add(&OpTypeVector{ComponentType: component, ComponentCount: count})
+}
+
+// TypeMatrix declares a matrix of count columns of the given vector type.
+func (b *Builder) TypeMatrix(column Id, count uint32) Id {
+	return b.Add(&OpTypeMatrix{ColumnType: column, ColumnCount: count})
+}
+
+// TypeArray declares an array of the given element type. The length is
+// the Id of a constant. Each call yields a distinct type.
+func (b *Builder) TypeArray(element, length Id) Id {
+	return b.Add(&OpTypeArray{ElementType: element, Length: length})
+}
+
+// TypeRuntimeArray declares an array of the given element type, whose
+// length is not known at compile time. Each call yields a distinct type.
+func (b *Builder) TypeRuntimeArray(element Id) Id {
+	return b.Add(&OpTypeRuntimeArray{ElementType: element})
+}
+
+// TypeStruct declares a new structure type with the given member types.
+// Each call yields a distinct type.
+func (b *Builder) TypeStruct(members ...Id) Id {
+	return b.Add(&OpTypeStruct{Members: members})
+}
+
+// TypePointer declares a pointer to the given type, in the given storage
+// class. Each call yields a distinct type.
+func (b *Builder) TypePointer(sc StorageClass, typ Id) Id {
+	return b.Add(&OpTypePointer{StorageClass: sc, Type: typ})
+}
+
+// TypeFunction declares a function type.
+func (b *Builder) TypeFunction(result Id, params ...Id) Id {
+	return b.Add(&OpTypeFunction{ReturnType: result, Parameters: params})
+}
+
+// Constant declares a numeric constant of the given type. The value is
+// given in words, with the low-order word first.
+func (b *Builder) Constant(typ Id, value ...uint32) Id {
+	return b.Add(&OpConstant{ResultType: typ, Value: value})
+}
+
+// ConstantTrue declares the boolean constant true.
+func (b *Builder) ConstantTrue(typ Id) Id {
+	return b.Add(&OpConstantTrue{ResultType: typ})
+}
+
+// ConstantFalse declares the boolean constant false.
+func (b *Builder) ConstantFalse(typ Id) Id {
+	return b.Add(&OpConstantFalse{ResultType: typ})
+}
+
+// ConstantComposite declares a composite constant from the given
+// constituents.
+func (b *Builder) ConstantComposite(typ Id, constituents ...Id) Id {
+	return b.Add(&OpConstantComposite{ResultType: typ, Constituents: constituents})
+}
+
+// ConstantNull declares the null value of the given type.
+func (b *Builder) ConstantNull(typ Id) Id {
+	return b.Add(&OpConstantNull{ResultType: typ})
+}
+
+// Variable declares a variable of the given pointer type. Variables in
+// the Function storage class belong to the function being written.
+func (b *Builder) Variable(typ Id, sc StorageClass) Id {
+	return b.Add(&OpVariable{ResultType: typ, StorageClass: sc})
+}
+
+// Function starts a new function. It must be ended with FunctionEnd.
+func (b *Builder) Function(result Id, control FunctionControl, typ Id) Id {
+	return b.Add(&OpFunction{ResultType: result, FunctionControl: control, FunctionType: typ})
+}
+
+// FunctionParameter declares the next parameter of the current function.
+func (b *Builder) FunctionParameter(typ Id) Id {
+	return b.Add(&OpFunctionParameter{ResultType: typ})
+}
+
+// FunctionEnd ends the current function.
+func (b *Builder) FunctionEnd() {
+	b.Add(&OpFunctionEnd{})
+}
+
+// Label starts a new block, and returns its label.
+func (b *Builder) Label() Id {
+	return b.Add(&OpLabel{})
+}
+
+// Block starts a new block with a label allocated earlier with Id.
+func (b *Builder) Block(label Id) {
+	b.Add(&OpLabel{ResultId: label})
+}
+
+// Branch ends the current block with an unconditional branch.
+func (b *Builder) Branch(target Id) {
+	b.Add(&OpBranch{TargetLabel: target})
+}
+
+// BranchConditional ends the current block with a conditional branch.
+func (b *Builder) BranchConditional(cond, trueLabel, falseLabel Id) {
+	b.Add(&OpBranchConditional{Condition: cond, TrueLabel: trueLabel, FalseLabel: falseLabel})
+}
+
+// SelectionMerge declares the merge block of a selection construct.
+func (b *Builder) SelectionMerge(merge Id, control SelectionControl) {
+	b.Add(&OpSelectionMerge{MergeBlock: merge, SelectionControl: control})
+}
+
+// LoopMerge declares the merge block and continue target of a loop.
+func (b *Builder) LoopMerge(merge, cont Id, control LoopControl) {
+	b.Add(&OpLoopMerge{MergeBlock: merge, ContinueTarget: cont, LoopControl: control})
+}
+
+// Return ends the current block, returning from a void function.
+func (b *Builder) Return() {
+	b.Add(&OpReturn{})
+}
+
+// ReturnValue ends the current block, returning the given value.
+func (b *Builder) ReturnValue(v Id) {
+	b.Add(&OpReturnValue{Value: v})
+}
+
+// FunctionCall calls the given function.
+func (b *Builder) FunctionCall(typ, function Id, argv ...Id) Id {
+	return b.Add(&OpFunctionCall{ResultType: typ, Function: function, Argv: argv})
+}
+
+// ExtInst calls an instruction from an imported extended instruction set.
+func (b *Builder) ExtInst(typ, set Id, instruction uint32, operands ...Id) Id {
+	return b.Add(&OpExtInst{ResultType: typ, Set: set, Instruction: instruction, Operands: operands})
+}
+
+// Load reads through the given pointer.
+func (b *Builder) Load(typ, pointer Id) Id {
+	return b.Add(&OpLoad{ResultType: typ, Pointer: pointer})
+}
+
+// Store writes the given object through a pointer.
+func (b *Builder) Store(pointer, object Id) {
+	b.Add(&OpStore{Pointer: pointer, Object: object})
+}
+
+// AccessChain yields a pointer into a composite object.
+func (b *Builder) AccessChain(typ, base Id, indices ...Id) Id {
+	return b.Add(&OpAccessChain{ResultType: typ, Base: base, Indices: indices})
+}
+
+// CompositeConstruct constructs a composite from the given constituents.
+func (b *Builder) CompositeConstruct(typ Id, constituents ...Id) Id {
+	return b.Add(&OpCompositeConstruct{ResultType: typ, Constituents: constituents})
+}
+
+// CompositeExtract extracts part of a composite object.
+func (b *Builder) CompositeExtract(typ, composite Id, indices ...uint32) Id {
+	return b.Add(&OpCompositeExtract{ResultType: typ, Composite: composite, Indices: indices})
+}
+
+// VectorShuffle selects components from two vectors.
+func (b *Builder) VectorShuffle(typ, v1, v2 Id, components ...uint32) Id {
+	return b.Add(&OpVectorShuffle{ResultType: typ, Vector1: v1, Vector2: v2, Components: components})
+}
+
+// Phi selects a value depending on the predecessor block. The operands
+// are (value, parent block) pairs.
+func (b *Builder) Phi(typ Id, operands ...Id) Id {
+	return b.Add(&OpPhi{ResultType: typ, Operands: operands})
+}
+
+// Select chooses between two objects, depending on a condition.
+func (b *Builder) Select(typ, cond, x, y Id) Id {
+	return b.Add(&OpSelect{ResultType: typ, Condition: cond, Object1: x, Object2: y})
+}
+
+// SNegate negates a signed integer.
+func (b *Builder) SNegate(typ, x Id) Id {
+	return b.Add(&OpSNegate{ResultType: typ, Operand: x})
+}
+
+// FNegate negates a float.
+func (b *Builder) FNegate(typ, x Id) Id {
+	return b.Add(&OpFNegate{ResultType: typ, Operand: x})
+}
+
+// IAdd adds two integers.
+func (b *Builder) IAdd(typ, x, y Id) Id {
+	return b.Add(&OpIAdd{ResultType: typ, Operand1: x, Operand2: y})
+}
+
+// FAdd adds two floats.
+func (b *Builder) FAdd(typ, x, y Id) Id {
+	return b.Add(&OpFAdd{ResultType: typ, Operand1: x, Operand2: y})
+}
+
+// ISub subtracts two integers.
+func (b *Builder) ISub(typ, x, y Id) Id {
+	return b.Add(&OpISub{ResultType: typ, Operand1: x, Operand2: y})
+}
+
+// FSub subtracts two floats.
+func (b *Builder) FSub(typ, x, y Id) Id {
+	return b.Add(&OpFSub{ResultType: typ, Operand1: x, Operand2: y})
+}
+
+// IMul multiplies two integers.
+func (b *Builder) IMul(typ, x, y Id) Id {
+	return b.Add(&OpIMul{ResultType: typ, Operand1: x, Operand2: y})
+}
+
+// FMul multiplies two floats.
+func (b *Builder) FMul(typ, x, y Id) Id {
+	return b.Add(&OpFMul{ResultType: typ, Operand1: x, Operand2: y})
+}
+
+// UDiv divides two unsigned integers.
+func (b *Builder) UDiv(typ, x, y Id) Id {
+	return b.Add(&OpUDiv{ResultType: typ, Operand1: x, Operand2: y})
+}
+
+// SDiv divides two signed integers.
+func (b *Builder) SDiv(typ, x, y Id) Id {
+	return b.Add(&OpSDiv{ResultType: typ, Operand1: x, Operand2: y})
+}
+
+// FDiv divides two floats.
+func (b *Builder) FDiv(typ, x, y Id) Id {
+	return b.Add(&OpFDiv{ResultType: typ, Operand1: x, Operand2: y})
+}
+
+// Dot computes the dot product of two float vectors.
+func (b *Builder) Dot(typ, x, y Id) Id {
+	return b.Add(&OpDot{ResultType: typ, Vector1: x, Vector2: y})
+}
+
+// IEqual compares two integers for equality.
+func (b *Builder) IEqual(typ, x, y Id) Id {
+	return b.Add(&OpIEqual{ResultType: typ, Operand1: x, Operand2: y})
+}
+
+// SLessThan tests if x < y, for signed integers.
+func (b *Builder) SLessThan(typ, x, y Id) Id {
+	return b.Add(&OpSLessThan{ResultType: typ, Operand1: x, Operand2: y})
+}
+
+// FOrdLessThan tests if x < y, for ordered floats.
+func (b *Builder) FOrdLessThan(typ, x, y Id) Id {
+	return b.Add(&OpFOrdLessThan{ResultType: typ, Operand1: x, Operand2: y})
+}
@@ -0,0 +1,145 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder()
+	b.Capability(CapabilityShader)
+	glsl := b.ExtInstImport("GLSL.std.450")
+	b.MemoryModel(AddressingModelLogical, MemoryModelGLSL450)
+
+	void := b.TypeVoid()
+	fnType := b.TypeFunction(void)
+
+	main := b.Function(void, FunctionControlNone, fnType)
+	b.Label()
+
+	// Declarations made inside the function move out of it, and local
+	// variables move to the start of the first block.
+	float := b.TypeFloat(32)
+	ptr := b.TypePointer(StorageClassFunction, float)
+	v1 := b.Variable(ptr, StorageClassFunction)
+	x := b.Load(float, v1)
+	one := b.Constant(float, 0x3f800000)
+	v2 := b.Variable(ptr, StorageClassFunction)
+	sum := b.FAdd(float, x, one)
+	abs := b.ExtInst(float, glsl, 4, sum)
+	b.Store(v2, abs)
+	b.Return()
+	b.FunctionEnd()
+
+	b.EntryPoint(ExecutionModelFragment, main, "main")
+	b.ExecutionMode(main, ExecutionModeOriginUpperLeft)
+	b.Name(main, "main")
+
+	// Declarations are only made once.
+	if have := b.TypeFloat(32); have != float {
+		t.Fatalf("TypeFloat: have %d, want %d", have, float)
+	}
+
+	if have := b.Constant(float, 0x3f800000); have != one {
+		t.Fatalf("Constant: have %d, want %d", have, one)
+	}
+
+	if have := b.ExtInstImport("GLSL.std.450"); have != glsl {
+		t.Fatalf("ExtInstImport: have %d, want %d", have, glsl)
+	}
+
+	b.Capability(CapabilityShader)
+
+	mod := b.Module()
+
+	want := InstructionList{
+		&OpCapability{Capability: CapabilityShader},
+		&OpExtInstImport{ResultId: glsl, Name: "GLSL.std.450"},
+		&OpMemoryModel{AddressingModel: AddressingModelLogical, MemoryModel: MemoryModelGLSL450},
+		&OpEntryPoint{ExecutionModel: ExecutionModelFragment, EntryPoint: main, Name: "main"},
+		&OpExecutionMode{EntryPoint: main, Mode: ExecutionModeOriginUpperLeft},
+		&OpName{Target: main, Name: "main"},
+		&OpTypeVoid{ResultId: void},
+		&OpTypeFunction{ResultId: fnType, ReturnType: void},
+		&OpTypeFloat{ResultId: float, Width: 32},
+		&OpTypePointer{ResultId: ptr, StorageClass: StorageClassFunction, Type: float},
+		&OpConstant{ResultType: float, ResultId: one, Value: []uint32{0x3f800000}},
+		&OpFunction{ResultType: void, ResultId: main, FunctionControl: FunctionControlNone, FunctionType: fnType},
+		&OpLabel{ResultId: 5},
+		&OpVariable{ResultType: ptr, ResultId: v1, StorageClass: StorageClassFunction},
+		&OpVariable{ResultType: ptr, ResultId: v2, StorageClass: StorageClassFunction},
+		&OpLoad{ResultType: float, ResultId: x, Pointer: v1},
+		&OpFAdd{ResultType: float, ResultId: sum, Operand1: x, Operand2: one},
+		&OpExtInst{ResultType: float, ResultId: abs, Set: glsl, Instruction: 4, Operands: []Id{sum}},
+		&OpStore{Pointer: v2, Object: abs},
+		&OpReturn{},
+		&OpFunctionEnd{},
+	}
+
+	if !reflect.DeepEqual(mod.Code, want) {
+		t.Fatalf("code mismatch:\nHave: %v\nWant: %v", mod.Code, want)
+	}
+
+	if mod.Header.Bound != uint32(abs)+1 {
+		t.Fatalf("bound mismatch: have %d, want %d", mod.Header.Bound, abs+1)
+	}
+
+	if err := mod.Verify(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuilderIds(t *testing.T) {
+	b := NewBuilder()
+
+	// Structure types are never merged.
+	float := b.TypeFloat(32)
+	s1 := b.TypeStruct(float)
+	s2 := b.TypeStruct(float)
+	if s1 == s2 {
+		t.Fatalf("TypeStruct: expected distinct types; have %d twice", s1)
+	}
+
+	// Neither are array and pointer types, which may be decorated
+	// differently; e.g. with another ArrayStride.
+	four := b.Constant(b.TypeInt(32, false), 4)
+	a1 := b.TypeArray(float, four)
+	b.Decorate(a1, DecorationArrayStride, 16)
+	a2 := b.TypeArray(float, four)
+	b.Decorate(a2, DecorationArrayStride, 4)
+	if a1 == a2 {
+		t.Fatalf("TypeArray: expected distinct types; have %d twice", a1)
+	}
+
+	if r1, r2 := b.TypeRuntimeArray(float), b.TypeRuntimeArray(float); r1 == r2 {
+		t.Fatalf("TypeRuntimeArray: expected distinct types; have %d twice", r1)
+	}
+
+	if p1, p2 := b.TypePointer(StorageClassUniform, a1), b.TypePointer(StorageClassUniform, a1); p1 == p2 {
+		t.Fatalf("TypePointer: expected distinct types; have %d twice", p1)
+	}
+
+	// Forward references allocate their Id up front.
+	label := b.Id()
+	b.Block(label)
+
+	// Instructions with a result Id keep it, and move the bound.
+	if have := b.Add(&OpTypeBool{ResultId: 100}); have != 100 {
+		t.Fatalf("Add: have %d, want 100", have)
+	}
+
+	if have := b.TypeBool(); have != 100 {
+		t.Fatalf("TypeBool: have %d, want 100", have)
+	}
+
+	if have := b.Id(); have != 101 {
+		t.Fatalf("Id: have %d, want 101", have)
+	}
+
+	if have := b.Module().Header.Bound; have != 102 {
+		t.Fatalf("bound mismatch: have %d, want 102", have)
+	}
+}
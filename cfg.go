@@ -0,0 +1,268 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// Block defines a basic block in a function's control-flow graph.
+// It starts with an OpLabel and ends with a block terminator.
+type Block struct {
+	Label Id  // Result Id of the block's OpLabel.
+	Start int // Address of the OpLabel in Module.Code.
+	End   int // Address of the terminator in Module.Code.
+	Index int // Index of the block in CFG.Blocks.
+
+	Succs []*Block // Successors, in the order the terminator names them.
+	Preds []*Block // Predecessors, in module order.
+}
+
+// Code returns the instructions of the block, in the given module.
+func (b *Block) Code(m *Module) InstructionList {
+	return m.Code[b.Start : b.End+1]
+}
+
+// Terminator returns the instruction which ends the block.
+func (b *Block) Terminator(m *Module) Instruction {
+	return m.Code[b.End]
+}
+
+// CFG defines the control-flow graph of a single function.
+type CFG struct {
+	Function Id  // Result Id of the function.
+	Start    int // Address of the OpFunction in Module.Code.
+	End      int // Address of the OpFunctionEnd in Module.Code.
+
+	// Blocks holds all blocks in module order. The first block is the
+	// entry block. Functions which are only declared have no blocks.
+	Blocks []*Block
+
+	// Exits holds all blocks which leave the function: those ending in
+	// OpReturn, OpReturnValue, OpKill, OpUnreachable or
+	// OpTerminateInvocation.
+	Exits []*Block
+
+	labels map[Id]*Block
+}
+
+// Entry returns the entry block of the function,
+// or nil if the function has no body.
+func (c *CFG) Entry() *Block {
+	if len(c.Blocks) == 0 {
+		return nil
+	}
+	return c.Blocks[0]
+}
+
+// Block returns the block with the given label.
+func (c *CFG) Block(label Id) (*Block, bool) {
+	b, ok := c.labels[label]
+	return b, ok
+}
+
+// ReversePostorder returns all blocks reachable from the entry block,
+// in reverse postorder. Each block comes before its successors, except
+// for those reached through back edges.
+func (c *CFG) ReversePostorder() []*Block {
+	if len(c.Blocks) == 0 {
+		return nil
+	}
+
+	type frame struct {
+		b    *Block
+		next int // Index of the next successor to visit.
+	}
+
+	visited := make([]bool, len(c.Blocks))
+	post := make([]*Block, 0, len(c.Blocks))
+	stack := []frame{{b: c.Blocks[0]}}
+	visited[0] = true
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+
+		if top.next < len(top.b.Succs) {
+			s := top.b.Succs[top.next]
+			top.next++
+
+			if !visited[s.Index] {
+				visited[s.Index] = true
+				stack = append(stack, frame{b: s})
+			}
+			continue
+		}
+
+		post = append(post, top.b)
+		stack = stack[:len(stack)-1]
+	}
+
+	for i, j := 0, len(post)-1; i < j; i, j = i+1, j-1 {
+		post[i], post[j] = post[j], post[i]
+	}
+
+	return post
+}
+
+// CFGs builds the control-flow graphs of all functions in the module.
+//
+// Returns an error if a function's body is not made up of well formed
+// blocks, or if a branch targets a label outside of its function.
+func (m *Module) CFGs() ([]*CFG, error) {
+	start := m.Code.FilterIndex(opcodeFunction, 0)
+	end := m.Code.FilterIndex(opcodeFunctionEnd, 0)
+
+	if len(start) != len(end) {
+		return nil, NewLayoutError(0, "unbalanced OpFunction and OpFunctionEnd instructions")
+	}
+
+	var literalWords func(Id) int
+	if m.Code.Index(opcodeSwitch) > -1 {
+		literalWords = m.literalWords()
+	}
+
+	out := make([]*CFG, len(start))
+
+	for i := range start {
+		if end[i] < start[i] || (i > 0 && start[i] < end[i-1]) {
+			return nil, NewLayoutError(start[i], "unbalanced OpFunction and OpFunctionEnd instructions")
+		}
+
+		cfg, err := m.newCFG(start[i], end[i], literalWords)
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = cfg
+	}
+
+	return out, nil
+}
+
+// CFG builds the control-flow graph of the function with the given Id.
+func (m *Module) CFG(function Id) (*CFG, error) {
+	cfgs, err := m.CFGs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cfg := range cfgs {
+		if cfg.Function == function {
+			return cfg, nil
+		}
+	}
+
+	return nil, NewLayoutError(0, "function %d is not defined", function)
+}
+
+// newCFG builds the control-flow graph of the function between the
+// given addresses.
+func (m *Module) newCFG(start, end int, literalWords func(Id) int) (*CFG, error) {
+	cfg := &CFG{
+		Start:  start,
+		End:    end,
+		labels: make(map[Id]*Block),
+	}
+
+	if fn, ok := m.Code[start].(*OpFunction); ok {
+		cfg.Function = fn.ResultId
+	}
+
+	// Split the body into blocks.
+	var cur *Block
+
+	for addr := start + 1; addr < end; addr++ {
+		instr := m.Code[addr]
+		opcode := instr.Opcode()
+
+		if opcode == opcodeLabel {
+			if cur != nil {
+				return nil, NewLayoutError(addr, "block %d is not terminated", cur.Label)
+			}
+
+			label, _ := instructionResultId(instr)
+			cur = &Block{Label: label, Start: addr, Index: len(cfg.Blocks)}
+			cfg.Blocks = append(cfg.Blocks, cur)
+			cfg.labels[label] = cur
+			continue
+		}
+
+		if cur == nil {
+			// Parameters precede the first block.
+			if len(cfg.Blocks) == 0 && (opcode == opcodeFunctionParameter ||
+				opcode == opcodeLine || opcode == opcodeNoLine) {
+				continue
+			}
+
+			return nil, NewLayoutError(addr, "%s is not in a block", instructionName(instr))
+		}
+
+		if isTerminator(opcode) {
+			cur.End = addr
+			cur = nil
+		}
+	}
+
+	if cur != nil {
+		return nil, NewLayoutError(end, "block %d is not terminated", cur.Label)
+	}
+
+	// Connect the blocks.
+	for _, b := range cfg.Blocks {
+		term := m.Code[b.End]
+		targets := branchTargets(term, literalWords)
+
+		if len(targets) == 0 {
+			cfg.Exits = append(cfg.Exits, b)
+			continue
+		}
+
+		for _, label := range targets {
+			s, ok := cfg.labels[label]
+			if !ok {
+				return nil, NewLayoutError(b.End, "%s: branch target %d is not a block in this function",
+					instructionName(term), label)
+			}
+
+			if !containsBlock(b.Succs, s) {
+				b.Succs = append(b.Succs, s)
+				s.Preds = append(s.Preds, b)
+			}
+		}
+	}
+
+	return cfg, nil
+}
+
+// branchTargets returns the labels the given terminator branches to.
+func branchTargets(term Instruction, literalWords func(Id) int) []Id {
+	switch v := term.(type) {
+	case *OpBranch:
+		return []Id{v.TargetLabel}
+
+	case *OpBranchConditional:
+		return []Id{v.TrueLabel, v.FalseLabel}
+
+	case *OpSwitch:
+		out := []Id{v.Default}
+
+		n := 1
+		if literalWords != nil {
+			n = literalWords(v.Selector)
+		}
+
+		for i := n; i < len(v.Target); i += n + 1 {
+			out = append(out, Id(v.Target[i]))
+		}
+
+		return out
+	}
+
+	return nil
+}
+
+func containsBlock(set []*Block, b *Block) bool {
+	for _, v := range set {
+		if v == b {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"testing"
+)
+
+// cfgCode holds a single function:
+//
+//	10 -> 11 (loop header) -> 12 (switch) -> 13, 14, 15
+//	13 -> 11 (back edge), 14 -> 16 (return), 15 (kill)
+//	17 is unreachable.
+var cfgCode = InstructionList{
+	&OpTypeVoid{ResultId: 1},
+	&OpTypeFunction{ResultId: 2, ReturnType: 1},
+	&OpTypeBool{ResultId: 3},
+	&OpTypeInt{ResultId: 4, Width: 64},
+	&OpConstantTrue{ResultType: 3, ResultId: 5},
+	&OpConstant{ResultType: 4, ResultId: 6, Value: []uint32{0, 0}},
+	&OpFunction{ResultType: 1, ResultId: 7, FunctionType: 2},
+	&OpLabel{ResultId: 10},
+	&OpBranch{TargetLabel: 11},
+	&OpLabel{ResultId: 11},
+	&OpLoopMerge{MergeBlock: 16, ContinueTarget: 13},
+	&OpBranchConditional{Condition: 5, TrueLabel: 12, FalseLabel: 16},
+	&OpLabel{ResultId: 12},
+	&OpSelectionMerge{MergeBlock: 13},
+	&OpSwitch{Selector: 6, Default: 13, Target: []uint32{1, 0, 14, 2, 0, 15}},
+	&OpLabel{ResultId: 13},
+	&OpBranch{TargetLabel: 11},
+	&OpLabel{ResultId: 14},
+	&OpBranch{TargetLabel: 16},
+	&OpLabel{ResultId: 15},
+	&OpKill{},
+	&OpLabel{ResultId: 16},
+	&OpReturn{},
+	&OpLabel{ResultId: 17},
+	&OpUnreachable{},
+	&OpFunctionEnd{},
+}
+
+func blockLabels(set []*Block) []Id {
+	out := make([]Id, len(set))
+	for i, b := range set {
+		out[i] = b.Label
+	}
+	return out
+}
+
+func TestCFG(t *testing.T) {
+	mod := NewModule()
+	mod.Code = cfgCode
+
+	cfg, err := mod.CFG(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if have, want := blockLabels(cfg.Blocks), []Id{10, 11, 12, 13, 14, 15, 16, 17}; !reflect.DeepEqual(have, want) {
+		t.Fatalf("blocks mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+
+	if cfg.Entry().Label != 10 {
+		t.Fatalf("entry mismatch: %d", cfg.Entry().Label)
+	}
+
+	if have, want := blockLabels(cfg.Exits), []Id{15, 16, 17}; !reflect.DeepEqual(have, want) {
+		t.Fatalf("exits mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+
+	for _, st := range []struct {
+		label        Id
+		succs, preds []Id
+	}{
+		{10, []Id{11}, []Id{}},
+		{11, []Id{12, 16}, []Id{10, 13}},
+		{12, []Id{13, 14, 15}, []Id{11}},
+		{13, []Id{11}, []Id{12}},
+		{16, []Id{}, []Id{11, 14}},
+		{17, []Id{}, []Id{}},
+	} {
+		b, ok := cfg.Block(st.label)
+		if !ok {
+			t.Fatalf("block %d not found", st.label)
+		}
+
+		if have := blockLabels(b.Succs); !reflect.DeepEqual(have, st.succs) {
+			t.Fatalf("block %d: successor mismatch:\nHave: %v\nWant: %v", st.label, have, st.succs)
+		}
+
+		if have := blockLabels(b.Preds); !reflect.DeepEqual(have, st.preds) {
+			t.Fatalf("block %d: predecessor mismatch:\nHave: %v\nWant: %v", st.label, have, st.preds)
+		}
+	}
+
+	b, _ := cfg.Block(12)
+	if _, ok := b.Terminator(mod).(*OpSwitch); !ok || len(b.Code(mod)) != 3 {
+		t.Fatalf("block code mismatch: %v", b.Code(mod))
+	}
+
+	if have, want := blockLabels(cfg.ReversePostorder()), []Id{10, 11, 12, 15, 14, 16, 13}; !reflect.DeepEqual(have, want) {
+		t.Fatalf("reverse postorder mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+}
+
+func TestCFGInvalid(t *testing.T) {
+	for i, code := range []InstructionList{
+		{
+			// Unterminated block.
+			&OpFunction{ResultId: 1},
+			&OpLabel{ResultId: 2},
+			&OpLabel{ResultId: 3},
+			&OpReturn{},
+			&OpFunctionEnd{},
+		},
+		{
+			// Instruction outside of a block.
+			&OpFunction{ResultId: 1},
+			&OpLabel{ResultId: 2},
+			&OpReturn{},
+			&OpNop{},
+			&OpFunctionEnd{},
+		},
+		{
+			// Branch out of the function.
+			&OpFunction{ResultId: 1},
+			&OpLabel{ResultId: 2},
+			&OpBranch{TargetLabel: 3},
+			&OpFunctionEnd{},
+		},
+		{
+			// Missing OpFunctionEnd.
+			&OpFunction{ResultId: 1},
+			&OpLabel{ResultId: 2},
+			&OpReturn{},
+		},
+	} {
+		mod := NewModule()
+		mod.Code = code
+
+		_, err := mod.CFGs()
+		if err == nil {
+			t.Fatalf("case %d: expected failure", i)
+		}
+	}
+}
+
+func TestInstructionListBlocks(t *testing.T) {
+	mod := NewModule()
+	mod.Code = cfgCode
+
+	blocks := mod.Code.Blocks()
+	if len(blocks) != 8 {
+		t.Fatalf("expected 8 blocks; have %d", len(blocks))
+	}
+
+	if _, ok := blocks[2][len(blocks[2])-1].(*OpSwitch); !ok {
+		t.Fatalf("expected block to end in OpSwitch; have %v", blocks[2])
+	}
+}
@@ -0,0 +1,77 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/andreas-jonsson/spirv/asm"
+)
+
+func main() {
+	in, out, verify := parseArgs()
+
+	fd, err := os.Open(in)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	defer fd.Close()
+
+	module, err := asm.Read(fd)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s:%v\n", in, err)
+		os.Exit(1)
+	}
+
+	if verify {
+		err = module.Verify()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", in, err)
+			os.Exit(1)
+		}
+	}
+
+	fd, err = os.Create(out)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	defer fd.Close()
+
+	err = module.Save(fd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// parseArgs parses and validates command line arguments.
+func parseArgs() (string, string, bool) {
+	flag.Usage = func() {
+		fmt.Println("usage:", AppName, "[options] <assembly file>")
+		flag.PrintDefaults()
+	}
+
+	version := flag.Bool("version", false, "Display version information.")
+	out := flag.String("o", "out.spv", "Name of the binary module to write.")
+	verify := flag.Bool("verify", false, "Verify the module before writing it.")
+	flag.Parse()
+
+	if *version {
+		fmt.Println(Version())
+		os.Exit(0)
+	}
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	return flag.Arg(0), *out, *verify
+}
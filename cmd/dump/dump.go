@@ -30,8 +30,8 @@ func dumpHeader(hdr spirv.Header) {
 
 // dumpInstruction prints a human-readable dump of the given instruction.
 func dumpInstruction(i spirv.Instruction) {
-	name := fmt.Sprintf("%T", i)
-	name = name[len("*spirv.Op"):]
+	name := reflect.Indirect(reflect.ValueOf(i)).Type().Name()
+	name = strings.TrimPrefix(name, "Op")
 	fields := instructionFields(i)
 
 	fmt.Println(" ", name)
@@ -54,8 +54,14 @@ func instructionFields(i spirv.Instruction) [][]string {
 		fv := rv.Field(i)
 		ft := rt.Field(i)
 
+		// Optional operands may be held by pointer.
+		if fv.Kind() == reflect.Ptr && !fv.IsNil() {
+			fv = fv.Elem()
+		}
+
 		v := fv.Interface()
 		k := fmt.Sprintf("%T", v)
+		k = strings.Replace(k, "prerelease.", "", -1)
 		k = strings.Replace(k, "spirv.", "", -1)
 
 		out[i] = []string{
@@ -4,15 +4,29 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
 
 	"github.com/andreas-jonsson/spirv"
+	"github.com/andreas-jonsson/spirv/disasm"
+)
+
+// Output formats selected with -format.
+const (
+	formatFields = "fields"
+	formatAsm    = "asm"
+	formatJSON   = "json"
+)
+
+var (
+	format = flag.String("format", formatFields, "Output format: "+formatFields+", "+formatAsm+" or "+formatJSON+".")
+	rawIds = flag.Bool("raw-ids", false, "Print Ids as numbers, rather than by name, in the "+formatAsm+" format.")
 )
 
 func main() {
-	file := parseArgs()
+	file, opts := parseArgs()
 
 	fd, err := os.Open(file)
 	if err != nil {
@@ -22,23 +36,40 @@ func main() {
 
 	defer fd.Close()
 
-	module, err := spirv.Load(fd)
+	module, err := spirv.LoadWithOptions(fd, opts)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 
-	dump(module)
+	switch *format {
+	case formatAsm:
+		err = disasm.WriteWithOptions(os.Stdout, module, disasm.Options{RawIds: *rawIds})
+	case formatJSON:
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(module)
+	default:
+		dump(module)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
 
 // parseArgs parses and validates command line arguments.
-func parseArgs() string {
+func parseArgs() (string, spirv.DecoderOptions) {
 	flag.Usage = func() {
 		fmt.Println("usage:", AppName, "[options] <module file>")
 		flag.PrintDefaults()
 	}
 
+	var opts spirv.DecoderOptions
+
 	version := flag.Bool("version", false, "Display version information.")
+	flag.BoolVar(&opts.KeepRaw, "raw", false, "Keep instructions which are not understood, rather than failing.")
 	flag.Parse()
 
 	if *version {
@@ -46,12 +77,19 @@ func parseArgs() string {
 		os.Exit(0)
 	}
 
+	switch *format {
+	case formatFields, formatAsm, formatJSON:
+	default:
+		fmt.Fprintf(os.Stderr, "unknown format %q\n", *format)
+		os.Exit(1)
+	}
+
 	if flag.NArg() == 0 {
 		flag.Usage()
 		os.Exit(1)
 	}
 
-	return flag.Arg(0)
+	return flag.Arg(0), opts
 }
 
 func makeNew(file string) {
@@ -65,7 +103,10 @@ func makeNew(file string) {
 
 	mod := spirv.NewModule()
 	mod.Code = []spirv.Instruction{
-		&spirv.OpSource{spirv.SourceLanguageGLSL, 450},
+		&spirv.OpSource{
+			SourceLanguage: spirv.SourceLanguageGLSL,
+			Version:        450,
+		},
 		&spirv.OpExtInst{
 			ResultType:  1,
 			ResultId:    2,
@@ -74,10 +115,10 @@ func makeNew(file string) {
 			Operands:    []spirv.Id{5, 4, 5},
 		},
 		&spirv.OpFunction{
-			ResultType:   0,
-			ResultId:     1,
-			ControlMask:  spirv.FunctionControlMaskInLine,
-			FunctionType: 2,
+			ResultType:      0,
+			ResultId:        1,
+			FunctionControl: spirv.FunctionControlInline,
+			FunctionType:    2,
 		},
 		&spirv.OpFunctionParameter{
 			ResultType: 0,
@@ -0,0 +1,86 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "reflect"
+
+// OperandCodec is implemented by instructions which know how to encode and
+// decode their own operands. The Encoder and Decoder use it in favour of
+// reflection, which is only used as a fallback for instructions which do
+// not implement it.
+//
+// All instructions in this package have generated implementations.
+// Custom instructions may implement it to get the same benefits.
+type OperandCodec interface {
+	// OperandLen returns the number of words the instruction operands
+	// will occupy once encoded. This excludes the opcode word.
+	OperandLen() int
+
+	// EncodeOperands writes the encoded operands into out and returns
+	// the number of words written. Out must be at least OperandLen()
+	// words long.
+	EncodeOperands(out []uint32) int
+
+	// DecodeOperands decodes the given operand words into the
+	// instruction. The words must not be retained after the call.
+	DecodeOperands(argv []uint32) error
+}
+
+// operandLen returns the number of words occupied by the operands of i.
+func operandLen(i Instruction) int {
+	if c, ok := i.(OperandCodec); ok {
+		return c.OperandLen()
+	}
+
+	return encodedValueLen(reflect.Indirect(reflect.ValueOf(i)))
+}
+
+// encodeOperands encodes the operands of i into out.
+// Returns the number of words written.
+func encodeOperands(i Instruction, out []uint32) (int, error) {
+	if c, ok := i.(OperandCodec); ok {
+		return c.EncodeOperands(out), nil
+	}
+
+	argc, err := encodeValue(reflect.Indirect(reflect.ValueOf(i)), out)
+	return int(argc), err
+}
+
+// decodeOperands decodes the given operand words into i.
+func decodeOperands(i Instruction, argv []uint32) error {
+	if c, ok := i.(OperandCodec); ok {
+		return c.DecodeOperands(argv)
+	}
+
+	_, err := decodeValue(reflect.ValueOf(i), argv)
+	return err
+}
+
+// decodeIds returns a copy of the given words as a list of Ids.
+func decodeIds(argv []uint32) []Id {
+	if len(argv) == 0 {
+		return nil
+	}
+
+	ids := make([]Id, len(argv))
+	for i, word := range argv {
+		ids[i] = Id(word)
+	}
+
+	return ids
+}
+
+// decodeStringOperand decodes a string literal from the start of argv.
+// It returns the string and the words following it.
+func decodeStringOperand(argv []uint32) (String, []uint32) {
+	str := DecodeString(argv)
+	size := int(str.EncodedLen())
+
+	// A string which is not nul-terminated consumes all words.
+	if size > len(argv) {
+		size = len(argv)
+	}
+
+	return str, argv[size:]
+}
@@ -0,0 +1,386 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// TestOperandCodec ensures the generated operand codecs behave exactly like
+// the reflection based codec, for both empty instructions and instructions
+// with all operands present.
+func TestOperandCodec(t *testing.T) {
+	for _, opcode := range Opcodes() {
+		constructor, _ := Lookup(uint32(opcode))
+
+		empty := constructor()
+		testOperandCodec(t, empty)
+
+		full := constructor()
+		next := uint32(1)
+		fillValue(reflect.ValueOf(full).Elem(), &next)
+		testOperandCodec(t, full)
+	}
+}
+
+func testOperandCodec(t *testing.T, instr Instruction) {
+	name := instructionName(instr)
+
+	codec, ok := instr.(OperandCodec)
+	if !ok {
+		t.Fatalf("%s: no operand codec", name)
+	}
+
+	rv := reflect.ValueOf(instr).Elem()
+
+	want := encodedValueLen(rv)
+	have := codec.OperandLen()
+	if have != want {
+		t.Fatalf("%s: length mismatch:\nHave: %d\nWant: %d", name, have, want)
+	}
+
+	wantWords := make([]uint32, want)
+	argc, err := encodeValue(rv, wantWords)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+
+	if int(argc) != want {
+		t.Fatalf("%s: reflection wrote %d words; want %d", name, argc, want)
+	}
+
+	haveWords := make([]uint32, have)
+	if n := codec.EncodeOperands(haveWords); n != have {
+		t.Fatalf("%s: codec wrote %d words; want %d", name, n, have)
+	}
+
+	if !reflect.DeepEqual(haveWords, wantWords) {
+		t.Fatalf("%s: encode mismatch:\nHave: %v\nWant: %v", name, haveWords, wantWords)
+	}
+
+	constructor, _ := Lookup(instr.Opcode())
+
+	wantInstr := constructor()
+	_, wantErr := decodeValue(reflect.ValueOf(wantInstr), wantWords)
+
+	haveInstr := constructor()
+	haveErr := haveInstr.(OperandCodec).DecodeOperands(wantWords)
+
+	if !reflect.DeepEqual(haveErr, wantErr) {
+		t.Fatalf("%s: decode error mismatch:\nHave: %v\nWant: %v", name, haveErr, wantErr)
+	}
+
+	if !reflect.DeepEqual(haveInstr, wantInstr) {
+		t.Fatalf("%s: decode mismatch:\nHave: %+v\nWant: %+v", name, haveInstr, wantInstr)
+	}
+
+	if wantErr == nil && !reflect.DeepEqual(haveInstr, instr) {
+		t.Fatalf("%s: roundtrip mismatch:\nHave: %+v\nWant: %+v", name, haveInstr, instr)
+	}
+}
+
+// fillValue assigns distinct, non-zero values to rv and all its fields.
+func fillValue(rv reflect.Value, next *uint32) {
+	switch rv.Kind() {
+	case reflect.Ptr:
+		rv.Set(reflect.New(rv.Type().Elem()))
+		fillValue(rv.Elem(), next)
+
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			fillValue(rv.Field(i), next)
+		}
+
+	case reflect.Slice:
+		rv.Set(reflect.MakeSlice(rv.Type(), 2, 2))
+		for i := 0; i < rv.Len(); i++ {
+			fillValue(rv.Index(i), next)
+		}
+
+	case reflect.Uint32:
+		rv.SetUint(uint64(*next))
+		*next++
+
+	case reflect.String:
+		rv.SetString(fmt.Sprintf("operand %d", *next))
+		*next++
+	}
+}
+
+// benchmarkModule builds a module which is at least size bytes large.
+// It consists of a single function, with a mix of instructions
+// commonly found in shaders.
+func benchmarkModule(size int) *Module {
+	aligned := MemoryAccess(MemoryAccessAligned)
+
+	mod := NewModule()
+	mod.Code = InstructionList{
+		&OpCapability{Capability: CapabilityShader},
+		&OpExtInstImport{ResultId: 1, Name: "GLSL.std.450"},
+		&OpMemoryModel{
+			AddressingModel: AddressingModelLogical,
+			MemoryModel:     MemoryModelGLSL450,
+		},
+		&OpEntryPoint{
+			ExecutionModel: ExecutionModelFragment,
+			EntryPoint:     4,
+			Name:           "main",
+			Interface:      []Id{5, 6},
+		},
+		&OpExecutionMode{EntryPoint: 4, Mode: ExecutionModeOriginUpperLeft},
+		&OpName{Target: 4, Name: "main"},
+		&OpDecorate{Target: 5, Decoration: DecorationLocation, Argv: []uint32{0}},
+		&OpDecorate{Target: 6, Decoration: DecorationLocation, Argv: []uint32{0}},
+		&OpTypeVoid{ResultId: 2},
+		&OpTypeFunction{ResultId: 3, ReturnType: 2},
+		&OpTypeFloat{ResultId: 7, Width: 32},
+		&OpTypeVector{ResultId: 8, ComponentType: 7, ComponentCount: 4},
+		&OpTypePointer{ResultId: 9, StorageClass: StorageClassInput, Type: 8},
+		&OpTypePointer{ResultId: 10, StorageClass: StorageClassOutput, Type: 8},
+		&OpVariable{ResultType: 9, ResultId: 5, StorageClass: StorageClassInput},
+		&OpVariable{ResultType: 10, ResultId: 6, StorageClass: StorageClassOutput},
+		&OpTypeInt{ResultId: 11, Width: 32},
+		&OpConstant{ResultType: 11, ResultId: 12, Value: []uint32{0}},
+		&OpFunction{ResultType: 2, ResultId: 4, FunctionType: 3},
+		&OpLabel{ResultId: 13},
+	}
+
+	id := Id(14)
+	for words := 0; words*4 < size; {
+		a, b, c, d, e := id, id+1, id+2, id+3, id+4
+		id += 5
+
+		block := []Instruction{
+			&OpLoad{ResultType: 8, ResultId: a, Pointer: 5, MemoryAccess: &aligned, Argv: []uint32{16}},
+			&OpFAdd{ResultType: 8, ResultId: b, Operand1: a, Operand2: a},
+			&OpExtInst{ResultType: 8, ResultId: c, Set: 1, Instruction: 46, Operands: []Id{a, b, b}},
+			&OpVectorShuffle{ResultType: 8, ResultId: d, Vector1: b, Vector2: c, Components: []uint32{0, 1, 4, 5}},
+			&OpCompositeExtract{ResultType: 7, ResultId: e, Composite: d, Indices: []uint32{0}},
+			&OpStore{Pointer: 6, Object: d},
+		}
+
+		for _, instr := range block {
+			words += EncodedLen(instr)
+		}
+
+		mod.Code = append(mod.Code, block...)
+	}
+
+	mod.Code = append(mod.Code, &OpReturn{}, &OpFunctionEnd{})
+	mod.Header.Bound = uint32(id)
+	return mod
+}
+
+// benchmarkWords returns the encoded instructions of a module of the
+// given size, one word slice per instruction.
+func benchmarkWords(b *testing.B, size int) [][]uint32 {
+	mod := benchmarkModule(size)
+	out := make([][]uint32, len(mod.Code))
+
+	for i, instr := range mod.Code {
+		words := make([]uint32, EncodedLen(instr))
+
+		argc, err := encodeOperands(instr, words[1:])
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		words[0] = EncodeOpcode(uint32(argc+1), instr.Opcode())
+		out[i] = words
+	}
+
+	return out
+}
+
+const benchmarkSize = 4 << 20
+
+func BenchmarkLoad(b *testing.B) {
+	var buf bytes.Buffer
+	err := benchmarkModule(benchmarkSize).Save(&buf)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	data := buf.Bytes()
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := Load(bytes.NewReader(data))
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLoadBytes(b *testing.B) {
+	var buf bytes.Buffer
+	err := benchmarkModule(benchmarkSize).Save(&buf)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	data := buf.Bytes()
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := LoadBytes(data)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkScanBytes measures iterating over the instructions of a module
+// in memory, without decoding their operands.
+func BenchmarkScanBytes(b *testing.B) {
+	var buf bytes.Buffer
+	err := benchmarkModule(benchmarkSize).Save(&buf)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	data := buf.Bytes()
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		dec := NewDecoderBytes(data)
+
+		_, err := dec.DecodeHeader()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for {
+			_, err := dec.DecodeInstructionWords()
+			if err == io.EOF {
+				break
+			}
+
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkSave(b *testing.B) {
+	mod := benchmarkModule(benchmarkSize)
+
+	var buf bytes.Buffer
+	err := mod.Save(&buf)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.SetBytes(int64(buf.Len()))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+
+		err := mod.Save(&buf)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkModuleBytes(b *testing.B) {
+	mod := benchmarkModule(benchmarkSize)
+
+	b.SetBytes(int64(mod.EncodedLen()))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := mod.Bytes()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkDecodeOperands compares the generated operand decoders against
+// the reflection based fallback.
+func BenchmarkDecodeOperands(b *testing.B) {
+	code := benchmarkWords(b, benchmarkSize)
+
+	b.Run("generated", func(b *testing.B) {
+		benchmarkDecode(b, code, decodeOperands)
+	})
+
+	b.Run("reflect", func(b *testing.B) {
+		benchmarkDecode(b, code, func(i Instruction, argv []uint32) error {
+			_, err := decodeValue(reflect.ValueOf(i), argv)
+			return err
+		})
+	})
+}
+
+func benchmarkDecode(b *testing.B, code [][]uint32, decode func(Instruction, []uint32) error) {
+	b.SetBytes(int64(benchmarkSize))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		for _, words := range code {
+			constructor, _ := Lookup(words[0] & 0xffff)
+			instr := constructor()
+
+			err := decode(instr, words[1:])
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkEncodeOperands compares the generated operand encoders against
+// the reflection based fallback.
+func BenchmarkEncodeOperands(b *testing.B) {
+	code := benchmarkModule(benchmarkSize).Code
+
+	b.Run("generated", func(b *testing.B) {
+		benchmarkEncode(b, code, func(i Instruction, out []uint32) (int, error) {
+			return encodeOperands(i, out[:operandLen(i)])
+		})
+	})
+
+	b.Run("reflect", func(b *testing.B) {
+		benchmarkEncode(b, code, func(i Instruction, out []uint32) (int, error) {
+			rv := reflect.Indirect(reflect.ValueOf(i))
+			argc, err := encodeValue(rv, out[:encodedValueLen(rv)])
+			return int(argc), err
+		})
+	})
+}
+
+func benchmarkEncode(b *testing.B, code InstructionList, encode func(Instruction, []uint32) (int, error)) {
+	out := make([]uint32, 64)
+
+	b.SetBytes(int64(benchmarkSize))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		for _, instr := range code {
+			_, err := encode(instr, out)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
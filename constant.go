@@ -1,36 +1,47 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
 package spirv
 
-import "errors"
+import (
+	"errors"
+	"math/bits"
+)
 
 var (
-	ErrInvalidAccessQualifier       = errors.New("invalid AccessQualifier value")
-	ErrInvalidAddressingModel       = errors.New("invalid AddressingModel value")
-	ErrInvalidDimensionality        = errors.New("invalid Dimensionality value")
-	ErrInvalidExecutionMode         = errors.New("invalid ExecutionMode value")
-	ErrInvalidExecutionModel        = errors.New("invalid ExecutionModel value")
-	ErrInvalidFPFastMathMode        = errors.New("invalid FPFastMathMode value")
-	ErrInvalidKernelProfilingInfo   = errors.New("invalid KernelProfilingInfo value")
-	ErrInvalidKernelEnqueueFlag     = errors.New("invalid KernelEnqueueFlag value")
-	ErrInvalidGroupOperation        = errors.New("invalid GroupOperation value")
-	ErrInvalidExecutionScope        = errors.New("invalid ExecutionScope value")
-	ErrInvalidMemoryAccess          = errors.New("invalid MemoryAccess value")
-	ErrInvalidMemorySemantic        = errors.New("invalid MemorySemantic value")
-	ErrInvalidFunctionControlMask   = errors.New("invalid FunctionControlMask value")
-	ErrInvalidLoopControl           = errors.New("invalid LoopControl value")
-	ErrInvalidSelectionControl      = errors.New("invalid SelectionControl value")
-	ErrInvalidBuiltin               = errors.New("invalid Builtin value")
-	ErrInvalidDecoration            = errors.New("invalid Decoration value")
-	ErrInvalidFunctionParameter     = errors.New("invalid FunctionParameter value")
-	ErrInvalidStorageClass          = errors.New("invalid StorageClass value")
-	ErrInvalidSourceLanguage        = errors.New("invalid SourceLanguage value")
-	ErrInvalidSamplerFilterMode     = errors.New("invalid SamplerFilterMode value")
-	ErrInvalidSamplerAddressingMode = errors.New("invalid SamplerAddressingMode value")
-	ErrInvalidMemoryModel           = errors.New("invalid MemoryModel value")
-	ErrInvalidLinkageType           = errors.New("invalid LinkageType value")
-	ErrInvalidFPRoundingMode        = errors.New("invalid FPRoundingMode value")
+	ErrInvalidImageOperands              = errors.New("invalid ImageOperands value")
+	ErrInvalidFPFastMathMode             = errors.New("invalid FPFastMathMode value")
+	ErrInvalidSelectionControl           = errors.New("invalid SelectionControl value")
+	ErrInvalidLoopControl                = errors.New("invalid LoopControl value")
+	ErrInvalidFunctionControl            = errors.New("invalid FunctionControl value")
+	ErrInvalidMemorySemantics            = errors.New("invalid MemorySemantics value")
+	ErrInvalidMemoryAccess               = errors.New("invalid MemoryAccess value")
+	ErrInvalidKernelProfilingInfo        = errors.New("invalid KernelProfilingInfo value")
+	ErrInvalidSourceLanguage             = errors.New("invalid SourceLanguage value")
+	ErrInvalidExecutionModel             = errors.New("invalid ExecutionModel value")
+	ErrInvalidAddressingModel            = errors.New("invalid AddressingModel value")
+	ErrInvalidMemoryModel                = errors.New("invalid MemoryModel value")
+	ErrInvalidExecutionMode              = errors.New("invalid ExecutionMode value")
+	ErrInvalidStorageClass               = errors.New("invalid StorageClass value")
+	ErrInvalidDim                        = errors.New("invalid Dim value")
+	ErrInvalidSamplerAddressingMode      = errors.New("invalid SamplerAddressingMode value")
+	ErrInvalidSamplerFilterMode          = errors.New("invalid SamplerFilterMode value")
+	ErrInvalidImageFormat                = errors.New("invalid ImageFormat value")
+	ErrInvalidImageChannelOrder          = errors.New("invalid ImageChannelOrder value")
+	ErrInvalidImageChannelDataType       = errors.New("invalid ImageChannelDataType value")
+	ErrInvalidFPRoundingMode             = errors.New("invalid FPRoundingMode value")
+	ErrInvalidLinkageType                = errors.New("invalid LinkageType value")
+	ErrInvalidAccessQualifier            = errors.New("invalid AccessQualifier value")
+	ErrInvalidFunctionParameterAttribute = errors.New("invalid FunctionParameterAttribute value")
+	ErrInvalidDecoration                 = errors.New("invalid Decoration value")
+	ErrInvalidBuiltIn                    = errors.New("invalid BuiltIn value")
+	ErrInvalidScope                      = errors.New("invalid Scope value")
+	ErrInvalidGroupOperation             = errors.New("invalid GroupOperation value")
+	ErrInvalidKernelEnqueueFlags         = errors.New("invalid KernelEnqueueFlags value")
+	ErrInvalidCapability                 = errors.New("invalid Capability value")
+	ErrInvalidPackedVectorFormat         = errors.New("invalid PackedVectorFormat value")
 )
 
 // verifyBitFlag returns true if v is a valid bit flag in the
@@ -39,1145 +50,1717 @@ func verifyBitFlag(v uint32, none bool, mask uint32) bool {
 	return v == (v&mask) && (none || v != 0)
 }
 
-type AccessQualifier uint32
+type ImageOperands uint32
 
-func (v AccessQualifier) Verify() error {
-	if v >= AccessQualifierReadOnly && v <= AccessQualifierReadWrite {
+func (v ImageOperands) Verify() error {
+	if verifyBitFlag(
+		uint32(v),
+		true,
+		ImageOperandsBias|
+			ImageOperandsLod|
+			ImageOperandsGrad|
+			ImageOperandsConstOffset|
+			ImageOperandsOffset|
+			ImageOperandsConstOffsets|
+			ImageOperandsSample|
+			ImageOperandsMinLod|
+			ImageOperandsMakeTexelAvailable|
+			ImageOperandsMakeTexelVisible|
+			ImageOperandsNonPrivateTexel|
+			ImageOperandsVolatileTexel|
+			ImageOperandsSignExtend|
+			ImageOperandsZeroExtend|
+			ImageOperandsNontemporal,
+	) {
 		return nil
 	}
-	return ErrInvalidAccessQualifier
+	return ErrInvalidImageOperands
 }
 
-// Access Qualifiers define the access permissions of OpTypeSampler
-// and OpTypePipe object.
+// ImageOperands is a mask of additional operands to image instructions.
 const (
-	AccessQualifierReadOnly  = 0 // A read-only object.
-	AccessQualifierWriteOnly = 1 // A write-only object.
-	AccessQualifierReadWrite = 2 // A readable and writable object.
+	ImageOperandsNone               = 0
+	ImageOperandsBias               = 0x1
+	ImageOperandsLod                = 0x2
+	ImageOperandsGrad               = 0x4
+	ImageOperandsConstOffset        = 0x8
+	ImageOperandsOffset             = 0x10
+	ImageOperandsConstOffsets       = 0x20
+	ImageOperandsSample             = 0x40
+	ImageOperandsMinLod             = 0x80
+	ImageOperandsMakeTexelAvailable = 0x100
+	ImageOperandsMakeTexelVisible   = 0x200
+	ImageOperandsNonPrivateTexel    = 0x400
+	ImageOperandsVolatileTexel      = 0x800
+	ImageOperandsSignExtend         = 0x1000
+	ImageOperandsZeroExtend         = 0x2000
+	ImageOperandsNontemporal        = 0x4000
 )
 
-type AddressingModel uint32
+// argc returns the number of extra operands which follow a ImageOperands
+// mask with these bits set.
+func (v ImageOperands) argc() int {
+	const argc1 = ImageOperandsBias | ImageOperandsLod | ImageOperandsConstOffset |
+		ImageOperandsOffset | ImageOperandsConstOffsets | ImageOperandsSample |
+		ImageOperandsMinLod | ImageOperandsMakeTexelAvailable |
+		ImageOperandsMakeTexelVisible
+	const argc2 = ImageOperandsGrad
 
-func (v AddressingModel) Verify() error {
-	if v >= AddressingModeLogical && v <= AddressingModePhysical64 {
-		return nil
-	}
-	return ErrInvalidAddressingModel
+	return bits.OnesCount32(uint32(v)&argc1) + 2*bits.OnesCount32(uint32(v)&argc2)
 }
 
-// Addressing Modes define an existing addressing mode.
-const (
-	AddressingModeLogical    = 0
-	AddressingModePhysical32 = 1
-	AddressingModePhysical64 = 2
-)
+func (v ImageOperands) String() string { return bitString("ImageOperands", uint32(v)) }
 
-type Dimensionality uint32
+type FPFastMathMode uint32
 
-func (v Dimensionality) Verify() error {
-	if v >= Dim1D && v <= DimBuffer {
+func (v FPFastMathMode) Verify() error {
+	if verifyBitFlag(
+		uint32(v),
+		true,
+		FPFastMathModeNotNaN|
+			FPFastMathModeNotInf|
+			FPFastMathModeNSZ|
+			FPFastMathModeAllowRecip|
+			FPFastMathModeFast,
+	) {
 		return nil
 	}
-	return ErrInvalidDimensionality
+	return ErrInvalidFPFastMathMode
 }
 
-// Dimensionalities define the dimensionality of a texture.
+// FPFastMathMode enables fast math operations which are otherwise unsafe.
 const (
-	Dim1D     = 0
-	Dim2D     = 1
-	Dim3D     = 2
-	DimCube   = 3
-	DimRect   = 4
-	DimBuffer = 5
+	FPFastMathModeNone       = 0
+	FPFastMathModeNotNaN     = 0x1
+	FPFastMathModeNotInf     = 0x2
+	FPFastMathModeNSZ        = 0x4
+	FPFastMathModeAllowRecip = 0x8
+	FPFastMathModeFast       = 0x10
 )
 
-type ExecutionMode uint32
+func (v FPFastMathMode) String() string { return bitString("FPFastMathMode", uint32(v)) }
 
-func (v ExecutionMode) Verify() error {
-	if v >= ExecutionModeInvocations && v <= ExecutionModeContractionOff {
+type SelectionControl uint32
+
+func (v SelectionControl) Verify() error {
+	if verifyBitFlag(
+		uint32(v),
+		true,
+		SelectionControlFlatten|
+			SelectionControlDontFlatten,
+	) {
 		return nil
 	}
-	return ErrInvalidExecutionMode
+	return ErrInvalidSelectionControl
 }
 
-// Execution Modes define a mode a module’s stage will execute in.
+// SelectionControl is a mask of hints for flattening of flow control
+// structures.
 const (
-	// Number of times to invoke the geometry stage for each input primitive
-	// received. The default is to run once for each input primitive.
-	// If greater than the target-dependent maximum, it will fail to compile.
-	// Only valid with the Geometry Execution Model.
-	//
-	// Arguments:
-	//
-	//   [0]: Number of invocations.
-	//
-	ExecutionModeInvocations = 0
-
-	// Requests the tessellation primitive generator to divide edges into a
-	// collection of equal-sized segments. Only valid with one of the
-	// tessellation Execution Models.
-	ExecutionModeSpacingEqual = 1
-
-	// Requests the tessellation primitive generator to divide edges into an
-	// even number of equal-length segments plus two additional shorter
-	// fractional segments. Only valid with one of the tessellation
-	// Execution Models.
-	ExecutionModeSpacingFractionalEven = 2
-
-	// Requests the tessellation primitive generator to divide edges into an
-	// even number of equal-length segments plus two additional shorter
-	// fractional segments. Only valid with one of the tessellation.
-	// Execution Models.
-	ExecutionModeSpacingFractionalOdd = 3
-
-	// Requests the tessellation primitive generator to generate triangles in
-	// clockwise order. Only valid with one of the tessellation Execution Models.
-	ExecutionModeVertexOrderCw = 4
-
-	// Requests the tessellation primitive generator to generate triangles in
-	// counter-clockwise order. Only valid with one of the tessellation
-	// Execution Models.
-	ExecutionModeVertexOrderCcw = 5
-
-	// Pixels appear centered on whole-number pixel offsets. E.g., the
-	// coordinate (0.5, 0.5) appears to move to (0.0, 0.0). Only valid with
-	// the Fragment Execution Model.
-	ExecutionModePixelCenterInteger = 6
-
-	// Pixel coordinates appear to originate in the upper left, and increase
-	// toward the right and downward. Only valid with the Fragment Execution Model.
-	ExecutionModeOriginUpperLeft = 7
-
-	// Fragment tests are to be performed before fragment shader execution.
-	// Only valid with the Fragment Execution Model.
-	ExecutionModeEarlyFragmentTests = 8
-
-	// Requests the tessellation primitive generator to generate a point for
-	// each distinct vertex in the subdivided primitive, rather than to
-	// generate lines or triangles. Only valid with one of the tessellation
-	// Execution Models.
-	ExecutionModePointMode = 9
-
-	// This stage will run in transform feedback-capturing mode and this module
-	// is responsible for describing the transform-feedback setup.
-	// See the XfbBuffer, Offset, and Stride Decorations.
-	ExecutionModeXFB = 10
-
-	// This mode must be declared if this module potentially changes the
-	// fragment’s depth. Only valid with the Fragment Execution Model.
-	ExecutionModeDepthReplacing = 11
-
-	// TODO: this should probably be removed. Depth testing will always be
-	// performed after the shader has executed. Only valid with the Fragment
-	// Execution Model.
-	ExecutionModeDepthAny = 12
-
-	// External optimizations may assume depth modifications will leave the
-	// fragment’s depth as greater than or equal to the fragment’s interpolated
-	// depth value (given by the z component of the FragCoord Built-In
-	// decorated variable). Only valid with the Fragment Execution Model.
-	ExecutionModeDepthGreater = 13
-
-	// External optimizations may assume depth modifications leave the
-	// fragment’s depth less than the fragment’s interpolated depth
-	// value, (given by the z component of the FragCoord Built-In decorated
-	// variable). Only valid with the Fragment Execution Model.
-	ExecutionModeDepthLess = 14
-
-	// External optimizations may assume this stage did not modify the
-	// fragment’s depth. However, DepthReplacing mode must accurately
-	// represent depth modification. Only valid with the Fragment Execution Model.
-	ExecutionModeDepthUnchanged = 15
-
-	// Indicates the work-group size in the x, y, and z dimensions. Only valid
-	// with the GLCompute or Kernel Execution Models.
-	//
-	// Arguments:
-	//
-	//   [0]: x size
-	//   [1]: y size
-	//   [2]: z size
-	//
-	ExecutionModeLocalSize = 16
-
-	// A hint to the compiler, which indicates the most likely to be used
-	// work-group size in the x, y, and z dimensions. Only valid with the
-	// Kernel Execution Model.
-	//
-	// Arguments:
-	//
-	//   [0]: x size
-	//   [1]: y size
-	//   [2]: z size
-	//
-	ExecutionModeLocalSizeHint = 17
-
-	// Stage input primitive is points. Only valid with the Geometry Execution Model.
-	ExecutionModeInputPoints = 18
-
-	// Stage input primitive is lines. Only valid with the Geometry Execution Model.
-	ExecutionModeInputLines = 19
-
-	// Stage input primitive is lines adjacency. Only valid with the Geometry
-	// Execution Model.
-	ExecutionModeInputLinesAdjacency = 20
-
-	// For a geometry stage, input primitive is triangles. For a tessellation
-	// stage, requests the tessellation primitive generator to generate
-	// triangles. Only valid with the Geometry or one of the tessellation
-	// Execution Models.
-	ExecutionModeInputTriangles = 21
-
-	// Geometry stage input primitive is triangles adjacency. Only valid with
-	// the Geometry Execution Model.
-	ExecutionModeInputTrianglesAdjacency = 22
-
-	// Requests the tessellation primitive generator to generate quads.
-	// Only valid with one of the tessellation Execution Models.
-	ExecutionModeInputQuads = 23
-
-	// Requests the tessellation primitive generator to generate isolines.
-	// Only valid with one of the tessellation Execution Models
-	ExecutionModeInputIsolines = 24
-
-	// For a geometry stage, the maximum number of vertices the shader will
-	// ever emit in a single invocation. For a tessellation-control stage,
-	// the number of vertices in the output patch produced by the tessellation
-	// control shader, which also specifies the number of times the
-	// tessellation control shader is invoked. Only valid with the Geometry
-	// or one of the tessellation Execution Models.
-	//
-	// Arguments:
-	//
-	//   [0]: Vertex count
-	//
-	ExecutionModeOutputVertices = 25
-
-	// Stage output primitive is points. Only valid with the Geometry
-	// Execution Model.
-	ExecutionModeOutputPoints = 26
-
-	// Stage output primitive is line strip. Only valid with the Geometry
-	// Execution Model.
-	ExecutionModeOutputLinestrip = 27
-
-	// Stage output primitive is triangle strip. Only valid with the
-	// Geometry Execution Model.
-	ExecutionModeOutputTrianglestrip = 28
-
-	// A hint to the compiler, which indicates that most operations used
-	// in the entry point are explicitly vectorized using a particular
-	// vector type. Only valid with the Kernel Execution Model.
-	//
-	// Arguments:
-	//
-	//   [0]: Vector type
-	//
-	ExecutionModeVecTypeHint = 29
-
-	// Indicates that floating-point-expressions contraction is disallowed.
-	// Only valid with the Kernel Execution Model.
-	ExecutionModeContractionOff = 30
+	SelectionControlNone        = 0
+	SelectionControlFlatten     = 0x1
+	SelectionControlDontFlatten = 0x2
 )
 
-type ExecutionModel uint32
+func (v SelectionControl) String() string { return bitString("SelectionControl", uint32(v)) }
 
-func (v ExecutionModel) Verify() error {
-	if v >= ExecutionModelVertex && v <= ExecutionModelKernel {
+type LoopControl uint32
+
+func (v LoopControl) Verify() error {
+	if verifyBitFlag(
+		uint32(v),
+		true,
+		LoopControlUnroll|
+			LoopControlDontUnroll|
+			LoopControlDependencyInfinite|
+			LoopControlDependencyLength|
+			LoopControlMinIterations|
+			LoopControlMaxIterations|
+			LoopControlIterationMultiple|
+			LoopControlPeelCount|
+			LoopControlPartialCount,
+	) {
 		return nil
 	}
-	return ErrInvalidExecutionModel
+	return ErrInvalidLoopControl
 }
 
-// Execution Models define a single execution model.
-// This is used in the EntryPoint instruction to determine what stage of the
-// pipeline a given set of instructions belongs to.
+// LoopControl is a mask of hints for unrolling of loop constructs.
 const (
-	ExecutionModelVertex                 = 0 // Vertex shading stage
-	ExecutionModelTessellationControl    = 1 // Tessellation control (or hull) shading stage.
-	ExecutionModelTessellationEvaluation = 2 // Tessellation evaluation (or domain) shading stage
-	ExecutionModelGeometry               = 3 // Geometry shading stage.
-	ExecutionModelFragment               = 4 // Fragment shading stage.
-	ExecutionModelGLCompute              = 5 // Graphical compute shading stage.
-	ExecutionModelKernel                 = 6 // Compute kernel.
+	LoopControlNone               = 0
+	LoopControlUnroll             = 0x1
+	LoopControlDontUnroll         = 0x2
+	LoopControlDependencyInfinite = 0x4
+	LoopControlDependencyLength   = 0x8
+	LoopControlMinIterations      = 0x10
+	LoopControlMaxIterations      = 0x20
+	LoopControlIterationMultiple  = 0x40
+	LoopControlPeelCount          = 0x80
+	LoopControlPartialCount       = 0x100
 )
 
-type FPFastMathMode uint32
+// argc returns the number of extra operands which follow a LoopControl
+// mask with these bits set.
+func (v LoopControl) argc() int {
+	const argc1 = LoopControlDependencyLength | LoopControlMinIterations |
+		LoopControlMaxIterations | LoopControlIterationMultiple |
+		LoopControlPeelCount | LoopControlPartialCount
 
-func (v FPFastMathMode) Verify() error {
-	if verifyBitFlag(uint32(v), true, FPFastMathModeNotInf|FPFastMathModeNSZ|FPFastMathModeAllowRecip|FPFastMathModeFast) {
+	return bits.OnesCount32(uint32(v) & argc1)
+}
+
+func (v LoopControl) String() string { return bitString("LoopControl", uint32(v)) }
+
+type FunctionControl uint32
+
+func (v FunctionControl) Verify() error {
+	if verifyBitFlag(
+		uint32(v),
+		true,
+		FunctionControlInline|
+			FunctionControlDontInline|
+			FunctionControlPure|
+			FunctionControlConst,
+	) {
 		return nil
 	}
-	return ErrInvalidFPFastMathMode
+	return ErrInvalidFunctionControl
 }
 
-// FPFastMathModes define bitflags which enable fast math operations
-// which are otherwise unsafe.
+// FunctionControl is a mask of hints for function optimisations.
 const (
-	// Assume parameters and result are not NaN.
-	FPFastMathModeNotNaN = 0
+	FunctionControlNone       = 0
+	FunctionControlInline     = 0x1
+	FunctionControlDontInline = 0x2
+	FunctionControlPure       = 0x4
+	FunctionControlConst      = 0x8
+)
 
-	// Assume parameters and result are not +/- Inf.
-	FPFastMathModeNotInf = 2
+func (v FunctionControl) String() string { return bitString("FunctionControl", uint32(v)) }
 
-	// Treat the sign of a zero parameter or result as insignificant.
-	FPFastMathModeNSZ = 4
+type MemorySemantics uint32
 
-	// Allow the usage of reciprocal rather than perform a division.
-	FPFastMathModeAllowRecip = 8
+func (v MemorySemantics) Verify() error {
+	if verifyBitFlag(
+		uint32(v),
+		true,
+		MemorySemanticsAcquire|
+			MemorySemanticsRelease|
+			MemorySemanticsAcquireRelease|
+			MemorySemanticsSequentiallyConsistent|
+			MemorySemanticsUniformMemory|
+			MemorySemanticsSubgroupMemory|
+			MemorySemanticsWorkgroupMemory|
+			MemorySemanticsCrossWorkgroupMemory|
+			MemorySemanticsAtomicCounterMemory|
+			MemorySemanticsImageMemory|
+			MemorySemanticsOutputMemory|
+			MemorySemanticsMakeAvailable|
+			MemorySemanticsMakeVisible|
+			MemorySemanticsVolatile,
+	) {
+		return nil
+	}
+	return ErrInvalidMemorySemantics
+}
 
-	// Allow algebraic transformations according to real-number associative
-	// and distributive algebra. This flag implies all the others.
-	FPFastMathModeFast = 16
+// MemorySemantics is a mask of memory classifications and ordering semantics.
+const (
+	MemorySemanticsRelaxed                = 0
+	MemorySemanticsNone                   = 0
+	MemorySemanticsAcquire                = 0x2
+	MemorySemanticsRelease                = 0x4
+	MemorySemanticsAcquireRelease         = 0x8
+	MemorySemanticsSequentiallyConsistent = 0x10
+	MemorySemanticsUniformMemory          = 0x40
+	MemorySemanticsSubgroupMemory         = 0x80
+	MemorySemanticsWorkgroupMemory        = 0x100
+	MemorySemanticsCrossWorkgroupMemory   = 0x200
+	MemorySemanticsAtomicCounterMemory    = 0x400
+	MemorySemanticsImageMemory            = 0x800
+	MemorySemanticsOutputMemory           = 0x1000
+	MemorySemanticsMakeAvailable          = 0x2000
+	MemorySemanticsMakeVisible            = 0x4000
+	MemorySemanticsVolatile               = 0x8000
 )
 
-type FPRoundingMode uint32
+func (v MemorySemantics) String() string { return bitString("MemorySemantics", uint32(v)) }
 
-func (v FPRoundingMode) Verify() error {
-	if v >= FPRoundingModeRTE && v <= FPRoundingModeRTN {
+type MemoryAccess uint32
+
+func (v MemoryAccess) Verify() error {
+	if verifyBitFlag(
+		uint32(v),
+		true,
+		MemoryAccessVolatile|
+			MemoryAccessAligned|
+			MemoryAccessNontemporal|
+			MemoryAccessMakePointerAvailable|
+			MemoryAccessMakePointerVisible|
+			MemoryAccessNonPrivatePointer,
+	) {
 		return nil
 	}
-	return ErrInvalidFPRoundingMode
+	return ErrInvalidMemoryAccess
 }
 
-// FPRoundingModes associate a rounding mode with a floating-point
-// conversion instruction.
-//
-// By default:
-//
-//    - Conversions from floating-point to integer types use the
-//      round-toward-zero rounding mode.
-//    - Conversions to floating-point types use the round-to-nearest-even
-//      rounding mode.
-//
+// MemoryAccess is a mask of memory access semantics.
 const (
-	FPRoundingModeRTE = 0 // Round to nearest even.
-	FPRoundingModeRTZ = 1 // Round towards zero.
-	FPRoundingModeRTP = 2 // Round towards positive infinity.
-	FPRoundingModeRTN = 3 // Round towards negative infinity.
+	MemoryAccessNone                 = 0
+	MemoryAccessVolatile             = 0x1
+	MemoryAccessAligned              = 0x2
+	MemoryAccessNontemporal          = 0x4
+	MemoryAccessMakePointerAvailable = 0x8
+	MemoryAccessMakePointerVisible   = 0x10
+	MemoryAccessNonPrivatePointer    = 0x20
 )
 
-type LinkageType uint32
+// argc returns the number of extra operands which follow a MemoryAccess
+// mask with these bits set.
+func (v MemoryAccess) argc() int {
+	const argc1 = MemoryAccessAligned | MemoryAccessMakePointerAvailable |
+		MemoryAccessMakePointerVisible
 
-func (v LinkageType) Verify() error {
-	if v >= LinkageTypeExport && v <= LinkageTypeImport {
+	return bits.OnesCount32(uint32(v) & argc1)
+}
+
+func (v MemoryAccess) String() string { return bitString("MemoryAccess", uint32(v)) }
+
+type KernelProfilingInfo uint32
+
+func (v KernelProfilingInfo) Verify() error {
+	if verifyBitFlag(
+		uint32(v),
+		true,
+		KernelProfilingInfoCmdExecTime,
+	) {
 		return nil
 	}
-	return ErrInvalidLinkageType
+	return ErrInvalidKernelProfilingInfo
 }
 
-// Linkage Types associate a linkage type with functions or global
-// variables. By default, functions and global variables are private
-// to a module and cannot be accessed by other modules.
+// KernelProfilingInfo is a mask of profiling information to capture.
 const (
-	LinkageTypeExport = 0 // Accessible by other modules as well.
-	LinkageTypeImport = 1 // Declaration for a global identifier that exists in another module.
+	KernelProfilingInfoNone        = 0
+	KernelProfilingInfoCmdExecTime = 0x1
 )
 
-type MemoryModel uint32
+func (v KernelProfilingInfo) String() string { return bitString("KernelProfilingInfo", uint32(v)) }
 
-func (v MemoryModel) Verify() error {
-	if v >= MemoryModelSimple && v <= MemoryModelOpenCL21 {
+type SourceLanguage uint32
+
+func (v SourceLanguage) Verify() error {
+	if v <= SourceLanguageZig {
 		return nil
 	}
-	return ErrInvalidMemoryModel
+	return ErrInvalidSourceLanguage
 }
 
-// Memory Models define an existing memory model.
+// SourceLanguage is the source language an instruction stream was translated
+// from.
 const (
-	MemoryModelSimple   = 0 // No shared memory consistency issues.
-	MemoryModelGLSL450  = 1 // Memory model needed by later versions of GLSL and ESSL. Works across multiple versions.
-	MemoryModelOpenCL12 = 2 // OpenCL 1.2 memory model.
-	MemoryModelOpenCL20 = 3 // OpenCL 2.0 memory model.
-	MemoryModelOpenCL21 = 4 // OpenCL 2.1 memory model.
+	SourceLanguageUnknown      = 0
+	SourceLanguageESSL         = 1
+	SourceLanguageGLSL         = 2
+	SourceLanguageOpenCLC      = 3
+	SourceLanguageOpenCLCPP    = 4
+	SourceLanguageHLSL         = 5
+	SourceLanguageCPPForOpenCL = 6
+	SourceLanguageSYCL         = 7
+	SourceLanguageHEROC        = 8
+	SourceLanguageNZSL         = 9
+	SourceLanguageWGSL         = 10
+	SourceLanguageSlang        = 11
+	SourceLanguageZig          = 12
 )
 
-type SamplerAddressingMode uint32
+func (v SourceLanguage) String() string { return valueString("SourceLanguage", uint32(v)) }
 
-func (v SamplerAddressingMode) Verify() error {
-	switch v {
-	case SamplerAddressingModeNone, SamplerAddressingModeClampEdge,
-		SamplerAddressingModeClamp, SamplerAddressingModeRepeat,
-		SamplerAddressingModeRepeatMirrored:
+type ExecutionModel uint32
+
+func (v ExecutionModel) Verify() error {
+	if v <= ExecutionModelKernel {
 		return nil
-	default:
-		return ErrInvalidSamplerAddressingMode
 	}
+	return ErrInvalidExecutionModel
 }
 
-// Sampler Addressing Modes define the addressing mode of read image
-// extended instructions.
+// ExecutionModel is the execution model of an entry point and its interface.
 const (
-	// The image coordinates used to sample elements of the image refer to a
-	// location inside the image, otherwise the results are undefined.
-	SamplerAddressingModeNone = 0
+	ExecutionModelVertex                 = 0
+	ExecutionModelTessellationControl    = 1
+	ExecutionModelTessellationEvaluation = 2
+	ExecutionModelGeometry               = 3
+	ExecutionModelFragment               = 4
+	ExecutionModelGLCompute              = 5
+	ExecutionModelKernel                 = 6
+)
 
-	// Out-of-range image coordinates are clamped to the extent.
-	SamplerAddressingModeClampEdge = 2
+func (v ExecutionModel) String() string { return valueString("ExecutionModel", uint32(v)) }
 
-	// Out-of-range image coordinates will return a border color.
-	SamplerAddressingModeClamp = 4
+type AddressingModel uint32
 
-	// Out-of-range image coordinates are wrapped to the valid range.
-	// Can only be used with normalized coordinates.
-	SamplerAddressingModeRepeat = 6
+func (v AddressingModel) Verify() error {
+	switch {
+	case v <= AddressingModelPhysical64,
+		v == AddressingModelPhysicalStorageBuffer64:
+		return nil
+	}
+	return ErrInvalidAddressingModel
+}
 
-	// Flip the image coordinate at every integer junction.
-	// Can only be used with normalized coordinates.
-	SamplerAddressingModeRepeatMirrored = 8
+// AddressingModel is the addressing model used by a module.
+const (
+	AddressingModelLogical                 = 0
+	AddressingModelPhysical32              = 1
+	AddressingModelPhysical64              = 2
+	AddressingModelPhysicalStorageBuffer64 = 5348
 )
 
-type SamplerFilterMode uint32
+func (v AddressingModel) String() string { return valueString("AddressingModel", uint32(v)) }
 
-func (v SamplerFilterMode) Verify() error {
-	switch v {
-	case SamplerFilterModeNearest, SamplerFilterModeLinear:
+type MemoryModel uint32
+
+func (v MemoryModel) Verify() error {
+	if v <= MemoryModelVulkan {
 		return nil
-	default:
-		return ErrInvalidSamplerFilterMode
 	}
+	return ErrInvalidMemoryModel
 }
 
-// Sampler Filter Modes define the filter mode of read image
-// extended instructions.
+// MemoryModel is the memory model used by a module.
 const (
-	// Use filter nearset mode when performing a read image operation.
-	SamplerFilterModeNearest = 16
-
-	// Use filter linear mode when performing a read image operation.
-	SamplerFilterModeLinear = 32
+	MemoryModelSimple  = 0
+	MemoryModelGLSL450 = 1
+	MemoryModelOpenCL  = 2
+	MemoryModelVulkan  = 3
 )
 
-type SourceLanguage uint32
+func (v MemoryModel) String() string { return valueString("MemoryModel", uint32(v)) }
 
-func (v SourceLanguage) Verify() error {
-	if v >= SourceLanguageUnknown && v <= SourceLanguageOpenCL {
+type ExecutionMode uint32
+
+func (v ExecutionMode) Verify() error {
+	switch {
+	case v <= ExecutionModeDepthReplacing,
+		v >= ExecutionModeDepthGreater && v <= ExecutionModeContractionOff,
+		v >= ExecutionModeInitializer && v <= ExecutionModeLocalSizeHintId,
+		v >= ExecutionModeDenormPreserve && v <= ExecutionModeRoundingModeRTZ:
 		return nil
 	}
-	return ErrInvalidSourceLanguage
+	return ErrInvalidExecutionMode
 }
 
-// Source Languages define a source language constant.
+// ExecutionMode declares the modes an entry point will execute in.
 const (
-	SourceLanguageUnknown = 0
-	SourceLanguageESSL    = 1
-	SourceLanguageGLSL    = 2
-	SourceLanguageOpenCL  = 3
+	ExecutionModeInvocations              = 0
+	ExecutionModeSpacingEqual             = 1
+	ExecutionModeSpacingFractionalEven    = 2
+	ExecutionModeSpacingFractionalOdd     = 3
+	ExecutionModeVertexOrderCw            = 4
+	ExecutionModeVertexOrderCcw           = 5
+	ExecutionModePixelCenterInteger       = 6
+	ExecutionModeOriginUpperLeft          = 7
+	ExecutionModeOriginLowerLeft          = 8
+	ExecutionModeEarlyFragmentTests       = 9
+	ExecutionModePointMode                = 10
+	ExecutionModeXfb                      = 11
+	ExecutionModeDepthReplacing           = 12
+	ExecutionModeDepthGreater             = 14
+	ExecutionModeDepthLess                = 15
+	ExecutionModeDepthUnchanged           = 16
+	ExecutionModeLocalSize                = 17
+	ExecutionModeLocalSizeHint            = 18
+	ExecutionModeInputPoints              = 19
+	ExecutionModeInputLines               = 20
+	ExecutionModeInputLinesAdjacency      = 21
+	ExecutionModeTriangles                = 22
+	ExecutionModeInputTrianglesAdjacency  = 23
+	ExecutionModeQuads                    = 24
+	ExecutionModeIsolines                 = 25
+	ExecutionModeOutputVertices           = 26
+	ExecutionModeOutputPoints             = 27
+	ExecutionModeOutputLineStrip          = 28
+	ExecutionModeOutputTriangleStrip      = 29
+	ExecutionModeVecTypeHint              = 30
+	ExecutionModeContractionOff           = 31
+	ExecutionModeInitializer              = 33
+	ExecutionModeFinalizer                = 34
+	ExecutionModeSubgroupSize             = 35
+	ExecutionModeSubgroupsPerWorkgroup    = 36
+	ExecutionModeSubgroupsPerWorkgroupId  = 37
+	ExecutionModeLocalSizeId              = 38
+	ExecutionModeLocalSizeHintId          = 39
+	ExecutionModeDenormPreserve           = 4459
+	ExecutionModeDenormFlushToZero        = 4460
+	ExecutionModeSignedZeroInfNanPreserve = 4461
+	ExecutionModeRoundingModeRTE          = 4462
+	ExecutionModeRoundingModeRTZ          = 4463
 )
 
+// argc returns the number of extra operands which follow an ExecutionMode
+// value v. It returns -1 for values whose operands are <id>s or a string.
+func (v ExecutionMode) argc() int {
+	switch v {
+	case ExecutionModeLocalSize, ExecutionModeLocalSizeHint:
+		return 3
+	case ExecutionModeInvocations, ExecutionModeOutputVertices,
+		ExecutionModeVecTypeHint, ExecutionModeSubgroupSize,
+		ExecutionModeSubgroupsPerWorkgroup, ExecutionModeDenormPreserve,
+		ExecutionModeDenormFlushToZero, ExecutionModeSignedZeroInfNanPreserve,
+		ExecutionModeRoundingModeRTE, ExecutionModeRoundingModeRTZ:
+		return 1
+	case ExecutionModeSubgroupsPerWorkgroupId, ExecutionModeLocalSizeId,
+		ExecutionModeLocalSizeHintId:
+		return -1
+	}
+	return 0
+}
+
+func (v ExecutionMode) String() string { return valueString("ExecutionMode", uint32(v)) }
+
 type StorageClass uint32
 
 func (v StorageClass) Verify() error {
-	if v >= StorageClassUniformConstant && v <= StorageClassAtomicCounter {
+	switch {
+	case v <= StorageClassStorageBuffer,
+		v == StorageClassPhysicalStorageBuffer:
 		return nil
 	}
 	return ErrInvalidStorageClass
 }
 
-// Storage Classes define a class of storage for declared variables
-// (does not include intermediate values).
+// StorageClass is the class of storage for declared variables.
 const (
-	// Shared externally, read-only memory, visible across all instantiation
-	// or work groups. Graphics uniform memory. OpenCL Constant memory
-	StorageClassUniformConstant = 0
-
-	// Input from pipeline. Read only
-	StorageClassInput = 1
-
-	// Shared externally, visible across all instantiations or work groups
-	StorageClassUniform = 2
+	StorageClassUniformConstant       = 0
+	StorageClassInput                 = 1
+	StorageClassUniform               = 2
+	StorageClassOutput                = 3
+	StorageClassWorkgroup             = 4
+	StorageClassCrossWorkgroup        = 5
+	StorageClassPrivate               = 6
+	StorageClassFunction              = 7
+	StorageClassGeneric               = 8
+	StorageClassPushConstant          = 9
+	StorageClassAtomicCounter         = 10
+	StorageClassImage                 = 11
+	StorageClassStorageBuffer         = 12
+	StorageClassPhysicalStorageBuffer = 5349
+)
 
-	// Output to pipeline.
-	StorageClassOutput = 3
+func (v StorageClass) String() string { return valueString("StorageClass", uint32(v)) }
 
-	// Shared across all work items within a work group. OpenGL "shared".
-	// OpenCL local memory.
-	StorageClassWorkgroupLocal = 4
+type Dim uint32
 
-	// Visible across all work items of all work groups. OpenCL global memory.
-	StorageClassWorkgroupGlobal = 5
+func (v Dim) Verify() error {
+	if v <= DimSubpassData {
+		return nil
+	}
+	return ErrInvalidDim
+}
 
-	// Accessible across functions within a module, non-IO (not visible outside
-	// the module).
-	StorageClassPrivateGlobal = 6
+// Dim is the dimensionality of an image.
+const (
+	Dim1D          = 0
+	Dim2D          = 1
+	Dim3D          = 2
+	DimCube        = 3
+	DimRect        = 4
+	DimBuffer      = 5
+	DimSubpassData = 6
+)
 
-	// A variable local to a function.
-	StorageClassFunction = 7
+func (v Dim) String() string { return valueString("Dim", uint32(v)) }
 
-	// A generic pointer, which overloads StoragePrivate, StorageLocal,
-	// StorageGlobal. not a real storage class.
-	StorageClassGeneric = 8
+type SamplerAddressingMode uint32
 
-	// Private to a work-item and is not visible to another work-item.
-	// OpenCL private memory.
-	StorageClassPrivate = 9
+func (v SamplerAddressingMode) Verify() error {
+	if v <= SamplerAddressingModeRepeatMirrored {
+		return nil
+	}
+	return ErrInvalidSamplerAddressingMode
+}
 
-	// For holding atomic counters.
-	StorageClassAtomicCounter = 10
+// SamplerAddressingMode is the addressing mode of read image extended
+// instructions.
+const (
+	SamplerAddressingModeNone           = 0
+	SamplerAddressingModeClampToEdge    = 1
+	SamplerAddressingModeClamp          = 2
+	SamplerAddressingModeRepeat         = 3
+	SamplerAddressingModeRepeatMirrored = 4
 )
 
-type FunctionParameter uint32
+func (v SamplerAddressingMode) String() string {
+	return valueString("SamplerAddressingMode", uint32(v))
+}
 
-func (v FunctionParameter) Verify() error {
-	if v >= FunctionParamAttrZext && v <= FunctionParamAttrNoReadWrite {
+type SamplerFilterMode uint32
+
+func (v SamplerFilterMode) Verify() error {
+	if v <= SamplerFilterModeLinear {
 		return nil
 	}
-	return ErrInvalidFunctionParameter
+	return ErrInvalidSamplerFilterMode
 }
 
-// Function Parameter Attributes add additional information to the return type
-// and to each parameter of a function.
+// SamplerFilterMode is the filter mode of read image extended instructions.
 const (
-	// Value should be zero extended if needed.
-	FunctionParamAttrZext = 0
-
-	// Value should be sign extended if needed.
-	FunctionParamAttrSext = 1
-
-	// This indicates that the pointer parameter should really be passed by
-	// value to the function. Only valid for pointer parameters (not
-	// for ret value)
-	FunctionParamAttrByVal = 2
-
-	// Indicates that the pointer parameter specifies the address of a
-	// structure that is the return value of the function in the source
-	// program. Only applicable to the first parameter which must be a
-	// pointer parameters.
-	FunctionParamAttrSret = 3
-
-	// Indicates that the memory pointed by a pointer parameter is not
-	// accessed via pointer values which are not derived from this pointer
-	// parameter. Only valid for pointer parameters. Not valid on return values
-	FunctionParamAttrNoAlias = 4
-
-	// The callee does not make a copy of the pointer parameter into a
-	// location that is accessible after returning from the callee. Only
-	// valid for pointer parameters. Not valid on return values.
-	FunctionParamAttrNoCapture = 5
-
-	// To be determined.
-	FunctionParamAttrSVM = 6
-
-	// Can only read the memory pointed by a pointer parameter.
-	// Only valid for pointer parameters. Not valid on return values.
-	FunctionParamAttrNoWrite = 7
-
-	// Cannot dereference the memory pointed by a pointer parameter.
-	// Only valid for pointer parameters. Not valid on return values.
-	FunctionParamAttrNoReadWrite = 8
+	SamplerFilterModeNearest = 0
+	SamplerFilterModeLinear  = 1
 )
 
-type Decoration uint32
+func (v SamplerFilterMode) String() string { return valueString("SamplerFilterMode", uint32(v)) }
 
-func (v Decoration) Verify() error {
-	if v >= DecorationPrecisionLow && v <= DecorationSpecId {
+type ImageFormat uint32
+
+func (v ImageFormat) Verify() error {
+	if v <= ImageFormatR8ui {
 		return nil
 	}
-	return ErrInvalidDecoration
+	return ErrInvalidImageFormat
 }
 
-// Decorations are used by OpDecorate and OpMemberDecorate
+// ImageFormat is the declared texel format of an image.
 const (
-	// Apply as described in the ES Precision section.
-	DecorationPrecisionLow = 0
-
-	// Apply as described in the ES Precision section.
-	DecorationPrecisionMedium = 1
-
-	// Apply as described in the ES Precision section.
-	DecorationPrecisionHigh = 2
-
-	// Apply to a structure type to establish it is a non-SSBO-like
-	// shader-interface block.
-	//
-	// TODO: can this be removed? Probably doesn’t add anything over a
-	// nonwritable structure in the UniformConstant or Uniform storage class.
-	// With a Binding and DescriptorSet decoration.
-	DecorationBlock = 3
-
-	// Apply to a structure type to establish it is an SSBO-like
-	// shader-interface block.
-	//
-	// TODO: can this be removed? Probably doesn’t add anything over a
-	// structure in the UniformConstant or Uniform storage class.
-	// With a Binding and DescriptorSet decoration.
-	DecorationBufferBlock = 4
-
-	// Apply to a variable or a member of a structure. Must decorate an
-	// entity whose type is a matrix. Indicates that components within a
-	// row are contiguous in memory.
-	DecorationRowMajor = 5
-
-	// Apply to a variable or a member of a structure. Must decorate an
-	// entity whose type is a matrix. Indicates that components within a
-	// column are contiguous in memory.
-	DecorationColMajor = 6
-
-	// Apply to a structure type to get GLSL shared memory layout.
-	DecorationGLSLShared = 7
-
-	// Apply to a structure type to get GLSL std140 memory layout.
-	DecorationLSLStd140 = 8
-
-	// Apply to a structure type to get GLSL std430 memory layout.
-	DecorationGLSLStd430 = 9
-
-	// Apply to a structure type to get GLSL packed memory layout.
-	DecorationGLSLPacked = 10
-
-	// Apply to a variable or a member of a structure. Indicates that
-	// perspective-correct interpolation must be used. Only valid for the
-	// Input and Output Storage Classes.
-	DecorationSmooth = 11
-
-	// Apply to a variable or a member of a structure. Indicates that linear,
-	// non-perspective correct interpolation must be used. Only valid for
-	// the Input and Output Storage Classes.
-	DecorationNoperspective = 12
-
-	// Apply to a variable or a member of a structure. Indicates no
-	// interpolation will be done. The non-interpolated value will come
-	// from a vertex, as described in the API specification. Only valid
-	// for the Input and Output Storage Classes.
-	DecorationFlat = 13
-
-	// Apply to a variable or a member of a structure. Indicates a tessellation
-	// patch. Only valid for the Input and Output Storage Classes.
-	DecorationPatch = 14
-
-	// Apply to a variable or a member of a structure. When used with
-	// multi-sampling rasterization, allows a single interpolation location
-	// for an entire pixel. The interpolation location must lie in both
-	// the pixel and in the primitive being rasterized. Only valid for the
-	// Input and Output Storage Classes.
-	DecorationCentroid = 15
-
-	// Apply to a variable or a member of a structure. When used with
-	// multi-sampling rasterization, requires per-sample interpolation.
-	//
-	// The interpolation locations must be the locations of the samples
-	// lying in both the pixel and in the primitive being rasterized.
-	// Only valid for the Input and Output Storage Classes.
-	DecorationSample = 16
-
-	// Apply to a variable, to indicate expressions computing its value
-	// be done invariant with respect to other modules computing the
-	// same expressions
-	DecorationInvariant = 17
-
-	// Apply to a variable, to indicate the compiler may compile as if there
-	// is no aliasing. See the Aliasing section for more detail.
-	DecorationRestrict = 18
-
-	// Apply to a variable, to indicate the compiler is to generate accesses
-	// to the variable that work correctly in the presence of aliasing.
-	// See the Aliasing section for more detail.
-	DecorationAliased = 19
-
-	// Apply to a variable, to indicate the memory holding the variable is
-	// volatile. See the Memory Model section for more detail.
-	DecorationVolatile = 20
-
-	// Indicates that a global variable is constant and will never be modified.
-	// Only allowed on global variables
-	DecorationConstant = 21
-
-	// Apply to a variable, to indicate the memory holding the variable is
-	// coherent. See the Memory Model section for more detail.
-	DecorationCoherent = 22
-
-	// Apply to a variable, to indicate the memory holding the variable is
-	// not writable, and that this module does not write to it.
-	DecorationNonwritable = 23
-
-	// Apply to a variable, to indicate the memory holding the variable is
-	// not readable, and that this module does not read from it
-	DecorationNonreadable = 24
-
-	// Apply to a variable or a member of a structure. Asserts that the
-	// value backing the decorated <id> is dynamically uniform across all
-	// instantiations that might run in parallel.
-	DecorationUniform = 25
-
-	// Apply to a variable to indicate that it is known that this
-	// module does not read or write it. Useful for establishing
-	// interface.
-	//
-	// TODO: consider removing this?
-	DecorationNoStaticUse = 26
-
-	// Marks a structure type as "packed", indicating that the alignment
-	// of the structure is one and that there is no padding between
-	// structure members.
-	DecorationCPacked = 27
-
-	// Indicates that a conversion to an integer type is saturated.
-	// Only valid for conversion instructions to integer type.
-	DecorationFPSaturatedConversion = 28
-
-	// Apply to a variable or a member of a structure. Indicates the stream
-	// number to put an output on. Only valid for the Output Storage
-	// Class and the Geometry Execution Model.
-	//
-	// Arguments:
-	//  - Stream number
-	//
-	DecorationStream = 29
-
-	// Apply to a variable or a structure member. Forms the main
-	// linkage for Storage Class Input and Output variables:
-	//
-	//  - between the API and vertex-stage inputs,
-	//  - between consecutive programmable stages, or
-	//  - between fragment-stage outputs and the API.
-	//
-	// Only valid for the Input and Output Storage Classes.
-	//
-	// Arguments:
-	//  - Location
-	//
-	DecorationLocation = 30
-
-	// Apply to a variable or a member of a structure. Indicates
-	// which component within a Location will be taken by the
-	// decorated entity. Only valid for the Input and Output
-	// Storage Classes.
-	//
-	// Arguments:
-	//  - Component within a vector
-	//
-	DecorationComponent = 31
-
-	// Apply to a variable to identify a blend equation input index,
-	// used as described in the API specification. Only valid for the
-	// Output Storage Class and the Fragment Execution Model.
-	//
-	// Arguments:
-	//  - Index
-	//
-	DecorationIndex = 32
-
-	// Apply to a variable. Part of the main linkage between the API
-	// and SPIR-V modules for memory buffers, textures, etc. See the
-	// API specification for more information.
-	//
-	// Arguments:
-	//  - Binding point
-	//
-	DecorationBinding = 33
-
-	// Apply to a variable. Part of the main linkage between the API and
-	// SPIR-V modules for memory buffers, textures, etc. See the API
-	// specification for more information.
-	//
-	// Arguments:
-	//  - Descriptor set
-	//
-	DecorationDescriptorSet = 34
-
-	// Apply to a structure member. This gives the byte offset of the
-	// member relative to the beginning of the structure. Can be used,
-	// for example, by both uniform and transform-feedback buffers.
-	//
-	// Arguments:
-	//  - Byte offset
-	//
-	DecorationOffset = 35
-
-	// TODO: This can probably be removed.
-	//
-	// Arguments:
-	//  - Declared alignment
-	//
-	DecorationAlignment = 36
-
-	// Apply to a variable or a member of a structure. Indicates which
-	// transform-feedback buffer an output is written to. Only valid for
-	// the Output Storage Classes of vertex processing Execution Models.
-	//
-	// Arguments:
-	//  - XFB Buffer number
-	//
-	DecorationXfbBuffer = 37
-
-	// The stride, in bytes, of array elements or transform-feedback
-	// buffer vertices.
-	//
-	// Arguments:
-	//  - Stride
-	//
-	DecorationStride = 38
-
-	// Apply to a variable or a member of a structure.
-	// Indicates which built-in variable the entity represents.
-	//
-	// Arguments:
-	//  - See Built-In
-	//
-	DecorationBuiltIn = 39
-
-	// Indicates a function return value or parameter attribute.
-	//
-	// Arguments:
-	//  - function parameter attribute
-	//
-	DecorationFuncParamAttr = 40
-
-	// Indicates a floating-point rounding mode
-	//
-	// Arguments:
-	//  - floating-point rounding mode
-	//
-	DecorationFPRoundingMode = 41
-
-	// Indicates a floating-point fast math flag
-	//
-	// Arguments:
-	//  - fast-math mode
-	//
-	DecorationFPFastMathMode = 42
-
-	// Indicate a linkage type. Only valid on an OpFunction or a
-	// module scope OpVariable.
-	//
-	// Arguments:
-	//  - linkage type
-	//
-	DecorationLinkageType = 43
-
-	// Apply to a specialization constant. Forms the API linkage for
-	// setting a specialized value. See specialization.
-	//
-	// Arguments:
-	//  - Literal Number: Specialization Constant ID
-	//
-	DecorationSpecId = 44
+	ImageFormatUnknown      = 0
+	ImageFormatRgba32f      = 1
+	ImageFormatRgba16f      = 2
+	ImageFormatR32f         = 3
+	ImageFormatRgba8        = 4
+	ImageFormatRgba8Snorm   = 5
+	ImageFormatRg32f        = 6
+	ImageFormatRg16f        = 7
+	ImageFormatR11fG11fB10f = 8
+	ImageFormatR16f         = 9
+	ImageFormatRgba16       = 10
+	ImageFormatRgb10A2      = 11
+	ImageFormatRg16         = 12
+	ImageFormatRg8          = 13
+	ImageFormatR16          = 14
+	ImageFormatR8           = 15
+	ImageFormatRgba16Snorm  = 16
+	ImageFormatRg16Snorm    = 17
+	ImageFormatRg8Snorm     = 18
+	ImageFormatR16Snorm     = 19
+	ImageFormatR8Snorm      = 20
+	ImageFormatRgba32i      = 21
+	ImageFormatRgba16i      = 22
+	ImageFormatRgba8i       = 23
+	ImageFormatR32i         = 24
+	ImageFormatRg32i        = 25
+	ImageFormatRg16i        = 26
+	ImageFormatRg8i         = 27
+	ImageFormatR16i         = 28
+	ImageFormatR8i          = 29
+	ImageFormatRgba32ui     = 30
+	ImageFormatRgba16ui     = 31
+	ImageFormatRgba8ui      = 32
+	ImageFormatR32ui        = 33
+	ImageFormatRgb10a2ui    = 34
+	ImageFormatRg32ui       = 35
+	ImageFormatRg16ui       = 36
+	ImageFormatRg8ui        = 37
+	ImageFormatR16ui        = 38
+	ImageFormatR8ui         = 39
 )
 
-type Builtin uint32
+func (v ImageFormat) String() string { return valueString("ImageFormat", uint32(v)) }
 
-func (v Builtin) Verify() error {
-	if v >= BuiltinPosition && v <= BuiltinSubgroupLocalInvocationId {
+type ImageChannelOrder uint32
+
+func (v ImageChannelOrder) Verify() error {
+	if v <= ImageChannelOrderABGR {
 		return nil
 	}
-	return ErrInvalidBuiltin
+	return ErrInvalidImageChannelOrder
 }
 
-// Builtins define a builtin operation.
-//
-// Used when Decoration is Built-In. Apply to either:
-//
-//   - The result <id> of the variable declaration of the built-in variable, or
-//   - A structure member, if the built-in is a member of a structure.
-//
-// These have the semantics described by their originating API and
-// high-level language environments.
+// ImageChannelOrder is the channel order returned by OpImageQueryOrder.
 const (
-	BuiltinPosition                  = 0
-	BuiltinPointSize                 = 1
-	BuiltinClipVertex                = 2
-	BuiltinClipDistance              = 3
-	BuiltinCullDistance              = 4
-	BuiltinVertexId                  = 5
-	BuiltinInstanceId                = 6
-	BuiltinPrimitiveId               = 7
-	BuiltinInvocationId              = 8
-	BuiltinLayer                     = 9
-	BuiltinViewportIndex             = 10
-	BuiltinTessLevelOuter            = 11
-	BuiltinTessLevelInner            = 12
-	BuiltinTessCoord                 = 13
-	BuiltinPatchVertices             = 14
-	BuiltinFragCoord                 = 15
-	BuiltinPointCoord                = 16
-	BuiltinFrontFacing               = 17
-	BuiltinSampleId                  = 18
-	BuiltinSamplePosition            = 19
-	BuiltinSampleMask                = 20
-	BuiltinFragColor                 = 21
-	BuiltinFragDepth                 = 22
-	BuiltinHelperInvocation          = 23
-	BuiltinNumWorkgroups             = 24
-	BuiltinWorkgroupSize             = 25
-	BuiltinWorkgroupId               = 26
-	BuiltinLocalInvocationId         = 27
-	BuiltinGlobalInvocationId        = 28
-	BuiltinLocalInvocationIndex      = 29
-	BuiltinWorkDim                   = 30
-	BuiltinGlobalSize                = 31
-	BuiltinEnqueuedWorkgroupSize     = 32
-	BuiltinGlobalOffset              = 33
-	BuiltinGlobalLinearId            = 34
-	BuiltinWorkgroupLinearId         = 35
-	BuiltinSubgroupSize              = 36
-	BuiltinSubgroupMaxSize           = 37
-	BuiltinNumSubgroups              = 38
-	BuiltinNumEnqueuedSubgroups      = 39
-	BuiltinSubgroupId                = 40
-	BuiltinSubgroupLocalInvocationId = 41
+	ImageChannelOrderR            = 0
+	ImageChannelOrderA            = 1
+	ImageChannelOrderRG           = 2
+	ImageChannelOrderRA           = 3
+	ImageChannelOrderRGB          = 4
+	ImageChannelOrderRGBA         = 5
+	ImageChannelOrderBGRA         = 6
+	ImageChannelOrderARGB         = 7
+	ImageChannelOrderIntensity    = 8
+	ImageChannelOrderLuminance    = 9
+	ImageChannelOrderRx           = 10
+	ImageChannelOrderRGx          = 11
+	ImageChannelOrderRGBx         = 12
+	ImageChannelOrderDepth        = 13
+	ImageChannelOrderDepthStencil = 14
+	ImageChannelOrdersRGB         = 15
+	ImageChannelOrdersRGBx        = 16
+	ImageChannelOrdersRGBA        = 17
+	ImageChannelOrdersBGRA        = 18
+	ImageChannelOrderABGR         = 19
 )
 
-type SelectionControl uint32
+func (v ImageChannelOrder) String() string { return valueString("ImageChannelOrder", uint32(v)) }
 
-func (v SelectionControl) Verify() error {
-	if v >= SelectionControlNoControl && v <= SelectionControlDontFlatten {
+type ImageChannelDataType uint32
+
+func (v ImageChannelDataType) Verify() error {
+	if v <= ImageChannelDataTypeUnormInt1010102 {
 		return nil
 	}
-	return ErrInvalidSelectionControl
+	return ErrInvalidImageChannelDataType
 }
 
-// Selection Controls define priorities for flattening
-// of flow control structures.
+// ImageChannelDataType is the channel data type returned by
+// OpImageQueryFormat.
 const (
-	// No control requested.
-	SelectionControlNoControl = 0
-
-	// Strong request, to the extent possible, to remove the flow
-	// control for this selection.
-	SelectionControlFlatten = 1
-
-	// Strong request, to the extent possible, to keep this
-	// selection as flow control.
-	SelectionControlDontFlatten = 2
+	ImageChannelDataTypeSnormInt8       = 0
+	ImageChannelDataTypeSnormInt16      = 1
+	ImageChannelDataTypeUnormInt8       = 2
+	ImageChannelDataTypeUnormInt16      = 3
+	ImageChannelDataTypeUnormShort565   = 4
+	ImageChannelDataTypeUnormShort555   = 5
+	ImageChannelDataTypeUnormInt101010  = 6
+	ImageChannelDataTypeSignedInt8      = 7
+	ImageChannelDataTypeSignedInt16     = 8
+	ImageChannelDataTypeSignedInt32     = 9
+	ImageChannelDataTypeUnsignedInt8    = 10
+	ImageChannelDataTypeUnsignedInt16   = 11
+	ImageChannelDataTypeUnsignedInt32   = 12
+	ImageChannelDataTypeHalfFloat       = 13
+	ImageChannelDataTypeFloat           = 14
+	ImageChannelDataTypeUnormInt24      = 15
+	ImageChannelDataTypeUnormInt1010102 = 16
 )
 
-type LoopControl uint32
+func (v ImageChannelDataType) String() string { return valueString("ImageChannelDataType", uint32(v)) }
 
-func (v LoopControl) Verify() error {
-	if v >= LoopControlNoControl && v <= LoopControlDontUnroll {
+type FPRoundingMode uint32
+
+func (v FPRoundingMode) Verify() error {
+	if v <= FPRoundingModeRTN {
 		return nil
 	}
-	return ErrInvalidLoopControl
+	return ErrInvalidFPRoundingMode
 }
 
-// Loop Controls define priorities for unrolling of
-// loop constructs.
+// FPRoundingMode associates a rounding mode to a floating-point conversion
+// instruction.
 const (
-	// No control requested.
-	LoopControlNoControl = 0
-
-	// Strong request, to the extent possible, to unroll or unwind this loop.
-	LoopControlUnroll = 1
-
-	// Strong request, to the extent possible, to keep this loop as a loop,
-	// without unrolling.
-	LoopControlDontUnroll = 2
+	FPRoundingModeRTE = 0
+	FPRoundingModeRTZ = 1
+	FPRoundingModeRTP = 2
+	FPRoundingModeRTN = 3
 )
 
-type FunctionControlMask uint32
+func (v FPRoundingMode) String() string { return valueString("FPRoundingMode", uint32(v)) }
 
-func (v FunctionControlMask) Verify() error {
-	if verifyBitFlag(
-		uint32(v),
-		false,
-		FunctionControlMaskInLine|FunctionControlMaskDontInline|FunctionControlMaskPure|FunctionControlMaskConst,
-	) {
+type LinkageType uint32
+
+func (v LinkageType) Verify() error {
+	if v <= LinkageTypeImport {
 		return nil
 	}
-	return ErrInvalidFunctionControlMask
+	return ErrInvalidLinkageType
 }
 
-// Function Control Masks define bitmask hints for function optimisations.
+// LinkageType associates a linkage type to functions or global variables.
 const (
-	// Strong request, to the extent possible, to inline the function.
-	FunctionControlMaskInLine = 1
-
-	// Strong request, to the extent possible, to not inline the function.
-	FunctionControlMaskDontInline = 2
-
-	// Compiler can assume this function has no side effect, but might
-	// read global memory or read through dereferenced function parameters.
-	// Always computes the same result for the same argument values.
-	FunctionControlMaskPure = 4
-
-	// Compiler can assume this function has no side effects, and will not
-	// access global memory or dereference function parameters. Always
-	// computes the same result for the same argument values.
-	FunctionControlMaskConst = 8
+	LinkageTypeExport = 0
+	LinkageTypeImport = 1
 )
 
-type MemorySemantic uint32
+func (v LinkageType) String() string { return valueString("LinkageType", uint32(v)) }
 
-func (v MemorySemantic) Verify() error {
-	if verifyBitFlag(
-		uint32(v),
-		false,
-		MemorySemanticRelaxed|
-			MemorySemanticSequentiallyConsistent|
-			MemorySemanticAcquire|
-			MemorySemanticRelease|
-			MemorySemanticUniformMemory|
-			MemorySemanticSubgroupMemory|
-			MemorySemanticWorkgroupLocalMemory|
-			MemorySemanticWorkgroupGlobalMemory|
-			MemorySemanticAtomicCounterMemory|
-			MemorySemanticImageMemory,
-	) {
+type AccessQualifier uint32
+
+func (v AccessQualifier) Verify() error {
+	if v <= AccessQualifierReadWrite {
 		return nil
 	}
-	return ErrInvalidMemorySemantic
+	return ErrInvalidAccessQualifier
 }
 
-// Memory Semantics define bitflag memory classifications and
-// ordering semantics.
+// AccessQualifier defines the access permissions of an image or pipe.
 const (
-	// TODO: ...
-	MemorySemanticRelaxed = 1
-
-	// All observers will see this memory access in the same order WRT to
-	// other sequentially-consistent memory accesses from this invocation.
-	MemorySemanticSequentiallyConsistent = 2
+	AccessQualifierReadOnly  = 0
+	AccessQualifierWriteOnly = 1
+	AccessQualifierReadWrite = 2
+)
 
-	// All memory operations provided in program order after this memory
-	// operation will execute after this memory operation.
-	MemorySemanticAcquire = 4
+func (v AccessQualifier) String() string { return valueString("AccessQualifier", uint32(v)) }
 
-	// All memory operations provided in program order before this memory
-	// operation will execute before this memory operation.
-	MemorySemanticRelease = 8
+type FunctionParameterAttribute uint32
 
-	// Filter the memory operations being constrained to just those
-	// accessing Uniform Storage Class memory.
-	MemorySemanticUniformMemory = 16
+func (v FunctionParameterAttribute) Verify() error {
+	if v <= FunctionParameterAttributeNoReadWrite {
+		return nil
+	}
+	return ErrInvalidFunctionParameterAttribute
+}
 
-	// The memory semantics only have to be correct WRT to this invocation’s
-	// subgroup memory
-	MemorySemanticSubgroupMemory = 32
+// FunctionParameterAttribute adds additional information to the return type
+// and to each parameter of a function.
+const (
+	FunctionParameterAttributeZext        = 0
+	FunctionParameterAttributeSext        = 1
+	FunctionParameterAttributeByVal       = 2
+	FunctionParameterAttributeSret        = 3
+	FunctionParameterAttributeNoAlias     = 4
+	FunctionParameterAttributeNoCapture   = 5
+	FunctionParameterAttributeNoWrite     = 6
+	FunctionParameterAttributeNoReadWrite = 7
+)
 
-	// The memory semantics only have to be correct WRT to this invocation’s
-	// local workgroup memory.
-	MemorySemanticWorkgroupLocalMemory = 64
+func (v FunctionParameterAttribute) String() string {
+	return valueString("FunctionParameterAttribute", uint32(v))
+}
 
-	// The memory semantics only have to be correct WRT to this invocation’s
-	// global workgroup memory.
-	MemorySemanticWorkgroupGlobalMemory = 128
+type Decoration uint32
 
-	// Filter the memory operations being constrained to just those
-	// accessing AtomicCounter Storage Class memory.
-	MemorySemanticAtomicCounterMemory = 256
+func (v Decoration) Verify() error {
+	switch {
+	case v <= DecorationBuiltIn,
+		v >= DecorationNoPerspective && v <= DecorationMaxByteOffsetId,
+		v >= DecorationNoSignedWrap && v <= DecorationNoUnsignedWrap,
+		v == DecorationNonUniform,
+		v >= DecorationRestrictPointer && v <= DecorationAliasedPointer,
+		v >= DecorationCounterBuffer && v <= DecorationUserSemantic:
+		return nil
+	}
+	return ErrInvalidDecoration
+}
 
-	// Filter the memory operations being constrained to just those
-	// accessing images (see OpTypeSampler Content).
-	MemorySemanticImageMemory = 512
+// Decoration is used by the annotation instructions to attach information to
+// an <id>.
+const (
+	DecorationRelaxedPrecision     = 0
+	DecorationSpecId               = 1
+	DecorationBlock                = 2
+	DecorationBufferBlock          = 3
+	DecorationRowMajor             = 4
+	DecorationColMajor             = 5
+	DecorationArrayStride          = 6
+	DecorationMatrixStride         = 7
+	DecorationGLSLShared           = 8
+	DecorationGLSLPacked           = 9
+	DecorationCPacked              = 10
+	DecorationBuiltIn              = 11
+	DecorationNoPerspective        = 13
+	DecorationFlat                 = 14
+	DecorationPatch                = 15
+	DecorationCentroid             = 16
+	DecorationSample               = 17
+	DecorationInvariant            = 18
+	DecorationRestrict             = 19
+	DecorationAliased              = 20
+	DecorationVolatile             = 21
+	DecorationConstant             = 22
+	DecorationCoherent             = 23
+	DecorationNonWritable          = 24
+	DecorationNonReadable          = 25
+	DecorationUniform              = 26
+	DecorationUniformId            = 27
+	DecorationSaturatedConversion  = 28
+	DecorationStream               = 29
+	DecorationLocation             = 30
+	DecorationComponent            = 31
+	DecorationIndex                = 32
+	DecorationBinding              = 33
+	DecorationDescriptorSet        = 34
+	DecorationOffset               = 35
+	DecorationXfbBuffer            = 36
+	DecorationXfbStride            = 37
+	DecorationFuncParamAttr        = 38
+	DecorationFPRoundingMode       = 39
+	DecorationFPFastMathMode       = 40
+	DecorationLinkageAttributes    = 41
+	DecorationNoContraction        = 42
+	DecorationInputAttachmentIndex = 43
+	DecorationAlignment            = 44
+	DecorationMaxByteOffset        = 45
+	DecorationAlignmentId          = 46
+	DecorationMaxByteOffsetId      = 47
+	DecorationNoSignedWrap         = 4469
+	DecorationNoUnsignedWrap       = 4470
+	DecorationNonUniform           = 5300
+	DecorationRestrictPointer      = 5355
+	DecorationAliasedPointer       = 5356
+	DecorationCounterBuffer        = 5634
+	DecorationUserSemantic         = 5635
 )
 
-type MemoryAccess uint32
+// argc returns the number of extra operands which follow a Decoration
+// value v. It returns -1 for values whose operands are <id>s or a string.
+func (v Decoration) argc() int {
+	switch v {
+	case DecorationLinkageAttributes:
+		return 2
+	case DecorationSpecId, DecorationArrayStride, DecorationMatrixStride,
+		DecorationBuiltIn, DecorationStream, DecorationLocation,
+		DecorationComponent, DecorationIndex, DecorationBinding,
+		DecorationDescriptorSet, DecorationOffset, DecorationXfbBuffer,
+		DecorationXfbStride, DecorationFuncParamAttr, DecorationFPRoundingMode,
+		DecorationFPFastMathMode, DecorationInputAttachmentIndex,
+		DecorationAlignment, DecorationMaxByteOffset:
+		return 1
+	case DecorationUniformId, DecorationAlignmentId, DecorationMaxByteOffsetId,
+		DecorationCounterBuffer, DecorationUserSemantic:
+		return -1
+	}
+	return 0
+}
 
-func (v MemoryAccess) Verify() error {
-	if v >= MemoryAccessVolatile && v <= MemoryAccessAligned {
+func (v Decoration) String() string { return valueString("Decoration", uint32(v)) }
+
+type BuiltIn uint32
+
+func (v BuiltIn) Verify() error {
+	switch {
+	case v <= BuiltInPointSize,
+		v >= BuiltInClipDistance && v <= BuiltInSampleMask,
+		v >= BuiltInFragDepth && v <= BuiltInGlobalLinearId,
+		v >= BuiltInSubgroupSize && v <= BuiltInInstanceIndex,
+		v >= BuiltInSubgroupEqMask && v <= BuiltInSubgroupLtMask,
+		v >= BuiltInBaseVertex && v <= BuiltInDrawIndex,
+		v == BuiltInDeviceIndex,
+		v == BuiltInViewIndex:
 		return nil
 	}
-	return ErrInvalidMemoryAccess
+	return ErrInvalidBuiltIn
 }
 
-// Memory Access defines memory access semantics.
+// BuiltIn identifies a built-in variable or structure member.
 const (
-	// This access cannot be optimized away; it has to be executed.
-	MemoryAccessVolatile = 1
-
-	// This access has a known alignment, provided as a literal in
-	// the next operand.
-	MemoryAccessAligned = 2
+	BuiltInPosition                  = 0
+	BuiltInPointSize                 = 1
+	BuiltInClipDistance              = 3
+	BuiltInCullDistance              = 4
+	BuiltInVertexId                  = 5
+	BuiltInInstanceId                = 6
+	BuiltInPrimitiveId               = 7
+	BuiltInInvocationId              = 8
+	BuiltInLayer                     = 9
+	BuiltInViewportIndex             = 10
+	BuiltInTessLevelOuter            = 11
+	BuiltInTessLevelInner            = 12
+	BuiltInTessCoord                 = 13
+	BuiltInPatchVertices             = 14
+	BuiltInFragCoord                 = 15
+	BuiltInPointCoord                = 16
+	BuiltInFrontFacing               = 17
+	BuiltInSampleId                  = 18
+	BuiltInSamplePosition            = 19
+	BuiltInSampleMask                = 20
+	BuiltInFragDepth                 = 22
+	BuiltInHelperInvocation          = 23
+	BuiltInNumWorkgroups             = 24
+	BuiltInWorkgroupSize             = 25
+	BuiltInWorkgroupId               = 26
+	BuiltInLocalInvocationId         = 27
+	BuiltInGlobalInvocationId        = 28
+	BuiltInLocalInvocationIndex      = 29
+	BuiltInWorkDim                   = 30
+	BuiltInGlobalSize                = 31
+	BuiltInEnqueuedWorkgroupSize     = 32
+	BuiltInGlobalOffset              = 33
+	BuiltInGlobalLinearId            = 34
+	BuiltInSubgroupSize              = 36
+	BuiltInSubgroupMaxSize           = 37
+	BuiltInNumSubgroups              = 38
+	BuiltInNumEnqueuedSubgroups      = 39
+	BuiltInSubgroupId                = 40
+	BuiltInSubgroupLocalInvocationId = 41
+	BuiltInVertexIndex               = 42
+	BuiltInInstanceIndex             = 43
+	BuiltInSubgroupEqMask            = 4416
+	BuiltInSubgroupGeMask            = 4417
+	BuiltInSubgroupGtMask            = 4418
+	BuiltInSubgroupLeMask            = 4419
+	BuiltInSubgroupLtMask            = 4420
+	BuiltInBaseVertex                = 4424
+	BuiltInBaseInstance              = 4425
+	BuiltInDrawIndex                 = 4426
+	BuiltInDeviceIndex               = 4438
+	BuiltInViewIndex                 = 4440
 )
 
-type ExecutionScope uint32
+func (v BuiltIn) String() string { return valueString("BuiltIn", uint32(v)) }
+
+type Scope uint32
 
-func (v ExecutionScope) Verify() error {
-	if v >= ExecutionScopeCrossDevice && v <= ExecutionScopeSubgroup {
+func (v Scope) Verify() error {
+	if v <= ScopeQueueFamily {
 		return nil
 	}
-	return ErrInvalidExecutionScope
+	return ErrInvalidScope
 }
 
-// Execution Scopes define the scope of execution.
+// Scope is the execution or memory scope of an operation.
 const (
-	// Everything executing on all the execution devices in the system.
-	ExecutionScopeCrossDevice = 0
-
-	// Everything executing on the device executing this invocation
-	ExecutionScopeDevice = 1
-
-	// All invocations for the invoking workgroup.
-	ExecutionScopeWorkgroup = 2
-
-	// All invocations in the currently executing subgroup.
-	ExecutionScopeSubgroup = 3
+	ScopeCrossDevice = 0
+	ScopeDevice      = 1
+	ScopeWorkgroup   = 2
+	ScopeSubgroup    = 3
+	ScopeInvocation  = 4
+	ScopeQueueFamily = 5
 )
 
+func (v Scope) String() string { return valueString("Scope", uint32(v)) }
+
 type GroupOperation uint32
 
 func (v GroupOperation) Verify() error {
-	if v >= GroupOperationReduce && v <= GroupOperationExclusiveScan {
+	if v <= GroupOperationClusteredReduce {
 		return nil
 	}
 	return ErrInvalidGroupOperation
 }
 
-// Group Operations define the class of workgroup or subgroup operation.
+// GroupOperation defines the class of workgroup or subgroup operation.
 const (
-	// Returns the result of a reduction operation for all values of a
-	// specific value X specified by workitems within a workgroup.
-	GroupOperationReduce = 0
-
-	// The inclusive scan performs a binary operation with an identity
-	// I and n (where n is the size of the workgroup) elements[a0, a1, . . . an-1]
-	// and returns [a0, (a0 op a1), . . . (a0 op a1 op . . . op an-1)]
-	GroupOperationInclusiveScan = 1
-
-	// The exclusive scan performs a binary operation with an identity
-	// I and n (where n is the size of the workgroup) elements[a0, a1, . . . an-1]
-	// and returns [I, a0, (a0 op a1), . . . (a0 op a1 op . . . op an-2)].
-	GroupOperationExclusiveScan = 2
+	GroupOperationReduce          = 0
+	GroupOperationInclusiveScan   = 1
+	GroupOperationExclusiveScan   = 2
+	GroupOperationClusteredReduce = 3
 )
 
-type KernelEnqueueFlag uint32
+func (v GroupOperation) String() string { return valueString("GroupOperation", uint32(v)) }
+
+type KernelEnqueueFlags uint32
 
-func (v KernelEnqueueFlag) Verify() error {
-	if v >= KernelEnqueueFlagNoWait && v <= KernelEnqueueFlagWaitWorkGroup {
+func (v KernelEnqueueFlags) Verify() error {
+	if v <= KernelEnqueueFlagsWaitWorkGroup {
 		return nil
 	}
-	return ErrInvalidKernelEnqueueFlag
+	return ErrInvalidKernelEnqueueFlags
 }
 
-// Kernel Enqueue Flags specify when the child kernel begins execution.
-//
-// Note: Implementations are not required to honor this flag. Implementations
-// may not schedule kernel launch earlier than the point specified by this
-// flag, however.
+// KernelEnqueueFlags specifies when the child kernel begins execution.
 const (
-	// Indicates that the enqueued kernels do not need to wait for the
-	// parent kernel to finish execution before they begin execution.
-	KernelEnqueueFlagNoWait = 0
-
-	// Indicates that all work-items of the parent kernel must finish
-	// executing and all immediate side effects committed before the
-	// enqueued child kernel may begin execution.
-	//
-	// Note: Immediate meaning not side effects resulting from child
-	// kernels. The side effects would include stores to global memory
-	// and pipe reads and writes.
-	KernelEnqueueFlagWaitKernel = 1
-
-	// Indicates that the enqueued kernels wait only for the workgroup that
-	// enqueued the kernels to finish before they begin execution.
-	//
-	// Note: This acts as a memory synchronization point between work-items
-	// in a work-group and child kernels enqueued by work-items in the
-	// work-group.
-	KernelEnqueueFlagWaitWorkGroup = 2
+	KernelEnqueueFlagsNoWait        = 0
+	KernelEnqueueFlagsWaitKernel    = 1
+	KernelEnqueueFlagsWaitWorkGroup = 2
 )
 
-type KernelProfilingInfo uint32
+func (v KernelEnqueueFlags) String() string { return valueString("KernelEnqueueFlags", uint32(v)) }
+
+type Capability uint32
+
+func (v Capability) Verify() error {
+	switch {
+	case v <= CapabilityImageMipmap,
+		v >= CapabilityPipes && v <= CapabilityImageGatherExtended,
+		v >= CapabilityStorageImageMultisample && v <= CapabilityUniformDecoration,
+		v == CapabilityDrawParameters,
+		v >= CapabilityStorageBuffer16BitAccess && v <= CapabilityDeviceGroup,
+		v == CapabilityMultiView,
+		v >= CapabilityVariablePointersStorageBuffer && v <= CapabilityVariablePointers,
+		v >= CapabilityStorageBuffer8BitAccess && v <= CapabilityStoragePushConstant8,
+		v >= CapabilityDenormPreserve && v <= CapabilityRoundingModeRTZ,
+		v >= CapabilityShaderNonUniform && v <= CapabilityStorageTexelBufferArrayNonUniformIndexing,
+		v >= CapabilityVulkanMemoryModel && v <= CapabilityPhysicalStorageBufferAddresses,
+		v == CapabilityDemoteToHelperInvocation,
+		v >= CapabilityDotProductInputAll && v <= CapabilityDotProduct:
+		return nil
+	}
+	return ErrInvalidCapability
+}
 
-func (v KernelProfilingInfo) Verify() error {
-	if v == KernelProfilingInfoCmdExecTime {
+// Capability declares a capability used by a module.
+const (
+	CapabilityMatrix                                    = 0
+	CapabilityShader                                    = 1
+	CapabilityGeometry                                  = 2
+	CapabilityTessellation                              = 3
+	CapabilityAddresses                                 = 4
+	CapabilityLinkage                                   = 5
+	CapabilityKernel                                    = 6
+	CapabilityVector16                                  = 7
+	CapabilityFloat16Buffer                             = 8
+	CapabilityFloat16                                   = 9
+	CapabilityFloat64                                   = 10
+	CapabilityInt64                                     = 11
+	CapabilityInt64Atomics                              = 12
+	CapabilityImageBasic                                = 13
+	CapabilityImageReadWrite                            = 14
+	CapabilityImageMipmap                               = 15
+	CapabilityPipes                                     = 17
+	CapabilityGroups                                    = 18
+	CapabilityDeviceEnqueue                             = 19
+	CapabilityLiteralSampler                            = 20
+	CapabilityAtomicStorage                             = 21
+	CapabilityInt16                                     = 22
+	CapabilityTessellationPointSize                     = 23
+	CapabilityGeometryPointSize                         = 24
+	CapabilityImageGatherExtended                       = 25
+	CapabilityStorageImageMultisample                   = 27
+	CapabilityUniformBufferArrayDynamicIndexing         = 28
+	CapabilitySampledImageArrayDynamicIndexing          = 29
+	CapabilityStorageBufferArrayDynamicIndexing         = 30
+	CapabilityStorageImageArrayDynamicIndexing          = 31
+	CapabilityClipDistance                              = 32
+	CapabilityCullDistance                              = 33
+	CapabilityImageCubeArray                            = 34
+	CapabilitySampleRateShading                         = 35
+	CapabilityImageRect                                 = 36
+	CapabilitySampledRect                               = 37
+	CapabilityGenericPointer                            = 38
+	CapabilityInt8                                      = 39
+	CapabilityInputAttachment                           = 40
+	CapabilitySparseResidency                           = 41
+	CapabilityMinLod                                    = 42
+	CapabilitySampled1D                                 = 43
+	CapabilityImage1D                                   = 44
+	CapabilitySampledCubeArray                          = 45
+	CapabilitySampledBuffer                             = 46
+	CapabilityImageBuffer                               = 47
+	CapabilityImageMSArray                              = 48
+	CapabilityStorageImageExtendedFormats               = 49
+	CapabilityImageQuery                                = 50
+	CapabilityDerivativeControl                         = 51
+	CapabilityInterpolationFunction                     = 52
+	CapabilityTransformFeedback                         = 53
+	CapabilityGeometryStreams                           = 54
+	CapabilityStorageImageReadWithoutFormat             = 55
+	CapabilityStorageImageWriteWithoutFormat            = 56
+	CapabilityMultiViewport                             = 57
+	CapabilitySubgroupDispatch                          = 58
+	CapabilityNamedBarrier                              = 59
+	CapabilityPipeStorage                               = 60
+	CapabilityGroupNonUniform                           = 61
+	CapabilityGroupNonUniformVote                       = 62
+	CapabilityGroupNonUniformArithmetic                 = 63
+	CapabilityGroupNonUniformBallot                     = 64
+	CapabilityGroupNonUniformShuffle                    = 65
+	CapabilityGroupNonUniformShuffleRelative            = 66
+	CapabilityGroupNonUniformClustered                  = 67
+	CapabilityGroupNonUniformQuad                       = 68
+	CapabilityShaderLayer                               = 69
+	CapabilityShaderViewportIndex                       = 70
+	CapabilityUniformDecoration                         = 71
+	CapabilityDrawParameters                            = 4427
+	CapabilityStorageBuffer16BitAccess                  = 4433
+	CapabilityUniformAndStorageBuffer16BitAccess        = 4434
+	CapabilityStoragePushConstant16                     = 4435
+	CapabilityStorageInputOutput16                      = 4436
+	CapabilityDeviceGroup                               = 4437
+	CapabilityMultiView                                 = 4439
+	CapabilityVariablePointersStorageBuffer             = 4441
+	CapabilityVariablePointers                          = 4442
+	CapabilityStorageBuffer8BitAccess                   = 4448
+	CapabilityUniformAndStorageBuffer8BitAccess         = 4449
+	CapabilityStoragePushConstant8                      = 4450
+	CapabilityDenormPreserve                            = 4464
+	CapabilityDenormFlushToZero                         = 4465
+	CapabilitySignedZeroInfNanPreserve                  = 4466
+	CapabilityRoundingModeRTE                           = 4467
+	CapabilityRoundingModeRTZ                           = 4468
+	CapabilityShaderNonUniform                          = 5301
+	CapabilityRuntimeDescriptorArray                    = 5302
+	CapabilityInputAttachmentArrayDynamicIndexing       = 5303
+	CapabilityUniformTexelBufferArrayDynamicIndexing    = 5304
+	CapabilityStorageTexelBufferArrayDynamicIndexing    = 5305
+	CapabilityUniformBufferArrayNonUniformIndexing      = 5306
+	CapabilitySampledImageArrayNonUniformIndexing       = 5307
+	CapabilityStorageBufferArrayNonUniformIndexing      = 5308
+	CapabilityStorageImageArrayNonUniformIndexing       = 5309
+	CapabilityInputAttachmentArrayNonUniformIndexing    = 5310
+	CapabilityUniformTexelBufferArrayNonUniformIndexing = 5311
+	CapabilityStorageTexelBufferArrayNonUniformIndexing = 5312
+	CapabilityVulkanMemoryModel                         = 5345
+	CapabilityVulkanMemoryModelDeviceScope              = 5346
+	CapabilityPhysicalStorageBufferAddresses            = 5347
+	CapabilityDemoteToHelperInvocation                  = 5379
+	CapabilityDotProductInputAll                        = 6016
+	CapabilityDotProductInput4x8Bit                     = 6017
+	CapabilityDotProductInput4x8BitPacked               = 6018
+	CapabilityDotProduct                                = 6019
+)
+
+func (v Capability) String() string { return valueString("Capability", uint32(v)) }
+
+type PackedVectorFormat uint32
+
+func (v PackedVectorFormat) Verify() error {
+	if v == PackedVectorFormat4x8Bit {
 		return nil
 	}
-	return ErrInvalidKernelProfilingInfo
+	return ErrInvalidPackedVectorFormat
 }
 
-// Kernel Profiling Info specifies the profiling information to be queried.
-// Used by OpCaptureEventProfilingInfo.
+// PackedVectorFormat describes how vector operands of the integer dot product
+// instructions are packed.
 const (
-	KernelProfilingInfoCmdExecTime = 1
+	PackedVectorFormat4x8Bit = 0
 )
+
+func (v PackedVectorFormat) String() string { return valueString("PackedVectorFormat", uint32(v)) }
+
+// enumNames maps the values of each enum type to their names.
+var enumNames = map[string]map[uint32]string{
+	"ImageOperands": {
+		ImageOperandsNone:               "None",
+		ImageOperandsBias:               "Bias",
+		ImageOperandsLod:                "Lod",
+		ImageOperandsGrad:               "Grad",
+		ImageOperandsConstOffset:        "ConstOffset",
+		ImageOperandsOffset:             "Offset",
+		ImageOperandsConstOffsets:       "ConstOffsets",
+		ImageOperandsSample:             "Sample",
+		ImageOperandsMinLod:             "MinLod",
+		ImageOperandsMakeTexelAvailable: "MakeTexelAvailable",
+		ImageOperandsMakeTexelVisible:   "MakeTexelVisible",
+		ImageOperandsNonPrivateTexel:    "NonPrivateTexel",
+		ImageOperandsVolatileTexel:      "VolatileTexel",
+		ImageOperandsSignExtend:         "SignExtend",
+		ImageOperandsZeroExtend:         "ZeroExtend",
+		ImageOperandsNontemporal:        "Nontemporal",
+	},
+	"FPFastMathMode": {
+		FPFastMathModeNone:       "None",
+		FPFastMathModeNotNaN:     "NotNaN",
+		FPFastMathModeNotInf:     "NotInf",
+		FPFastMathModeNSZ:        "NSZ",
+		FPFastMathModeAllowRecip: "AllowRecip",
+		FPFastMathModeFast:       "Fast",
+	},
+	"SelectionControl": {
+		SelectionControlNone:        "None",
+		SelectionControlFlatten:     "Flatten",
+		SelectionControlDontFlatten: "DontFlatten",
+	},
+	"LoopControl": {
+		LoopControlNone:               "None",
+		LoopControlUnroll:             "Unroll",
+		LoopControlDontUnroll:         "DontUnroll",
+		LoopControlDependencyInfinite: "DependencyInfinite",
+		LoopControlDependencyLength:   "DependencyLength",
+		LoopControlMinIterations:      "MinIterations",
+		LoopControlMaxIterations:      "MaxIterations",
+		LoopControlIterationMultiple:  "IterationMultiple",
+		LoopControlPeelCount:          "PeelCount",
+		LoopControlPartialCount:       "PartialCount",
+	},
+	"FunctionControl": {
+		FunctionControlNone:       "None",
+		FunctionControlInline:     "Inline",
+		FunctionControlDontInline: "DontInline",
+		FunctionControlPure:       "Pure",
+		FunctionControlConst:      "Const",
+	},
+	"MemorySemantics": {
+		MemorySemanticsRelaxed:                "Relaxed",
+		MemorySemanticsAcquire:                "Acquire",
+		MemorySemanticsRelease:                "Release",
+		MemorySemanticsAcquireRelease:         "AcquireRelease",
+		MemorySemanticsSequentiallyConsistent: "SequentiallyConsistent",
+		MemorySemanticsUniformMemory:          "UniformMemory",
+		MemorySemanticsSubgroupMemory:         "SubgroupMemory",
+		MemorySemanticsWorkgroupMemory:        "WorkgroupMemory",
+		MemorySemanticsCrossWorkgroupMemory:   "CrossWorkgroupMemory",
+		MemorySemanticsAtomicCounterMemory:    "AtomicCounterMemory",
+		MemorySemanticsImageMemory:            "ImageMemory",
+		MemorySemanticsOutputMemory:           "OutputMemory",
+		MemorySemanticsMakeAvailable:          "MakeAvailable",
+		MemorySemanticsMakeVisible:            "MakeVisible",
+		MemorySemanticsVolatile:               "Volatile",
+	},
+	"MemoryAccess": {
+		MemoryAccessNone:                 "None",
+		MemoryAccessVolatile:             "Volatile",
+		MemoryAccessAligned:              "Aligned",
+		MemoryAccessNontemporal:          "Nontemporal",
+		MemoryAccessMakePointerAvailable: "MakePointerAvailable",
+		MemoryAccessMakePointerVisible:   "MakePointerVisible",
+		MemoryAccessNonPrivatePointer:    "NonPrivatePointer",
+	},
+	"KernelProfilingInfo": {
+		KernelProfilingInfoNone:        "None",
+		KernelProfilingInfoCmdExecTime: "CmdExecTime",
+	},
+	"SourceLanguage": {
+		SourceLanguageUnknown:      "Unknown",
+		SourceLanguageESSL:         "ESSL",
+		SourceLanguageGLSL:         "GLSL",
+		SourceLanguageOpenCLC:      "OpenCL_C",
+		SourceLanguageOpenCLCPP:    "OpenCL_CPP",
+		SourceLanguageHLSL:         "HLSL",
+		SourceLanguageCPPForOpenCL: "CPP_for_OpenCL",
+		SourceLanguageSYCL:         "SYCL",
+		SourceLanguageHEROC:        "HERO_C",
+		SourceLanguageNZSL:         "NZSL",
+		SourceLanguageWGSL:         "WGSL",
+		SourceLanguageSlang:        "Slang",
+		SourceLanguageZig:          "Zig",
+	},
+	"ExecutionModel": {
+		ExecutionModelVertex:                 "Vertex",
+		ExecutionModelTessellationControl:    "TessellationControl",
+		ExecutionModelTessellationEvaluation: "TessellationEvaluation",
+		ExecutionModelGeometry:               "Geometry",
+		ExecutionModelFragment:               "Fragment",
+		ExecutionModelGLCompute:              "GLCompute",
+		ExecutionModelKernel:                 "Kernel",
+	},
+	"AddressingModel": {
+		AddressingModelLogical:                 "Logical",
+		AddressingModelPhysical32:              "Physical32",
+		AddressingModelPhysical64:              "Physical64",
+		AddressingModelPhysicalStorageBuffer64: "PhysicalStorageBuffer64",
+	},
+	"MemoryModel": {
+		MemoryModelSimple:  "Simple",
+		MemoryModelGLSL450: "GLSL450",
+		MemoryModelOpenCL:  "OpenCL",
+		MemoryModelVulkan:  "Vulkan",
+	},
+	"ExecutionMode": {
+		ExecutionModeInvocations:              "Invocations",
+		ExecutionModeSpacingEqual:             "SpacingEqual",
+		ExecutionModeSpacingFractionalEven:    "SpacingFractionalEven",
+		ExecutionModeSpacingFractionalOdd:     "SpacingFractionalOdd",
+		ExecutionModeVertexOrderCw:            "VertexOrderCw",
+		ExecutionModeVertexOrderCcw:           "VertexOrderCcw",
+		ExecutionModePixelCenterInteger:       "PixelCenterInteger",
+		ExecutionModeOriginUpperLeft:          "OriginUpperLeft",
+		ExecutionModeOriginLowerLeft:          "OriginLowerLeft",
+		ExecutionModeEarlyFragmentTests:       "EarlyFragmentTests",
+		ExecutionModePointMode:                "PointMode",
+		ExecutionModeXfb:                      "Xfb",
+		ExecutionModeDepthReplacing:           "DepthReplacing",
+		ExecutionModeDepthGreater:             "DepthGreater",
+		ExecutionModeDepthLess:                "DepthLess",
+		ExecutionModeDepthUnchanged:           "DepthUnchanged",
+		ExecutionModeLocalSize:                "LocalSize",
+		ExecutionModeLocalSizeHint:            "LocalSizeHint",
+		ExecutionModeInputPoints:              "InputPoints",
+		ExecutionModeInputLines:               "InputLines",
+		ExecutionModeInputLinesAdjacency:      "InputLinesAdjacency",
+		ExecutionModeTriangles:                "Triangles",
+		ExecutionModeInputTrianglesAdjacency:  "InputTrianglesAdjacency",
+		ExecutionModeQuads:                    "Quads",
+		ExecutionModeIsolines:                 "Isolines",
+		ExecutionModeOutputVertices:           "OutputVertices",
+		ExecutionModeOutputPoints:             "OutputPoints",
+		ExecutionModeOutputLineStrip:          "OutputLineStrip",
+		ExecutionModeOutputTriangleStrip:      "OutputTriangleStrip",
+		ExecutionModeVecTypeHint:              "VecTypeHint",
+		ExecutionModeContractionOff:           "ContractionOff",
+		ExecutionModeInitializer:              "Initializer",
+		ExecutionModeFinalizer:                "Finalizer",
+		ExecutionModeSubgroupSize:             "SubgroupSize",
+		ExecutionModeSubgroupsPerWorkgroup:    "SubgroupsPerWorkgroup",
+		ExecutionModeSubgroupsPerWorkgroupId:  "SubgroupsPerWorkgroupId",
+		ExecutionModeLocalSizeId:              "LocalSizeId",
+		ExecutionModeLocalSizeHintId:          "LocalSizeHintId",
+		ExecutionModeDenormPreserve:           "DenormPreserve",
+		ExecutionModeDenormFlushToZero:        "DenormFlushToZero",
+		ExecutionModeSignedZeroInfNanPreserve: "SignedZeroInfNanPreserve",
+		ExecutionModeRoundingModeRTE:          "RoundingModeRTE",
+		ExecutionModeRoundingModeRTZ:          "RoundingModeRTZ",
+	},
+	"StorageClass": {
+		StorageClassUniformConstant:       "UniformConstant",
+		StorageClassInput:                 "Input",
+		StorageClassUniform:               "Uniform",
+		StorageClassOutput:                "Output",
+		StorageClassWorkgroup:             "Workgroup",
+		StorageClassCrossWorkgroup:        "CrossWorkgroup",
+		StorageClassPrivate:               "Private",
+		StorageClassFunction:              "Function",
+		StorageClassGeneric:               "Generic",
+		StorageClassPushConstant:          "PushConstant",
+		StorageClassAtomicCounter:         "AtomicCounter",
+		StorageClassImage:                 "Image",
+		StorageClassStorageBuffer:         "StorageBuffer",
+		StorageClassPhysicalStorageBuffer: "PhysicalStorageBuffer",
+	},
+	"Dim": {
+		Dim1D:          "1D",
+		Dim2D:          "2D",
+		Dim3D:          "3D",
+		DimCube:        "Cube",
+		DimRect:        "Rect",
+		DimBuffer:      "Buffer",
+		DimSubpassData: "SubpassData",
+	},
+	"SamplerAddressingMode": {
+		SamplerAddressingModeNone:           "None",
+		SamplerAddressingModeClampToEdge:    "ClampToEdge",
+		SamplerAddressingModeClamp:          "Clamp",
+		SamplerAddressingModeRepeat:         "Repeat",
+		SamplerAddressingModeRepeatMirrored: "RepeatMirrored",
+	},
+	"SamplerFilterMode": {
+		SamplerFilterModeNearest: "Nearest",
+		SamplerFilterModeLinear:  "Linear",
+	},
+	"ImageFormat": {
+		ImageFormatUnknown:      "Unknown",
+		ImageFormatRgba32f:      "Rgba32f",
+		ImageFormatRgba16f:      "Rgba16f",
+		ImageFormatR32f:         "R32f",
+		ImageFormatRgba8:        "Rgba8",
+		ImageFormatRgba8Snorm:   "Rgba8Snorm",
+		ImageFormatRg32f:        "Rg32f",
+		ImageFormatRg16f:        "Rg16f",
+		ImageFormatR11fG11fB10f: "R11fG11fB10f",
+		ImageFormatR16f:         "R16f",
+		ImageFormatRgba16:       "Rgba16",
+		ImageFormatRgb10A2:      "Rgb10A2",
+		ImageFormatRg16:         "Rg16",
+		ImageFormatRg8:          "Rg8",
+		ImageFormatR16:          "R16",
+		ImageFormatR8:           "R8",
+		ImageFormatRgba16Snorm:  "Rgba16Snorm",
+		ImageFormatRg16Snorm:    "Rg16Snorm",
+		ImageFormatRg8Snorm:     "Rg8Snorm",
+		ImageFormatR16Snorm:     "R16Snorm",
+		ImageFormatR8Snorm:      "R8Snorm",
+		ImageFormatRgba32i:      "Rgba32i",
+		ImageFormatRgba16i:      "Rgba16i",
+		ImageFormatRgba8i:       "Rgba8i",
+		ImageFormatR32i:         "R32i",
+		ImageFormatRg32i:        "Rg32i",
+		ImageFormatRg16i:        "Rg16i",
+		ImageFormatRg8i:         "Rg8i",
+		ImageFormatR16i:         "R16i",
+		ImageFormatR8i:          "R8i",
+		ImageFormatRgba32ui:     "Rgba32ui",
+		ImageFormatRgba16ui:     "Rgba16ui",
+		ImageFormatRgba8ui:      "Rgba8ui",
+		ImageFormatR32ui:        "R32ui",
+		ImageFormatRgb10a2ui:    "Rgb10a2ui",
+		ImageFormatRg32ui:       "Rg32ui",
+		ImageFormatRg16ui:       "Rg16ui",
+		ImageFormatRg8ui:        "Rg8ui",
+		ImageFormatR16ui:        "R16ui",
+		ImageFormatR8ui:         "R8ui",
+	},
+	"ImageChannelOrder": {
+		ImageChannelOrderR:            "R",
+		ImageChannelOrderA:            "A",
+		ImageChannelOrderRG:           "RG",
+		ImageChannelOrderRA:           "RA",
+		ImageChannelOrderRGB:          "RGB",
+		ImageChannelOrderRGBA:         "RGBA",
+		ImageChannelOrderBGRA:         "BGRA",
+		ImageChannelOrderARGB:         "ARGB",
+		ImageChannelOrderIntensity:    "Intensity",
+		ImageChannelOrderLuminance:    "Luminance",
+		ImageChannelOrderRx:           "Rx",
+		ImageChannelOrderRGx:          "RGx",
+		ImageChannelOrderRGBx:         "RGBx",
+		ImageChannelOrderDepth:        "Depth",
+		ImageChannelOrderDepthStencil: "DepthStencil",
+		ImageChannelOrdersRGB:         "sRGB",
+		ImageChannelOrdersRGBx:        "sRGBx",
+		ImageChannelOrdersRGBA:        "sRGBA",
+		ImageChannelOrdersBGRA:        "sBGRA",
+		ImageChannelOrderABGR:         "ABGR",
+	},
+	"ImageChannelDataType": {
+		ImageChannelDataTypeSnormInt8:       "SnormInt8",
+		ImageChannelDataTypeSnormInt16:      "SnormInt16",
+		ImageChannelDataTypeUnormInt8:       "UnormInt8",
+		ImageChannelDataTypeUnormInt16:      "UnormInt16",
+		ImageChannelDataTypeUnormShort565:   "UnormShort565",
+		ImageChannelDataTypeUnormShort555:   "UnormShort555",
+		ImageChannelDataTypeUnormInt101010:  "UnormInt101010",
+		ImageChannelDataTypeSignedInt8:      "SignedInt8",
+		ImageChannelDataTypeSignedInt16:     "SignedInt16",
+		ImageChannelDataTypeSignedInt32:     "SignedInt32",
+		ImageChannelDataTypeUnsignedInt8:    "UnsignedInt8",
+		ImageChannelDataTypeUnsignedInt16:   "UnsignedInt16",
+		ImageChannelDataTypeUnsignedInt32:   "UnsignedInt32",
+		ImageChannelDataTypeHalfFloat:       "HalfFloat",
+		ImageChannelDataTypeFloat:           "Float",
+		ImageChannelDataTypeUnormInt24:      "UnormInt24",
+		ImageChannelDataTypeUnormInt1010102: "UnormInt101010_2",
+	},
+	"FPRoundingMode": {
+		FPRoundingModeRTE: "RTE",
+		FPRoundingModeRTZ: "RTZ",
+		FPRoundingModeRTP: "RTP",
+		FPRoundingModeRTN: "RTN",
+	},
+	"LinkageType": {
+		LinkageTypeExport: "Export",
+		LinkageTypeImport: "Import",
+	},
+	"AccessQualifier": {
+		AccessQualifierReadOnly:  "ReadOnly",
+		AccessQualifierWriteOnly: "WriteOnly",
+		AccessQualifierReadWrite: "ReadWrite",
+	},
+	"FunctionParameterAttribute": {
+		FunctionParameterAttributeZext:        "Zext",
+		FunctionParameterAttributeSext:        "Sext",
+		FunctionParameterAttributeByVal:       "ByVal",
+		FunctionParameterAttributeSret:        "Sret",
+		FunctionParameterAttributeNoAlias:     "NoAlias",
+		FunctionParameterAttributeNoCapture:   "NoCapture",
+		FunctionParameterAttributeNoWrite:     "NoWrite",
+		FunctionParameterAttributeNoReadWrite: "NoReadWrite",
+	},
+	"Decoration": {
+		DecorationRelaxedPrecision:     "RelaxedPrecision",
+		DecorationSpecId:               "SpecId",
+		DecorationBlock:                "Block",
+		DecorationBufferBlock:          "BufferBlock",
+		DecorationRowMajor:             "RowMajor",
+		DecorationColMajor:             "ColMajor",
+		DecorationArrayStride:          "ArrayStride",
+		DecorationMatrixStride:         "MatrixStride",
+		DecorationGLSLShared:           "GLSLShared",
+		DecorationGLSLPacked:           "GLSLPacked",
+		DecorationCPacked:              "CPacked",
+		DecorationBuiltIn:              "BuiltIn",
+		DecorationNoPerspective:        "NoPerspective",
+		DecorationFlat:                 "Flat",
+		DecorationPatch:                "Patch",
+		DecorationCentroid:             "Centroid",
+		DecorationSample:               "Sample",
+		DecorationInvariant:            "Invariant",
+		DecorationRestrict:             "Restrict",
+		DecorationAliased:              "Aliased",
+		DecorationVolatile:             "Volatile",
+		DecorationConstant:             "Constant",
+		DecorationCoherent:             "Coherent",
+		DecorationNonWritable:          "NonWritable",
+		DecorationNonReadable:          "NonReadable",
+		DecorationUniform:              "Uniform",
+		DecorationUniformId:            "UniformId",
+		DecorationSaturatedConversion:  "SaturatedConversion",
+		DecorationStream:               "Stream",
+		DecorationLocation:             "Location",
+		DecorationComponent:            "Component",
+		DecorationIndex:                "Index",
+		DecorationBinding:              "Binding",
+		DecorationDescriptorSet:        "DescriptorSet",
+		DecorationOffset:               "Offset",
+		DecorationXfbBuffer:            "XfbBuffer",
+		DecorationXfbStride:            "XfbStride",
+		DecorationFuncParamAttr:        "FuncParamAttr",
+		DecorationFPRoundingMode:       "FPRoundingMode",
+		DecorationFPFastMathMode:       "FPFastMathMode",
+		DecorationLinkageAttributes:    "LinkageAttributes",
+		DecorationNoContraction:        "NoContraction",
+		DecorationInputAttachmentIndex: "InputAttachmentIndex",
+		DecorationAlignment:            "Alignment",
+		DecorationMaxByteOffset:        "MaxByteOffset",
+		DecorationAlignmentId:          "AlignmentId",
+		DecorationMaxByteOffsetId:      "MaxByteOffsetId",
+		DecorationNoSignedWrap:         "NoSignedWrap",
+		DecorationNoUnsignedWrap:       "NoUnsignedWrap",
+		DecorationNonUniform:           "NonUniform",
+		DecorationRestrictPointer:      "RestrictPointer",
+		DecorationAliasedPointer:       "AliasedPointer",
+		DecorationCounterBuffer:        "CounterBuffer",
+		DecorationUserSemantic:         "UserSemantic",
+	},
+	"BuiltIn": {
+		BuiltInPosition:                  "Position",
+		BuiltInPointSize:                 "PointSize",
+		BuiltInClipDistance:              "ClipDistance",
+		BuiltInCullDistance:              "CullDistance",
+		BuiltInVertexId:                  "VertexId",
+		BuiltInInstanceId:                "InstanceId",
+		BuiltInPrimitiveId:               "PrimitiveId",
+		BuiltInInvocationId:              "InvocationId",
+		BuiltInLayer:                     "Layer",
+		BuiltInViewportIndex:             "ViewportIndex",
+		BuiltInTessLevelOuter:            "TessLevelOuter",
+		BuiltInTessLevelInner:            "TessLevelInner",
+		BuiltInTessCoord:                 "TessCoord",
+		BuiltInPatchVertices:             "PatchVertices",
+		BuiltInFragCoord:                 "FragCoord",
+		BuiltInPointCoord:                "PointCoord",
+		BuiltInFrontFacing:               "FrontFacing",
+		BuiltInSampleId:                  "SampleId",
+		BuiltInSamplePosition:            "SamplePosition",
+		BuiltInSampleMask:                "SampleMask",
+		BuiltInFragDepth:                 "FragDepth",
+		BuiltInHelperInvocation:          "HelperInvocation",
+		BuiltInNumWorkgroups:             "NumWorkgroups",
+		BuiltInWorkgroupSize:             "WorkgroupSize",
+		BuiltInWorkgroupId:               "WorkgroupId",
+		BuiltInLocalInvocationId:         "LocalInvocationId",
+		BuiltInGlobalInvocationId:        "GlobalInvocationId",
+		BuiltInLocalInvocationIndex:      "LocalInvocationIndex",
+		BuiltInWorkDim:                   "WorkDim",
+		BuiltInGlobalSize:                "GlobalSize",
+		BuiltInEnqueuedWorkgroupSize:     "EnqueuedWorkgroupSize",
+		BuiltInGlobalOffset:              "GlobalOffset",
+		BuiltInGlobalLinearId:            "GlobalLinearId",
+		BuiltInSubgroupSize:              "SubgroupSize",
+		BuiltInSubgroupMaxSize:           "SubgroupMaxSize",
+		BuiltInNumSubgroups:              "NumSubgroups",
+		BuiltInNumEnqueuedSubgroups:      "NumEnqueuedSubgroups",
+		BuiltInSubgroupId:                "SubgroupId",
+		BuiltInSubgroupLocalInvocationId: "SubgroupLocalInvocationId",
+		BuiltInVertexIndex:               "VertexIndex",
+		BuiltInInstanceIndex:             "InstanceIndex",
+		BuiltInSubgroupEqMask:            "SubgroupEqMask",
+		BuiltInSubgroupGeMask:            "SubgroupGeMask",
+		BuiltInSubgroupGtMask:            "SubgroupGtMask",
+		BuiltInSubgroupLeMask:            "SubgroupLeMask",
+		BuiltInSubgroupLtMask:            "SubgroupLtMask",
+		BuiltInBaseVertex:                "BaseVertex",
+		BuiltInBaseInstance:              "BaseInstance",
+		BuiltInDrawIndex:                 "DrawIndex",
+		BuiltInDeviceIndex:               "DeviceIndex",
+		BuiltInViewIndex:                 "ViewIndex",
+	},
+	"Scope": {
+		ScopeCrossDevice: "CrossDevice",
+		ScopeDevice:      "Device",
+		ScopeWorkgroup:   "Workgroup",
+		ScopeSubgroup:    "Subgroup",
+		ScopeInvocation:  "Invocation",
+		ScopeQueueFamily: "QueueFamily",
+	},
+	"GroupOperation": {
+		GroupOperationReduce:          "Reduce",
+		GroupOperationInclusiveScan:   "InclusiveScan",
+		GroupOperationExclusiveScan:   "ExclusiveScan",
+		GroupOperationClusteredReduce: "ClusteredReduce",
+	},
+	"KernelEnqueueFlags": {
+		KernelEnqueueFlagsNoWait:        "NoWait",
+		KernelEnqueueFlagsWaitKernel:    "WaitKernel",
+		KernelEnqueueFlagsWaitWorkGroup: "WaitWorkGroup",
+	},
+	"Capability": {
+		CapabilityMatrix:                                    "Matrix",
+		CapabilityShader:                                    "Shader",
+		CapabilityGeometry:                                  "Geometry",
+		CapabilityTessellation:                              "Tessellation",
+		CapabilityAddresses:                                 "Addresses",
+		CapabilityLinkage:                                   "Linkage",
+		CapabilityKernel:                                    "Kernel",
+		CapabilityVector16:                                  "Vector16",
+		CapabilityFloat16Buffer:                             "Float16Buffer",
+		CapabilityFloat16:                                   "Float16",
+		CapabilityFloat64:                                   "Float64",
+		CapabilityInt64:                                     "Int64",
+		CapabilityInt64Atomics:                              "Int64Atomics",
+		CapabilityImageBasic:                                "ImageBasic",
+		CapabilityImageReadWrite:                            "ImageReadWrite",
+		CapabilityImageMipmap:                               "ImageMipmap",
+		CapabilityPipes:                                     "Pipes",
+		CapabilityGroups:                                    "Groups",
+		CapabilityDeviceEnqueue:                             "DeviceEnqueue",
+		CapabilityLiteralSampler:                            "LiteralSampler",
+		CapabilityAtomicStorage:                             "AtomicStorage",
+		CapabilityInt16:                                     "Int16",
+		CapabilityTessellationPointSize:                     "TessellationPointSize",
+		CapabilityGeometryPointSize:                         "GeometryPointSize",
+		CapabilityImageGatherExtended:                       "ImageGatherExtended",
+		CapabilityStorageImageMultisample:                   "StorageImageMultisample",
+		CapabilityUniformBufferArrayDynamicIndexing:         "UniformBufferArrayDynamicIndexing",
+		CapabilitySampledImageArrayDynamicIndexing:          "SampledImageArrayDynamicIndexing",
+		CapabilityStorageBufferArrayDynamicIndexing:         "StorageBufferArrayDynamicIndexing",
+		CapabilityStorageImageArrayDynamicIndexing:          "StorageImageArrayDynamicIndexing",
+		CapabilityClipDistance:                              "ClipDistance",
+		CapabilityCullDistance:                              "CullDistance",
+		CapabilityImageCubeArray:                            "ImageCubeArray",
+		CapabilitySampleRateShading:                         "SampleRateShading",
+		CapabilityImageRect:                                 "ImageRect",
+		CapabilitySampledRect:                               "SampledRect",
+		CapabilityGenericPointer:                            "GenericPointer",
+		CapabilityInt8:                                      "Int8",
+		CapabilityInputAttachment:                           "InputAttachment",
+		CapabilitySparseResidency:                           "SparseResidency",
+		CapabilityMinLod:                                    "MinLod",
+		CapabilitySampled1D:                                 "Sampled1D",
+		CapabilityImage1D:                                   "Image1D",
+		CapabilitySampledCubeArray:                          "SampledCubeArray",
+		CapabilitySampledBuffer:                             "SampledBuffer",
+		CapabilityImageBuffer:                               "ImageBuffer",
+		CapabilityImageMSArray:                              "ImageMSArray",
+		CapabilityStorageImageExtendedFormats:               "StorageImageExtendedFormats",
+		CapabilityImageQuery:                                "ImageQuery",
+		CapabilityDerivativeControl:                         "DerivativeControl",
+		CapabilityInterpolationFunction:                     "InterpolationFunction",
+		CapabilityTransformFeedback:                         "TransformFeedback",
+		CapabilityGeometryStreams:                           "GeometryStreams",
+		CapabilityStorageImageReadWithoutFormat:             "StorageImageReadWithoutFormat",
+		CapabilityStorageImageWriteWithoutFormat:            "StorageImageWriteWithoutFormat",
+		CapabilityMultiViewport:                             "MultiViewport",
+		CapabilitySubgroupDispatch:                          "SubgroupDispatch",
+		CapabilityNamedBarrier:                              "NamedBarrier",
+		CapabilityPipeStorage:                               "PipeStorage",
+		CapabilityGroupNonUniform:                           "GroupNonUniform",
+		CapabilityGroupNonUniformVote:                       "GroupNonUniformVote",
+		CapabilityGroupNonUniformArithmetic:                 "GroupNonUniformArithmetic",
+		CapabilityGroupNonUniformBallot:                     "GroupNonUniformBallot",
+		CapabilityGroupNonUniformShuffle:                    "GroupNonUniformShuffle",
+		CapabilityGroupNonUniformShuffleRelative:            "GroupNonUniformShuffleRelative",
+		CapabilityGroupNonUniformClustered:                  "GroupNonUniformClustered",
+		CapabilityGroupNonUniformQuad:                       "GroupNonUniformQuad",
+		CapabilityShaderLayer:                               "ShaderLayer",
+		CapabilityShaderViewportIndex:                       "ShaderViewportIndex",
+		CapabilityUniformDecoration:                         "UniformDecoration",
+		CapabilityDrawParameters:                            "DrawParameters",
+		CapabilityStorageBuffer16BitAccess:                  "StorageBuffer16BitAccess",
+		CapabilityUniformAndStorageBuffer16BitAccess:        "UniformAndStorageBuffer16BitAccess",
+		CapabilityStoragePushConstant16:                     "StoragePushConstant16",
+		CapabilityStorageInputOutput16:                      "StorageInputOutput16",
+		CapabilityDeviceGroup:                               "DeviceGroup",
+		CapabilityMultiView:                                 "MultiView",
+		CapabilityVariablePointersStorageBuffer:             "VariablePointersStorageBuffer",
+		CapabilityVariablePointers:                          "VariablePointers",
+		CapabilityStorageBuffer8BitAccess:                   "StorageBuffer8BitAccess",
+		CapabilityUniformAndStorageBuffer8BitAccess:         "UniformAndStorageBuffer8BitAccess",
+		CapabilityStoragePushConstant8:                      "StoragePushConstant8",
+		CapabilityDenormPreserve:                            "DenormPreserve",
+		CapabilityDenormFlushToZero:                         "DenormFlushToZero",
+		CapabilitySignedZeroInfNanPreserve:                  "SignedZeroInfNanPreserve",
+		CapabilityRoundingModeRTE:                           "RoundingModeRTE",
+		CapabilityRoundingModeRTZ:                           "RoundingModeRTZ",
+		CapabilityShaderNonUniform:                          "ShaderNonUniform",
+		CapabilityRuntimeDescriptorArray:                    "RuntimeDescriptorArray",
+		CapabilityInputAttachmentArrayDynamicIndexing:       "InputAttachmentArrayDynamicIndexing",
+		CapabilityUniformTexelBufferArrayDynamicIndexing:    "UniformTexelBufferArrayDynamicIndexing",
+		CapabilityStorageTexelBufferArrayDynamicIndexing:    "StorageTexelBufferArrayDynamicIndexing",
+		CapabilityUniformBufferArrayNonUniformIndexing:      "UniformBufferArrayNonUniformIndexing",
+		CapabilitySampledImageArrayNonUniformIndexing:       "SampledImageArrayNonUniformIndexing",
+		CapabilityStorageBufferArrayNonUniformIndexing:      "StorageBufferArrayNonUniformIndexing",
+		CapabilityStorageImageArrayNonUniformIndexing:       "StorageImageArrayNonUniformIndexing",
+		CapabilityInputAttachmentArrayNonUniformIndexing:    "InputAttachmentArrayNonUniformIndexing",
+		CapabilityUniformTexelBufferArrayNonUniformIndexing: "UniformTexelBufferArrayNonUniformIndexing",
+		CapabilityStorageTexelBufferArrayNonUniformIndexing: "StorageTexelBufferArrayNonUniformIndexing",
+		CapabilityVulkanMemoryModel:                         "VulkanMemoryModel",
+		CapabilityVulkanMemoryModelDeviceScope:              "VulkanMemoryModelDeviceScope",
+		CapabilityPhysicalStorageBufferAddresses:            "PhysicalStorageBufferAddresses",
+		CapabilityDemoteToHelperInvocation:                  "DemoteToHelperInvocation",
+		CapabilityDotProductInputAll:                        "DotProductInputAll",
+		CapabilityDotProductInput4x8Bit:                     "DotProductInput4x8Bit",
+		CapabilityDotProductInput4x8BitPacked:               "DotProductInput4x8BitPacked",
+		CapabilityDotProduct:                                "DotProduct",
+	},
+	"PackedVectorFormat": {
+		PackedVectorFormat4x8Bit: "PackedVectorFormat4x8Bit",
+	},
+}
+
+// enumAliases maps the alternative names of enum values to their values.
+var enumAliases = map[string]map[string]uint32{
+	"MemorySemantics": {
+		"None": MemorySemanticsNone,
+	},
+}
+
+// bitEnums lists the enum types whose values are bit masks.
+var bitEnums = map[string]bool{
+	"ImageOperands":       true,
+	"FPFastMathMode":      true,
+	"SelectionControl":    true,
+	"LoopControl":         true,
+	"FunctionControl":     true,
+	"MemorySemantics":     true,
+	"MemoryAccess":        true,
+	"KernelProfilingInfo": true,
+}
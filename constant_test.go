@@ -3,48 +3,26 @@
 
 package spirv
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 type constantTest struct {
 	verify func(uint32) error
 	min    uint32
 	max    uint32
 	err    error
-	even   bool
 }
 
 func TestConstant(t *testing.T) {
 	for _, ct := range []constantTest{
 		{
-			min: AccessQualifierReadOnly,
-			max: AccessQualifierReadWrite,
-			err: ErrInvalidAccessQualifier,
-			verify: func(w uint32) error {
-				return AccessQualifier(w).Verify()
-			},
-		},
-		{
-			min: AddressingModeLogical,
-			max: AddressingModePhysical64,
-			err: ErrInvalidAddressingModel,
-			verify: func(w uint32) error {
-				return AddressingModel(w).Verify()
-			},
-		},
-		{
-			min: Dim1D,
-			max: DimBuffer,
-			err: ErrInvalidDimensionality,
-			verify: func(w uint32) error {
-				return Dimensionality(w).Verify()
-			},
-		},
-		{
-			min: ExecutionModeInvocations,
-			max: ExecutionModeContractionOff,
-			err: ErrInvalidExecutionMode,
+			min: SourceLanguageUnknown,
+			max: SourceLanguageZig,
+			err: ErrInvalidSourceLanguage,
 			verify: func(w uint32) error {
-				return ExecutionMode(w).Verify()
+				return SourceLanguage(w).Verify()
 			},
 		},
 		{
@@ -56,177 +34,83 @@ func TestConstant(t *testing.T) {
 			},
 		},
 		{
-			min: FPFastMathModeNotNaN,
-			max: FPFastMathModeNotNaN | FPFastMathModeNotInf |
-				FPFastMathModeNSZ | FPFastMathModeAllowRecip |
-				FPFastMathModeFast,
-			err: ErrInvalidFPFastMathMode,
-			verify: func(w uint32) error {
-				return FPFastMathMode(w).Verify()
-			},
-		},
-		{
-			min: FPRoundingModeRTE,
-			max: FPRoundingModeRTN,
-			err: ErrInvalidFPRoundingMode,
-			verify: func(w uint32) error {
-				return FPRoundingMode(w).Verify()
-			},
-		},
-		{
-			min: LinkageTypeExport,
-			max: LinkageTypeImport,
-			err: ErrInvalidLinkageType,
+			min: AddressingModelLogical,
+			max: AddressingModelPhysical64,
+			err: ErrInvalidAddressingModel,
 			verify: func(w uint32) error {
-				return LinkageType(w).Verify()
+				return AddressingModel(w).Verify()
 			},
 		},
 		{
 			min: MemoryModelSimple,
-			max: MemoryModelOpenCL21,
+			max: MemoryModelVulkan,
 			err: ErrInvalidMemoryModel,
 			verify: func(w uint32) error {
 				return MemoryModel(w).Verify()
 			},
 		},
 		{
-			min:  SamplerAddressingModeNone,
-			max:  SamplerAddressingModeRepeatMirrored,
-			err:  ErrInvalidSamplerAddressingMode,
-			even: true,
-			verify: func(w uint32) error {
-				return SamplerAddressingMode(w).Verify()
-			},
-		},
-		{
-			min:  SamplerFilterModeNearest,
-			max:  SamplerFilterModeLinear,
-			err:  ErrInvalidSamplerFilterMode,
-			even: true,
-			verify: func(w uint32) error {
-				return SamplerFilterMode(w).Verify()
-			},
-		},
-		{
-			min: SourceLanguageUnknown,
-			max: SourceLanguageOpenCL,
-			err: ErrInvalidSourceLanguage,
-			verify: func(w uint32) error {
-				return SourceLanguage(w).Verify()
-			},
-		},
-		{
-			min: StorageClassUniformConstant,
-			max: StorageClassAtomicCounter,
-			err: ErrInvalidStorageClass,
-			verify: func(w uint32) error {
-				return StorageClass(w).Verify()
-			},
-		},
-		{
-			min: FunctionParamAttrZext,
-			max: FunctionParamAttrNoReadWrite,
-			err: ErrInvalidFunctionParameter,
-			verify: func(w uint32) error {
-				return FunctionParameter(w).Verify()
-			},
-		},
-		{
-			min: DecorationPrecisionLow,
-			max: DecorationSpecId,
-			err: ErrInvalidDecoration,
-			verify: func(w uint32) error {
-				return Decoration(w).Verify()
-			},
-		},
-		{
-			min: BuiltinPosition,
-			max: BuiltinSubgroupLocalInvocationId,
-			err: ErrInvalidBuiltin,
-			verify: func(w uint32) error {
-				return Builtin(w).Verify()
-			},
-		},
-		{
-			min: SelectionControlNoControl,
-			max: SelectionControlDontFlatten,
-			err: ErrInvalidSelectionControl,
+			min: Dim1D,
+			max: DimSubpassData,
+			err: ErrInvalidDim,
 			verify: func(w uint32) error {
-				return SelectionControl(w).Verify()
+				return Dim(w).Verify()
 			},
 		},
 		{
-			min: LoopControlNoControl,
-			max: LoopControlDontUnroll,
-			err: ErrInvalidLoopControl,
+			min: SamplerAddressingModeNone,
+			max: SamplerAddressingModeRepeatMirrored,
+			err: ErrInvalidSamplerAddressingMode,
 			verify: func(w uint32) error {
-				return LoopControl(w).Verify()
+				return SamplerAddressingMode(w).Verify()
 			},
 		},
 		{
-			min: FunctionControlMaskInLine,
-			max: FunctionControlMaskInLine | FunctionControlMaskDontInline |
-				FunctionControlMaskPure | FunctionControlMaskConst,
-			err: ErrInvalidFunctionControlMask,
+			min: SamplerFilterModeNearest,
+			max: SamplerFilterModeLinear,
+			err: ErrInvalidSamplerFilterMode,
 			verify: func(w uint32) error {
-				return FunctionControlMask(w).Verify()
+				return SamplerFilterMode(w).Verify()
 			},
 		},
 		{
-			min: MemorySemanticRelaxed,
-			max: MemorySemanticRelaxed |
-				MemorySemanticSequentiallyConsistent |
-				MemorySemanticAcquire |
-				MemorySemanticRelease |
-				MemorySemanticUniformMemory |
-				MemorySemanticSubgroupMemory |
-				MemorySemanticWorkgroupLocalMemory |
-				MemorySemanticWorkgroupGlobalMemory |
-				MemorySemanticAtomicCounterMemory |
-				MemorySemanticImageMemory,
-			err: ErrInvalidMemorySemantic,
+			min: FPRoundingModeRTE,
+			max: FPRoundingModeRTN,
+			err: ErrInvalidFPRoundingMode,
 			verify: func(w uint32) error {
-				return MemorySemantic(w).Verify()
+				return FPRoundingMode(w).Verify()
 			},
 		},
 		{
-			min: MemoryAccessVolatile,
-			max: MemoryAccessAligned,
-			err: ErrInvalidMemoryAccess,
+			min: AccessQualifierReadOnly,
+			max: AccessQualifierReadWrite,
+			err: ErrInvalidAccessQualifier,
 			verify: func(w uint32) error {
-				return MemoryAccess(w).Verify()
+				return AccessQualifier(w).Verify()
 			},
 		},
 		{
-			min: ExecutionScopeCrossDevice,
-			max: ExecutionScopeSubgroup,
-			err: ErrInvalidExecutionScope,
+			min: ScopeCrossDevice,
+			max: ScopeQueueFamily,
+			err: ErrInvalidScope,
 			verify: func(w uint32) error {
-				return ExecutionScope(w).Verify()
+				return Scope(w).Verify()
 			},
 		},
 		{
 			min: GroupOperationReduce,
-			max: GroupOperationExclusiveScan,
+			max: GroupOperationClusteredReduce,
 			err: ErrInvalidGroupOperation,
 			verify: func(w uint32) error {
 				return GroupOperation(w).Verify()
 			},
 		},
 		{
-			min: KernelEnqueueFlagNoWait,
-			max: KernelEnqueueFlagWaitWorkGroup,
-			err: ErrInvalidKernelEnqueueFlag,
+			min: KernelEnqueueFlagsNoWait,
+			max: KernelEnqueueFlagsWaitWorkGroup,
+			err: ErrInvalidKernelEnqueueFlags,
 			verify: func(w uint32) error {
-				return KernelEnqueueFlag(w).Verify()
-			},
-		},
-		{
-			min: KernelProfilingInfoCmdExecTime,
-			max: KernelProfilingInfoCmdExecTime,
-			err: ErrInvalidKernelProfilingInfo,
-			verify: func(w uint32) error {
-				return KernelProfilingInfo(w).Verify()
+				return KernelEnqueueFlags(w).Verify()
 			},
 		},
 	} {
@@ -245,20 +129,6 @@ func TestConstant(t *testing.T) {
 			t.Fatalf("Invalid value mismatch: %d\nHave: %v\nWant: %v",
 				ct.max, have, ct.err)
 		}
-
-		if !ct.even {
-			continue
-		}
-
-		// SamplerAddressingMode and SamplerFilterMode are not exactly bit flags,
-		// but they are numbered like one; sort of.
-		//
-		// Giving it odd numbers inside the valid range should be an error.
-		have = ct.verify(ct.min + 1)
-		if have != ct.err {
-			t.Fatalf("Odd value mismatch: %d\nHave: %v\nWant: %v",
-				ct.max, have, ct.err)
-		}
 	}
 }
 
@@ -276,15 +146,54 @@ func TestBitflag(t *testing.T) {
 		{0, true, 7, true},
 		{2, true, 7, true},
 		{5, true, 7, true},
+		{8, true, 7, false},
+	} {
+		have := verifyBitFlag(bt.in, bt.none, bt.mask)
+		if bt.want != have {
+			t.Fatalf("mismatch: in: %x, none: %v, mask: %x, want: %v, have: %v",
+				bt.in, bt.none, bt.mask, bt.want, have)
+		}
+	}
+}
+
+func TestConstantString(t *testing.T) {
+	for _, st := range []struct {
+		have fmt.Stringer
+		want string
+	}{
+		{StorageClass(StorageClassFunction), "Function"},
+		{StorageClass(12345), "StorageClass(12345)"},
+		{BuiltIn(BuiltInPosition), "Position"},
+		{MemoryAccess(0), "None"},
+		{MemoryAccess(MemoryAccessVolatile | MemoryAccessAligned), "Volatile|Aligned"},
+		{MemoryAccess(MemoryAccessNontemporal | 0x80000000), "Nontemporal|0x80000000"},
+		{FunctionControl(FunctionControlInline), "Inline"},
 	} {
-		testBitflag(t, bt.in, bt.none, bt.mask, bt.want)
+		if have := st.have.String(); have != st.want {
+			t.Fatalf("%T(%d): name mismatch:\nHave: %s\nWant: %s", st.have, st.have, have, st.want)
+		}
 	}
 }
 
-func testBitflag(t *testing.T, in uint32, none bool, mask uint32, want bool) {
-	have := verifyBitFlag(in, none, mask)
-	if want != have {
-		t.Fatalf("mismatch: in: %x, none: %v, mask: %x, want: %v, have: %v",
-			in, none, mask, want, have)
+func TestEnumValue(t *testing.T) {
+	for _, st := range []struct {
+		kind, name string
+		want       uint32
+		ok         bool
+	}{
+		{"StorageClass", "Function", StorageClassFunction, true},
+		{"StorageClass", "Funktion", 0, false},
+		{"Storage", "Function", 0, false},
+		{"MemoryAccess", "Volatile|Aligned", MemoryAccessVolatile | MemoryAccessAligned, true},
+		{"MemoryAccess", "None", MemoryAccessNone, true},
+		{"MemoryAccess", "Volatile|", 0, false},
+		{"MemorySemantics", "Relaxed", MemorySemanticsNone, true},
+		{"ExecutionModel", "Vertex|Fragment", 0, false},
+	} {
+		have, ok := EnumValue(st.kind, st.name)
+		if have != st.want || ok != st.ok {
+			t.Fatalf("%s %s: value mismatch:\nHave: %d, %v\nWant: %d, %v",
+				st.kind, st.name, have, ok, st.want, st.ok)
+		}
 	}
 }
@@ -6,50 +6,167 @@ package spirv
 import (
 	"fmt"
 	"io"
+	"math/bits"
 	"reflect"
+	"unsafe"
 )
 
+// DecoderOptions defines optional behaviour of a Decoder.
+type DecoderOptions struct {
+	// KeepRaw makes the decoder yield a *RawInstruction for instructions
+	// it does not understand, rather than failing on unknown opcodes.
+	// Known instructions which would not encode to exactly the words
+	// they were decoded from are kept raw as well. This ensures a module
+	// which is loaded and saved again, is identical byte-for-byte.
+	KeepRaw bool
+
+	// MaxModuleSize limits the size of the module in bytes,
+	// including the header. Zero means no limit.
+	MaxModuleSize int
+
+	// MaxInstructions limits the number of instructions in the module.
+	// Zero means no limit.
+	MaxInstructions int
+
+	// MaxStringLength limits the length of string operands in bytes,
+	// excluding the nul terminator. Zero means no limit.
+	MaxStringLength int
+}
+
 // Decoder defines a decoder for the SPIR-V format.
-// It reads binary data from a stream and yields sequences
+// It reads binary data from a stream or memory and yields sequences
 // of 32-bit words.
 type Decoder struct {
-	r      io.Reader
-	ubuf   []uint32 // Scratch buffer for instruction decoding.
-	bbuf   [4]byte  // Scratch buffer for the word reader.
-	endian Endian
+	Options DecoderOptions
+
+	r       io.Reader
+	words   []uint32 // In-memory source, used if r is nil.
+	pos     int      // Read position, in words.
+	opword  uint32   // First word of the current instruction, if read.
+	count   int      // Number of instructions read.
+	partial bool     // In-memory source ends in a partial word.
+	ubuf    []uint32 // Scratch buffer for instruction decoding.
+	ebuf    []uint32 // Scratch buffer for lossless decoding checks.
+	bbuf    [4]byte  // Scratch buffer for the word reader.
+	endian  Endian
+	version uint32 // Module version; selects the instruction set.
 }
 
 // NewDecoder creates a new decoder for the given stream and instruction set.
 func NewDecoder(r io.Reader) *Decoder {
 	return &Decoder{
-		r:      r,
-		endian: LittleEndian,
-		ubuf:   make([]uint32, 16),
+		r:       r,
+		endian:  LittleEndian,
+		ubuf:    make([]uint32, 16),
+		version: SpecificationVersion,
+	}
+}
+
+// NewDecoderWords creates a new decoder for a module held in memory.
+//
+// The slices yielded by DecodeInstructionWords refer to words directly,
+// so scanning a module this way copies no data. The words must therefore
+// not be modified while they are in use.
+//
+// If words starts with a byte-swapped magic value, all words are swapped
+// into a copy before use. The byte order of the original data is then
+// reported through the header's magic value.
+func NewDecoderWords(words []uint32) *Decoder {
+	d := &Decoder{
+		words:   words,
+		endian:  LittleEndian,
+		ubuf:    make([]uint32, 16),
+		version: SpecificationVersion,
+	}
+
+	if len(words) > 0 && words[0] == MagicBE {
+		d.words = make([]uint32, len(words))
+		for i, word := range words {
+			d.words[i] = bits.ReverseBytes32(word)
+		}
+
+		d.endian = BigEndian
+	}
+
+	return d
+}
+
+// NewDecoderBytes creates a new decoder for a module held in memory, for
+// example one embedded with go:embed.
+//
+// The byte order is determined by the magic value at the start of data,
+// and defaults to little endian if there is none. If data is suitably
+// aligned and its byte order matches that of the host, it is used in place.
+// Otherwise it is converted to words once. Either way, scanning the module
+// with DecodeInstructionWords copies no data. The data must not be modified
+// while it is in use.
+func NewDecoderBytes(data []byte) *Decoder {
+	endian := LittleEndian
+	if len(data) >= 4 && data[0] == 0x07 && data[1] == 0x23 &&
+		data[2] == 0x02 && data[3] == 0x03 {
+		endian = BigEndian
+	}
+
+	d := NewDecoderWords(bytesToWords(data, endian))
+	d.endian = endian
+	d.partial = len(data)%4 != 0
+	return d
+}
+
+// hostEndian is the byte order of the machine we are running on.
+var hostEndian = func() Endian {
+	v := uint16(1)
+	if *(*byte)(unsafe.Pointer(&v)) == 1 {
+		return LittleEndian
+	}
+	return BigEndian
+}()
+
+// bytesToWords returns data as a slice of words in host order.
+// Trailing bytes which do not make up a whole word are dropped.
+func bytesToWords(data []byte, endian Endian) []uint32 {
+	n := len(data) / 4
+	if n == 0 {
+		return nil
+	}
+
+	// Use the data in place if we can.
+	if endian == hostEndian && uintptr(unsafe.Pointer(&data[0]))%4 == 0 {
+		return unsafe.Slice((*uint32)(unsafe.Pointer(&data[0])), n)
+	}
+
+	words := make([]uint32, n)
+	for i := range words {
+		b := data[i*4 : i*4+4]
+		if endian == LittleEndian {
+			words[i] = uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 |
+				uint32(b[3])<<24
+		} else {
+			words[i] = uint32(b[3]) | uint32(b[2])<<8 | uint32(b[1])<<16 |
+				uint32(b[0])<<24
+		}
 	}
+
+	return words
 }
 
 // DecodeHeader reads a module header from the underlying stream.
 //
 // The magic value's byte order will be used to determine the byte order
-// for the entire stream.
+// for the entire stream. The version number selects the instruction set
+// used by subsequent calls to DecodeInstruction. Streams decoded without
+// a header are assumed to target SpecificationVersion.
 //
 // Returns an error if the magic value is invalid.
 func (d *Decoder) DecodeHeader() (Header, error) {
 	var hdr Header
+	var err error
 
-	// Read the magic value. This is the one value we read as separate bytes.
-	// The order will tell us the byte order of the rest of the stream.
-	_, err := io.ReadFull(d.r, d.bbuf[:])
+	hdr.Magic, err = d.readMagic()
 	if err != nil {
-		if err == io.EOF {
-			return hdr, ErrUnexpectedEOF
-		}
 		return hdr, err
 	}
 
-	hdr.Magic = uint32(d.bbuf[0]) | uint32(d.bbuf[1])<<8 |
-		uint32(d.bbuf[2])<<16 | uint32(d.bbuf[3])<<24
-
 	// Make sure it's a valid number. The order of the magic bytes lets us
 	// determine the endianness of the stream's remaining data.
 	switch hdr.Magic {
@@ -61,6 +178,10 @@ func (d *Decoder) DecodeHeader() (Header, error) {
 		return hdr, ErrInvalidMagicValue
 	}
 
+	if max := d.Options.MaxModuleSize; max > 0 && max < 5*4 {
+		return hdr, &LimitError{Limit: "MaxModuleSize", Max: max}
+	}
+
 	// Read remaining header.
 	err = d.read(d.ubuf[:4])
 	if err != nil {
@@ -71,15 +192,83 @@ func (d *Decoder) DecodeHeader() (Header, error) {
 	hdr.GeneratorMagic = d.ubuf[1]
 	hdr.Bound = d.ubuf[2]
 	hdr.Reserved = d.ubuf[3]
+
+	d.version = hdr.Version
 	return hdr, nil
 }
 
+// decodeError wraps err, which occurred while decoding the instruction
+// with the given index, starting at the given word offset.
+func (d *Decoder) decodeError(index, offset int, err error) *DecodeError {
+	de := &DecodeError{
+		Offset: offset,
+		Index:  index,
+		Err:    err,
+	}
+
+	if d.opword != 0 {
+		de.Opcode = d.opword & 0xffff
+
+		if fun, ok := instructionSetFor(d.version).Lookup(de.Opcode); ok {
+			de.Name = instructionName(fun())
+		}
+	}
+
+	return de
+}
+
+// readMagic reads the magic value. For streams, this is the one value we
+// read as separate bytes. The order will tell us the byte order of the rest
+// of the stream.
+func (d *Decoder) readMagic() (uint32, error) {
+	if d.r == nil {
+		// In-memory words are in host order already, so their byte
+		// order was determined when the decoder was created.
+		if d.pos >= len(d.words) {
+			return 0, ErrUnexpectedEOF
+		}
+
+		word := d.words[d.pos]
+		d.pos++
+
+		if word != MagicLE {
+			return word, nil // Invalid, but let the caller decide.
+		}
+
+		if d.endian == BigEndian {
+			return MagicBE, nil
+		}
+
+		return MagicLE, nil
+	}
+
+	_, err := io.ReadFull(d.r, d.bbuf[:])
+	if err != nil {
+		if err == io.EOF {
+			return 0, ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+
+	d.pos++
+	return uint32(d.bbuf[0]) | uint32(d.bbuf[1])<<8 |
+		uint32(d.bbuf[2])<<16 | uint32(d.bbuf[3])<<24, nil
+}
+
 // DecodeInstructionWords decodes the next instruction from the underlying
 // stream. The returned slice of words contains all data for the entire
 // instruction.
 //
-// The data remains valid until the next call to any of the decoder methods.
+// For stream decoders, the data remains valid until the next call to any
+// of the decoder methods. In-memory decoders return a sub-slice of their
+// input instead, which remains valid for as long as the input does.
 func (d *Decoder) DecodeInstructionWords() ([]uint32, error) {
+	d.opword = 0
+
+	if d.r == nil {
+		return d.nextWords()
+	}
+
 	// Read the first word: word count + opcode.
 	err := d.read(d.ubuf[:1])
 	if err != nil {
@@ -89,11 +278,18 @@ func (d *Decoder) DecodeInstructionWords() ([]uint32, error) {
 		return nil, err
 	}
 
+	d.opword = d.ubuf[0]
+
 	words := int(d.ubuf[0] >> 16)
 	if words < 1 {
 		return nil, ErrInvalidInstructionSize
 	}
 
+	err = d.checkLimits(d.pos - 1 + words)
+	if err != nil {
+		return nil, err
+	}
+
 	if words > 1 {
 		// Resize read buffer if necessary.
 		if words >= len(d.ubuf) {
@@ -126,16 +322,143 @@ func (d *Decoder) DecodeInstruction() (Instruction, error) {
 		return nil, err
 	}
 
-	return DecodeInstruction(words)
+	var instr Instruction
+
+	set := instructionSetFor(d.version)
+	if d.Options.KeepRaw {
+		instr, err = d.decodeRaw(set, words)
+	} else {
+		instr, err = decodeInstruction(set, words)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Strings can not be longer than the instruction holding them,
+	// so we only need to look at larger instructions.
+	if max := d.Options.MaxStringLength; max > 0 && (len(words)-1)*4 > max {
+		err = checkStringLen(reflect.ValueOf(instr), max)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return instr, nil
+}
+
+// checkLimits ensures the instruction which is about to be read does not
+// exceed the limits set in the decoder options. The instruction ends at
+// the given word offset.
+func (d *Decoder) checkLimits(end int) error {
+	if max := d.Options.MaxInstructions; max > 0 && d.count >= max {
+		return &LimitError{Limit: "MaxInstructions", Max: max}
+	}
+
+	if max := d.Options.MaxModuleSize; max > 0 && end*4 > max {
+		return &LimitError{Limit: "MaxModuleSize", Max: max}
+	}
+
+	d.count++
+	return nil
+}
+
+// checkStringLen returns an error if rv holds a string which is longer
+// than max bytes.
+func checkStringLen(rv reflect.Value, max int) error {
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil
+		}
+		return checkStringLen(rv.Elem(), max)
+
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			err := checkStringLen(rv.Field(i), max)
+			if err != nil {
+				return err
+			}
+		}
+
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint32 {
+			return nil // Nothing to see here.
+		}
+
+		for i := 0; i < rv.Len(); i++ {
+			err := checkStringLen(rv.Index(i), max)
+			if err != nil {
+				return err
+			}
+		}
+
+	case reflect.String:
+		if rv.Len() > max {
+			return &LimitError{Limit: "MaxStringLength", Max: max}
+		}
+	}
+
+	return nil
+}
+
+// decodeRaw decodes an instruction from the given set of words. It yields
+// a RawInstruction if the opcode is unknown, or if the decoded instruction
+// does not encode to the same words again.
+func (d *Decoder) decodeRaw(set *instructionSet, words []uint32) (Instruction, error) {
+	if _, ok := set.Lookup(words[0] & 0xffff); !ok {
+		return NewRawInstruction(words), nil
+	}
+
+	instr, err := decodeInstruction(set, words)
+	if err != nil {
+		return nil, err
+	}
+
+	if !d.lossless(instr, words) {
+		return NewRawInstruction(words), nil
+	}
+
+	return instr, nil
+}
+
+// lossless returns true if instr encodes to exactly the given words.
+func (d *Decoder) lossless(instr Instruction, words []uint32) bool {
+	size := EncodedLen(instr)
+	if size != len(words) {
+		return false
+	}
+
+	if size > len(d.ebuf) {
+		d.ebuf = make([]uint32, size)
+	}
+
+	argc, err := encodeOperands(instr, d.ebuf[1:size])
+	if err != nil || argc+1 != size {
+		return false
+	}
+
+	for i := 1; i < size; i++ {
+		if d.ebuf[i] != words[i] {
+			return false
+		}
+	}
+
+	return true
 }
 
-// DecodeInstructionFromWords decodes an instruction from the given
-// set of words. Additionally, it verifies that the values for each
-// instruction field meet the requirements as defined in the specification.
+// DecodeInstruction decodes an instruction from the given set of words,
+// using the instruction set of the released specification.
 //
 // Returns an error if there is no matching instruction or the
 // decoding failed.
 func DecodeInstruction(words []uint32) (Instruction, error) {
+	return decodeInstruction(instructions, words)
+}
+
+// decodeInstruction decodes an instruction from the given set of words,
+// using the given instruction set.
+func decodeInstruction(set *instructionSet, words []uint32) (Instruction, error) {
 	wordCount := words[0] >> 16
 	opcode := words[0] & 0xffff
 
@@ -147,30 +470,70 @@ func DecodeInstruction(words []uint32) (Instruction, error) {
 		return nil, ErrInvalidInstructionSize
 	}
 
-	constructor, ok := instructions[opcode]
+	constructor, ok := set.Lookup(opcode)
 	if !ok {
-		return nil, fmt.Errorf("unknown instruction: %08x", opcode)
+		return nil, fmt.Errorf("%w: %08x", ErrUnknownInstruction, opcode)
 	}
 
-	instr := constructor()
-
-	// This instruction is illegal.
+	// OpNop is illegal in the pre-release specification.
 	// FIXME Remove this once instruction validation code is in.
-	switch instr.(type) {
-	case *OpNop:
+	if opcode == opcodeNop && set == preReleaseInstructions {
 		return nil, ErrUnacceptable
 	}
 
-	rv := reflect.ValueOf(instr)
-	_, err := decodeValue(rv, words[1:])
+	instr := constructor()
+	err := decodeOperands(instr, words[1:wordCount])
 
 	return instr, err
 }
 
+// nextWords returns the next instruction from the in-memory source,
+// without copying it.
+func (d *Decoder) nextWords() ([]uint32, error) {
+	if d.pos >= len(d.words) {
+		if d.partial {
+			return nil, ErrUnexpectedEOF
+		}
+		return nil, io.EOF
+	}
+
+	d.opword = d.words[d.pos]
+
+	words := int(d.opword >> 16)
+	if words < 1 {
+		return nil, ErrInvalidInstructionSize
+	}
+
+	err := d.checkLimits(d.pos + words)
+	if err != nil {
+		return nil, err
+	}
+
+	end := d.pos + words
+	if end > len(d.words) {
+		d.pos = len(d.words)
+		return nil, ErrUnexpectedEOF
+	}
+
+	out := d.words[d.pos:end:end]
+	d.pos = end
+	return out, nil
+}
+
 // Next reads exactly len(p) words from the stream.
 // Returns an error if there is either not enough data or something else
 // went wrong.
 func (d *Decoder) read(p []uint32) error {
+	if d.r == nil {
+		if len(d.words)-d.pos < len(p) {
+			d.pos = len(d.words)
+			return ErrUnexpectedEOF
+		}
+
+		d.pos += copy(p, d.words[d.pos:])
+		return nil
+	}
+
 	for i := range p {
 		_, err := io.ReadFull(d.r, d.bbuf[:])
 		if err != nil {
@@ -189,6 +552,8 @@ func (d *Decoder) read(p []uint32) error {
 			p[i] = uint32(d.bbuf[3]) | uint32(d.bbuf[2])<<8 | uint32(d.bbuf[1])<<16 |
 				uint32(d.bbuf[0])<<24
 		}
+
+		d.pos++
 	}
 
 	return nil
@@ -196,7 +561,15 @@ func (d *Decoder) read(p []uint32) error {
 
 // decodeValue decodes the given words into the specified instruction.
 func decodeValue(rv reflect.Value, argv []uint32) ([]uint32, error) {
-	rv = reflect.Indirect(rv)
+	// Pointer fields hold optional operands. They are only allocated
+	// once we know the operand is present.
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+
+		rv = rv.Elem()
+	}
 
 	switch rv.Kind() {
 	case reflect.Struct:
@@ -244,13 +617,10 @@ func decodeSlice(rv reflect.Value, argv []uint32) ([]uint32, error) {
 	}
 
 	// Otherwise we have to do some manualy copying magic.
-	new := reflect.MakeSlice(rt, 0, len(argv))
+	new := reflect.MakeSlice(rt, len(argv), len(argv))
 
-	for _, word := range argv {
-		elem := reflect.New(et)
-		elem = reflect.Indirect(elem)
-		elem.SetUint(uint64(word))
-		new = reflect.Append(new, elem)
+	for i, word := range argv {
+		new.Index(i).SetUint(uint64(word))
 	}
 
 	rv.Set(new)
@@ -282,8 +652,7 @@ func decodeStruct(rv reflect.Value, argv []uint32) ([]uint32, error) {
 
 // decodeString decodes input data into a string value.
 func decodeString(rv reflect.Value, argv []uint32) ([]uint32, error) {
-	str := DecodeString(argv)
-	size := str.EncodedLen()
+	str, argv := decodeStringOperand(argv)
 	rv.SetString(string(str))
-	return argv[size:], nil
+	return argv, nil
 }
@@ -5,6 +5,8 @@ package spirv
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"io"
 	"reflect"
 	"testing"
@@ -50,20 +52,25 @@ func TestDecodeWords(t *testing.T) {
 			},
 		},
 	} {
-		dec := NewDecoder(bytes.NewBuffer(st.in))
-		have, err := dec.DecodeInstructionWords()
+		// Stream and in-memory decoders must behave the same.
+		for _, dec := range []*Decoder{
+			NewDecoder(bytes.NewBuffer(st.in)),
+			NewDecoderBytes(st.in),
+		} {
+			have, err := dec.DecodeInstructionWords()
 
-		if err != nil {
-			if !reflect.DeepEqual(err, st.err) {
-				t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v",
-					i, err, st.err)
+			if err != nil {
+				if !reflect.DeepEqual(err, st.err) {
+					t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v",
+						i, err, st.err)
+				}
+				continue // Expected error -- Just move on.
 			}
-			continue // Expected error -- Just move on.
-		}
 
-		if !reflect.DeepEqual(have, st.want) {
-			t.Fatalf("case %d: value mismatch:\nHave: %v\nWant: %v",
-				i, have, st.want)
+			if !reflect.DeepEqual(have, st.want) {
+				t.Fatalf("case %d: value mismatch:\nHave: %v\nWant: %v",
+					i, have, st.want)
+			}
 		}
 	}
 }
@@ -109,20 +116,302 @@ func TestDecodeHeader(t *testing.T) {
 			want: Header{MagicBE, 99, 1, 255, 0},
 		},
 	} {
-		dec := NewDecoder(bytes.NewBuffer(st.in))
-		have, err := dec.DecodeHeader()
+		// Stream and in-memory decoders must behave the same.
+		for _, dec := range []*Decoder{
+			NewDecoder(bytes.NewBuffer(st.in)),
+			NewDecoderBytes(st.in),
+		} {
+			have, err := dec.DecodeHeader()
+
+			if err != nil {
+				if !reflect.DeepEqual(err, st.err) {
+					t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v",
+						i, err, st.err)
+				}
+				continue // Expected error -- Just move on.
+			}
+
+			if !reflect.DeepEqual(have, st.want) {
+				t.Fatalf("case %d: value mismatch:\nHave: %v\nWant: %v",
+					i, have, st.want)
+			}
+		}
+	}
+}
+
+func TestDecoderWords(t *testing.T) {
+	words := []uint32{
+		MagicLE, Version13, 0, 10, 0,
+		0x00020011, 1, // OpCapability Shader
+		0x00010100, // OpNop
+	}
+
+	dec := NewDecoderWords(words)
 
+	_, err := dec.DecodeHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have, err := dec.DecodeInstructionWords()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The instruction must refer to the input, rather than a copy.
+	if &have[0] != &words[5] || cap(have) != 2 {
+		t.Fatalf("expected a sub-slice of the input")
+	}
+
+	have, err = dec.DecodeInstructionWords()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if &have[0] != &words[7] || len(have) != 1 {
+		t.Fatalf("expected a sub-slice of the input")
+	}
+
+	_, err = dec.DecodeInstructionWords()
+	if err != io.EOF {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", err, io.EOF)
+	}
+}
+
+func TestDecoderWordsSwapped(t *testing.T) {
+	words := []uint32{
+		MagicBE, 0x00000100, 0, 0x0a000000, 0,
+		0x11000200, 0x01000000, // OpCapability Shader
+	}
+
+	mod, err := LoadWords(words)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Module{
+		Header: Header{MagicBE, Version10, 0, 10, 0},
+		Code: InstructionList{
+			&OpCapability{Capability: CapabilityShader},
+		},
+	}
+
+	if !reflect.DeepEqual(mod, want) {
+		t.Fatalf("decode mismatch:\nHave: %+v\nWant: %+v", mod, want)
+	}
+}
+
+func TestLoadBytes(t *testing.T) {
+	mod := benchmarkModule(1 << 10)
+
+	for _, magic := range []uint32{MagicLE, MagicBE} {
+		mod.Header.Magic = magic
+
+		var buf bytes.Buffer
+		err := mod.Save(&buf)
 		if err != nil {
-			if !reflect.DeepEqual(err, st.err) {
-				t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v",
-					i, err, st.err)
+			t.Fatal(err)
+		}
+
+		// Decode from both aligned and unaligned memory.
+		data := make([]byte, buf.Len()+1)
+		for _, in := range [][]byte{data[:buf.Len()], data[1:]} {
+			copy(in, buf.Bytes())
+
+			have, err := LoadBytes(in)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Decoded instructions must not refer to the input.
+			for i := range in {
+				in[i] = 0
+			}
+
+			if !reflect.DeepEqual(have, mod) {
+				t.Fatalf("magic %08x: decode mismatch", magic)
 			}
-			continue // Expected error -- Just move on.
 		}
+	}
+}
+
+func TestLoadWordsNop(t *testing.T) {
+	// OpNop is valid in released versions only.
+	words := []uint32{
+		MagicLE, Version10, 0, 10, 0,
+		0x00020011, 1, // OpCapability Shader
+		0x00010000, // OpNop
+	}
+
+	mod, err := LoadWords(words)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := InstructionList{&OpCapability{Capability: CapabilityShader}, &OpNop{}}
+	if !reflect.DeepEqual(mod.Code, want) {
+		t.Fatalf("code mismatch:\nHave: %v\nWant: %v", mod.Code, want)
+	}
 
-		if !reflect.DeepEqual(have, st.want) {
-			t.Fatalf("case %d: value mismatch:\nHave: %v\nWant: %v",
-				i, have, st.want)
+	if err := mod.check((*Module).verifyInstructions); err != nil {
+		t.Fatal(err)
+	}
+
+	words = []uint32{MagicLE, VersionPreRelease, 0, 10, 0, 0x00010000}
+	if _, err := LoadWords(words); !errors.Is(err, ErrUnacceptable) {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrUnacceptable)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	header := []uint32{MagicLE, Version10, 0, 10, 0}
+
+	for i, st := range []struct {
+		in   []uint32
+		want *DecodeError
+		is   error
+	}{
+		{
+			in:   []uint32{MagicLE, Version10},
+			want: &DecodeError{Index: -1, Err: ErrUnexpectedEOF},
+			is:   ErrUnexpectedEOF,
+		},
+		{
+			in: append(header,
+				0x00020011, 1, // OpCapability Shader
+				0x00040015, 1, // OpTypeInt, truncated
+			),
+			want: &DecodeError{
+				Offset: 7,
+				Index:  1,
+				Opcode: opcodeTypeInt,
+				Name:   "OpTypeInt",
+				Err:    ErrUnexpectedEOF,
+			},
+			is: ErrUnexpectedEOF,
+		},
+		{
+			in: append(header,
+				0x00020011, 1, // OpCapability Shader
+				0x00020015, 1, // OpTypeInt, missing operands
+			),
+			want: &DecodeError{
+				Offset: 7,
+				Index:  1,
+				Opcode: opcodeTypeInt,
+				Name:   "OpTypeInt",
+				Err:    ErrMissingInstructionArgs,
+			},
+			is: ErrMissingInstructionArgs,
+		},
+		{
+			in: append(header,
+				0x0001ffff, // Unknown opcode.
+			),
+			want: &DecodeError{
+				Offset: 5,
+				Index:  0,
+				Opcode: 0xffff,
+				Err:    fmt.Errorf("%w: %08x", ErrUnknownInstruction, 0xffff),
+			},
+			is: ErrUnknownInstruction,
+		},
+		{
+			in: append(header,
+				0x00000011, // Zero word count.
+			),
+			want: &DecodeError{
+				Offset: 5,
+				Index:  0,
+				Opcode: opcodeCapability,
+				Name:   "OpCapability",
+				Err:    ErrInvalidInstructionSize,
+			},
+			is: ErrInvalidInstructionSize,
+		},
+	} {
+		for _, load := range []func() (*Module, error){
+			func() (*Module, error) { return Load(testWordReader(st.in)) },
+			func() (*Module, error) { return LoadWords(st.in) },
+		} {
+			_, err := load()
+
+			if !errors.Is(err, st.is) {
+				t.Fatalf("case %d: expected errors.Is(%v, %v)", i, err, st.is)
+			}
+
+			var have *DecodeError
+			if !errors.As(err, &have) {
+				t.Fatalf("case %d: expected a *DecodeError; have %T", i, err)
+			}
+
+			if !reflect.DeepEqual(have, st.want) {
+				t.Fatalf("case %d: error mismatch:\nHave: %+v\nWant: %+v",
+					i, have, st.want)
+			}
+		}
+	}
+}
+
+func TestDecoderLimits(t *testing.T) {
+	in := []uint32{
+		MagicLE, Version10, 0, 10, 0,
+		0x00020011, 1, // OpCapability Shader
+		0x00040005, 1, 0x6c6c6568, 0x0000006f, // OpName %1 "hello"
+	}
+
+	data := testWordReader(in).Bytes()
+
+	for i, st := range []struct {
+		opts DecoderOptions
+		err  error
+	}{
+		{
+			opts: DecoderOptions{},
+		},
+		{
+			opts: DecoderOptions{MaxModuleSize: 44, MaxInstructions: 2, MaxStringLength: 5},
+		},
+		{
+			opts: DecoderOptions{MaxModuleSize: 16},
+			err:  &LimitError{Limit: "MaxModuleSize", Max: 16},
+		},
+		{
+			opts: DecoderOptions{MaxModuleSize: 40},
+			err:  &LimitError{Limit: "MaxModuleSize", Max: 40},
+		},
+		{
+			opts: DecoderOptions{MaxInstructions: 1},
+			err:  &LimitError{Limit: "MaxInstructions", Max: 1},
+		},
+		{
+			opts: DecoderOptions{MaxStringLength: 4},
+			err:  &LimitError{Limit: "MaxStringLength", Max: 4},
+		},
+	} {
+		for _, load := range []func(DecoderOptions) (*Module, error){
+			func(opts DecoderOptions) (*Module, error) { return LoadWithOptions(testWordReader(in), opts) },
+			func(opts DecoderOptions) (*Module, error) { return LoadBytesWithOptions(data, opts) },
+			func(opts DecoderOptions) (*Module, error) { return LoadWordsWithOptions(in, opts) },
+		} {
+			_, err := load(st.opts)
+
+			if st.err == nil {
+				if err != nil {
+					t.Fatalf("case %d: %v", i, err)
+				}
+				continue
+			}
+
+			var have *LimitError
+			if !errors.As(err, &have) {
+				t.Fatalf("case %d: expected a *LimitError; have %v", i, err)
+			}
+
+			if !reflect.DeepEqual(have, st.err) {
+				t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v",
+					i, have, st.err)
+			}
 		}
 	}
 }
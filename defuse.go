@@ -0,0 +1,196 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// Use defines a single use of an Id as an operand.
+type Use struct {
+	Addr int // Index of the instruction in Module.Code.
+
+	// Field is the name of the struct field holding the Id. For the
+	// operands of OpExtInst, this is a field of the extended instruction.
+	Field string
+
+	// Index is the position of the Id among the Ids in Field. For
+	// fields holding a slice of Ids, this is the index in that slice.
+	Index int
+}
+
+// DefUse maps the Ids of a module to the instructions which define and
+// use them. Ids are found as described for Module.ForEachId.
+//
+// The index refers to instructions by their address. Passes which edit
+// the module keep it up to date with Update, Insert and Remove.
+type DefUse struct {
+	m    *Module
+	defs map[Id]int
+	uses map[Id][]Use
+	refs [][]Id // Ids defined or used by each instruction.
+	sets map[Id]ExtInstSet
+}
+
+// DefUse builds a def-use index for the module.
+func (m *Module) DefUse() *DefUse {
+	du := &DefUse{
+		m:    m,
+		defs: make(map[Id]int),
+		uses: make(map[Id][]Use),
+		refs: make([][]Id, len(m.Code)),
+		sets: m.extInstSets(),
+	}
+
+	literalWords := func(Id) int { return 1 }
+	if m.Code.Index(opcodeSwitch) > -1 {
+		literalWords = m.literalWords()
+	}
+
+	for addr := range m.Code {
+		du.index(addr, literalWords)
+	}
+
+	return du
+}
+
+// Def returns the address of the instruction defining the given Id.
+func (du *DefUse) Def(id Id) (int, bool) {
+	addr, ok := du.defs[id]
+	return addr, ok
+}
+
+// Uses returns all uses of the given Id, in module order.
+// The returned slice must not be modified.
+func (du *DefUse) Uses(id Id) []Use {
+	return du.uses[id]
+}
+
+// Update re-indexes the instruction at addr, after it has been modified
+// or replaced.
+func (du *DefUse) Update(addr int) {
+	du.unindex(addr)
+	du.index(addr, du.literalWords(addr))
+}
+
+// Insert indexes the instruction at addr, after it has been inserted into
+// Module.Code. All instructions which follow it have moved up by one.
+func (du *DefUse) Insert(addr int) {
+	du.shift(addr, 1)
+
+	du.refs = append(du.refs, nil)
+	copy(du.refs[addr+1:], du.refs[addr:])
+	du.refs[addr] = nil
+
+	du.index(addr, du.literalWords(addr))
+}
+
+// Remove drops the instruction at addr from the index, after it has been
+// removed from Module.Code. All instructions which followed it have moved
+// down by one.
+func (du *DefUse) Remove(addr int) {
+	du.unindex(addr)
+
+	copy(du.refs[addr:], du.refs[addr+1:])
+	du.refs = du.refs[:len(du.refs)-1]
+
+	du.shift(addr+1, -1)
+}
+
+// index adds the Ids of the instruction at addr to the index.
+func (du *DefUse) index(addr int, literalWords func(Id) int) {
+	instr := du.m.Code[addr]
+
+	if imp, ok := instr.(*OpExtInstImport); ok {
+		if set, ok := LookupExtInstSet(string(imp.Name)); ok {
+			du.sets[imp.ResultId] = set
+		}
+	}
+
+	var refs []Id
+	var last string
+	var index int
+
+	forEachInstructionId(addr, instr, du.sets, literalWords, func(addr int, field string, id *Id) {
+		if field == "ResultId" {
+			if _, ok := du.defs[*id]; !ok {
+				du.defs[*id] = addr
+			}
+			refs = append(refs, *id)
+			return
+		}
+
+		if field != last {
+			last, index = field, 0
+		}
+
+		du.insertUse(*id, Use{Addr: addr, Field: field, Index: index})
+		refs = append(refs, *id)
+		index++
+	})
+
+	du.refs[addr] = refs
+}
+
+// insertUse adds u to the uses of id, keeping them in module order.
+func (du *DefUse) insertUse(id Id, u Use) {
+	uses := du.uses[id]
+
+	i := len(uses)
+	for i > 0 && uses[i-1].Addr > u.Addr {
+		i--
+	}
+
+	uses = append(uses, Use{})
+	copy(uses[i+1:], uses[i:])
+	uses[i] = u
+	du.uses[id] = uses
+}
+
+// unindex removes all references to the instruction at addr.
+func (du *DefUse) unindex(addr int) {
+	for _, id := range du.refs[addr] {
+		if def, ok := du.defs[id]; ok && def == addr {
+			delete(du.defs, id)
+		}
+
+		uses := du.uses[id][:0]
+		for _, u := range du.uses[id] {
+			if u.Addr != addr {
+				uses = append(uses, u)
+			}
+		}
+
+		if len(uses) == 0 {
+			delete(du.uses, id)
+		} else {
+			du.uses[id] = uses
+		}
+	}
+
+	du.refs[addr] = nil
+}
+
+// shift moves all references to instructions at or after addr by delta.
+func (du *DefUse) shift(addr, delta int) {
+	for id, def := range du.defs {
+		if def >= addr {
+			du.defs[id] = def + delta
+		}
+	}
+
+	for _, uses := range du.uses {
+		for i := range uses {
+			if uses[i].Addr >= addr {
+				uses[i].Addr += delta
+			}
+		}
+	}
+}
+
+// literalWords returns the literal width function needed to index the
+// instruction at addr.
+func (du *DefUse) literalWords(addr int) func(Id) int {
+	if _, ok := du.m.Code[addr].(*OpSwitch); ok {
+		return du.m.literalWords()
+	}
+
+	return func(Id) int { return 1 }
+}
@@ -0,0 +1,173 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"testing"
+)
+
+// defUseCode holds a function with a loop, an access chain and a phi.
+var defUseCode = InstructionList{
+	&OpTypeVoid{ResultId: 1},
+	&OpTypeFunction{ResultId: 2, ReturnType: 1},
+	&OpTypeInt{ResultId: 3, Width: 32},
+	&OpTypeBool{ResultId: 4},
+	&OpTypeVector{ResultId: 5, ComponentType: 3, ComponentCount: 4},
+	&OpTypePointer{ResultId: 6, StorageClass: StorageClassFunction, Type: 5},
+	&OpTypePointer{ResultId: 7, StorageClass: StorageClassFunction, Type: 3},
+	&OpConstant{ResultType: 3, ResultId: 8, Value: []uint32{0}},
+	&OpConstant{ResultType: 3, ResultId: 9, Value: []uint32{1}},
+	&OpFunction{ResultType: 1, ResultId: 10, FunctionType: 2},
+	&OpLabel{ResultId: 11},
+	&OpVariable{ResultType: 6, ResultId: 12, StorageClass: StorageClassFunction},
+	&OpBranch{TargetLabel: 13},
+	&OpLabel{ResultId: 13},
+	&OpPhi{ResultType: 3, ResultId: 14, Operands: []Id{8, 11, 15, 13}},
+	&OpIAdd{ResultType: 3, ResultId: 15, Operand1: 14, Operand2: 9},
+	&OpAccessChain{ResultType: 7, ResultId: 16, Base: 12, Indices: []Id{8}},
+	&OpStore{Pointer: 16, Object: 15},
+	&OpSLessThan{ResultType: 4, ResultId: 17, Operand1: 15, Operand2: 9},
+	&OpBranchConditional{Condition: 17, TrueLabel: 13, FalseLabel: 18},
+	&OpLabel{ResultId: 18},
+	&OpReturn{},
+	&OpFunctionEnd{},
+}
+
+func TestDefUse(t *testing.T) {
+	mod := NewModule()
+	mod.Code = defUseCode
+
+	du := mod.DefUse()
+
+	for _, st := range []struct {
+		id   Id
+		def  int
+		uses []Use
+	}{
+		{
+			id:  8,
+			def: 7,
+			uses: []Use{
+				{Addr: 14, Field: "Operands", Index: 0},
+				{Addr: 16, Field: "Indices", Index: 0},
+			},
+		},
+		{
+			id:  13,
+			def: 13,
+			uses: []Use{
+				{Addr: 12, Field: "TargetLabel"},
+				{Addr: 14, Field: "Operands", Index: 3},
+				{Addr: 19, Field: "TrueLabel"},
+			},
+		},
+		{
+			id:  15,
+			def: 15,
+			uses: []Use{
+				{Addr: 14, Field: "Operands", Index: 2},
+				{Addr: 17, Field: "Object"},
+				{Addr: 18, Field: "Operand1"},
+			},
+		},
+		{
+			id:  10,
+			def: 9,
+		},
+	} {
+		def, ok := du.Def(st.id)
+		if !ok || def != st.def {
+			t.Fatalf("%%%d: def mismatch:\nHave: %d\nWant: %d", st.id, def, st.def)
+		}
+
+		if have := du.Uses(st.id); !reflect.DeepEqual(have, st.uses) {
+			t.Fatalf("%%%d: uses mismatch:\nHave: %+v\nWant: %+v", st.id, have, st.uses)
+		}
+	}
+
+	if _, ok := du.Def(99); ok {
+		t.Fatalf("unexpected definition for %%99")
+	}
+}
+
+func TestDefUseSpecConstantOp(t *testing.T) {
+	// The literal index equals the live Id %3, but is not a use of it.
+	mod := NewModule()
+	mod.Code = InstructionList{
+		&OpTypeInt{ResultId: 1, Width: 32},
+		&OpTypeVector{ResultId: 2, ComponentType: 1, ComponentCount: 2},
+		&OpSpecConstant{ResultType: 1, ResultId: 3, Value: []uint32{7}},
+		&OpSpecConstantComposite{ResultType: 2, ResultId: 4, Constituents: []Id{3, 3}},
+		&OpSpecConstantOp{ResultType: 1, ResultId: 5, Operation: opcodeCompositeExtract, Operands: []Id{4, 3}},
+	}
+
+	du := mod.DefUse()
+
+	want := []Use{
+		{Addr: 3, Field: "Constituents", Index: 0},
+		{Addr: 3, Field: "Constituents", Index: 1},
+	}
+
+	if have := du.Uses(3); !reflect.DeepEqual(have, want) {
+		t.Fatalf("uses mismatch:\nHave: %+v\nWant: %+v", have, want)
+	}
+
+	want = []Use{{Addr: 4, Field: "Operands", Index: 0}}
+	if have := du.Uses(4); !reflect.DeepEqual(have, want) {
+		t.Fatalf("uses mismatch:\nHave: %+v\nWant: %+v", have, want)
+	}
+
+	// Updating the instruction leaves the index alone as well.
+	mod.Code[4].(*OpSpecConstantOp).Operands[1] = 4
+	du.Update(4)
+
+	if have := du.Uses(4); !reflect.DeepEqual(have, want) {
+		t.Fatalf("uses mismatch after update:\nHave: %+v\nWant: %+v", have, want)
+	}
+}
+
+func TestDefUseIncremental(t *testing.T) {
+	// The list is changed below, so it is copied from the fixture.
+	mod := NewModule()
+	mod.Code = append(InstructionList{}, defUseCode...)
+	du := mod.DefUse()
+
+	check := func(step string) {
+		want := mod.DefUse()
+
+		if !reflect.DeepEqual(du.defs, want.defs) {
+			t.Fatalf("%s: defs mismatch:\nHave: %v\nWant: %v", step, du.defs, want.defs)
+		}
+
+		if !reflect.DeepEqual(du.uses, want.uses) {
+			t.Fatalf("%s: uses mismatch:\nHave: %v\nWant: %v", step, du.uses, want.uses)
+		}
+	}
+
+	// Modify an instruction. It is copied first, as the instructions
+	// of the fixture are shared with TestDefUse.
+	add := *mod.Code[15].(*OpIAdd)
+	mod.Code[15] = &add
+	add.Operand2 = 8
+	du.Update(15)
+	check("update")
+
+	// Replace an instruction.
+	mod.Code[17] = &OpStore{Pointer: 12, Object: 14}
+	du.Update(17)
+	check("replace")
+
+	// Insert an instruction.
+	mod.Code = append(mod.Code, nil)
+	copy(mod.Code[16:], mod.Code[15:])
+	mod.Code[15] = &OpISub{ResultType: 3, ResultId: 19, Operand1: 14, Operand2: 9}
+	du.Insert(15)
+	check("insert")
+
+	// Remove an instruction.
+	mod.Code = append(mod.Code[:17], mod.Code[18:]...)
+	du.Remove(17)
+	check("remove")
+}
@@ -0,0 +1,157 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Severity defines how serious a diagnostic is.
+type Severity uint8
+
+// Known severities.
+const (
+	// SeverityError marks a violation of the specification.
+	// Modules with errors are invalid.
+	SeverityError Severity = iota
+
+	// SeverityWarning marks a construct which could not be fully
+	// checked, or which is likely to be a mistake.
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return fmt.Sprintf("Severity(%d)", s)
+}
+
+// Diagnostic describes a single problem found by Module.VerifyAll.
+type Diagnostic struct {
+	Severity Severity
+
+	// Index is the index of the offending instruction in Module.Code,
+	// or -1 if the problem is not tied to an instruction.
+	Index int
+
+	// Rule identifies the rule which was violated, by the section of
+	// the specification which defines it. For example "2.4" for the
+	// logical layout of a module.
+	Rule string
+
+	// Message describes the problem.
+	Message string
+
+	// Err is the error which Module.Verify returns for this problem.
+	Err error
+}
+
+func (d Diagnostic) String() string {
+	if d.Index < 0 {
+		return fmt.Sprintf("%s [%s]: %s", d.Severity, d.Rule, d.Message)
+	}
+	return fmt.Sprintf("%s [%s] at $%08x: %s", d.Severity, d.Rule, d.Index, d.Message)
+}
+
+// Specification sections defining the rules checked by Verify.
+const (
+	ruleHeader       = "2.3"    // Physical layout of a module.
+	ruleLayout       = "2.4"    // Logical layout of a module.
+	ruleStructured   = "2.11"   // Structured control flow.
+	ruleUniversal    = "2.16.1" // Universal validation rules.
+	ruleInstructions = "3"      // Binary form of instructions.
+)
+
+// verifier collects the diagnostics of the verification passes. Passes
+// stop at the first error, unless all diagnostics are requested.
+type verifier struct {
+	rule     string // Rule of the current pass.
+	all      bool
+	failures int
+	diags    []Diagnostic
+	seen     map[string]bool
+}
+
+// report records the given error. It returns true if the check should
+// continue looking for more problems.
+func (v *verifier) report(err error) bool {
+	return v.reportAt(-1, err)
+}
+
+// reportAt records the given error for the instruction at addr.
+// Layout errors carry their own address.
+func (v *verifier) reportAt(addr int, err error) bool {
+	v.add(SeverityError, addr, err)
+	return v.all
+}
+
+// warn records a warning. Warnings are only collected if all
+// diagnostics are requested.
+func (v *verifier) warn(addr int, err error) {
+	if v.all {
+		v.add(SeverityWarning, addr, err)
+	}
+}
+
+func (v *verifier) add(severity Severity, addr int, err error) {
+	d := Diagnostic{
+		Severity: severity,
+		Index:    addr,
+		Rule:     v.rule,
+		Message:  err.Error(),
+		Err:      err,
+	}
+
+	var le *LayoutError
+	if errors.As(err, &le) {
+		d.Index = le.Address
+		d.Message = le.Msg
+	}
+
+	// A pass fails on its errors, even if an earlier pass already
+	// reported them.
+	if severity == SeverityError {
+		v.failures++
+	}
+
+	// Passes which share the same analysis can report the same problem.
+	key := fmt.Sprintf("%s:%d:%d:%s", d.Rule, d.Severity, d.Index, d.Message)
+	if v.seen[key] {
+		return
+	}
+
+	if v.seen == nil {
+		v.seen = make(map[string]bool)
+	}
+
+	v.seen[key] = true
+	v.diags = append(v.diags, d)
+}
+
+// check runs a single verification pass and returns the first error it
+// reports. It is used where a pass is run on its own.
+func (m *Module) check(pass func(*Module, *verifier)) error {
+	var v verifier
+	pass(m, &v)
+
+	if len(v.diags) > 0 {
+		return v.diags[0].Err
+	}
+
+	return nil
+}
+
+// errorPass adapts a pass which can only find a single problem.
+func errorPass(fn func(*Module) error) func(*Module, *verifier) {
+	return func(m *Module, v *verifier) {
+		if err := fn(m); err != nil {
+			v.report(err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestModuleVerifyAll(t *testing.T) {
+	// A valid layout, but several semantic problems.
+	mod := NewModule()
+	mod.Header.Bound = 10
+	mod.Code = InstructionList{
+		&OpCapability{Capability: CapabilityShader},
+		&OpExtInstImport{ResultId: 1, Name: "Unknown.ext"},
+		&OpMemoryModel{AddressingModel: AddressingModelLogical, MemoryModel: MemoryModelGLSL450},
+		&OpEntryPoint{ExecutionModel: ExecutionModelGLCompute, EntryPoint: 5, Name: "main"},
+		&OpTypeVoid{ResultId: 2},
+		&OpTypeFunction{ResultId: 3, ReturnType: 2},
+		&OpTypeBool{ResultId: 4},
+		&OpTypeBool{ResultId: 4},
+		&OpFunction{ResultType: 2, ResultId: 5, FunctionType: 3},
+		&OpLabel{ResultId: 6},
+		&OpLogicalNot{ResultType: 4, ResultId: 7, Operand: 8},
+		&OpLogicalNot{ResultType: 4, ResultId: 8, Operand: 50},
+		&OpReturn{},
+		&OpFunctionEnd{},
+	}
+
+	have := make([]string, 0)
+	for _, d := range mod.VerifyAll() {
+		have = append(have, d.String())
+	}
+
+	want := []string{
+		"error [2.3] at $0000000b: OpLogicalNot: Operand(50) is out of range; Bound is 10",
+		"error [2.16.1] at $00000007: duplicate ResultId(4); previous definition at: $00000006",
+		"error [2.16.1] at $0000000a: OpLogicalNot: Operand(8) is used before it is defined at $0000000b",
+		"warning [2.16.1] at $00000001: extended instruction set \"Unknown.ext\" is not registered; its instructions are not checked",
+	}
+
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("diagnostics mismatch:\nHave: %q\nWant: %q", have, want)
+	}
+
+	// Verify stops at the first error.
+	err := mod.Verify()
+	if want := NewLayoutError(11, "OpLogicalNot: Operand(50) is out of range; Bound is 10"); !reflect.DeepEqual(err, want) {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", err, want)
+	}
+}
+
+func TestModuleVerifyAllLayout(t *testing.T) {
+	// Checks of the control flow are skipped if the layout is invalid.
+	mod := NewModule()
+	mod.Header.Bound = 10
+	mod.Code = InstructionList{
+		&OpCapability{Capability: CapabilityShader},
+		&OpExtInstImport{ResultId: 1, Name: "Unknown.ext"},
+		&OpMemoryModel{AddressingModel: AddressingModelLogical, MemoryModel: MemoryModelGLSL450},
+		&OpEntryPoint{ExecutionModel: ExecutionModelGLCompute, EntryPoint: 5, Name: "main"},
+		&OpTypeVoid{ResultId: 2},
+		&OpTypeFunction{ResultId: 3, ReturnType: 2},
+		&OpTypeBool{ResultId: 4},
+		&OpTypeBool{ResultId: 4},
+		&OpFunction{ResultType: 2, ResultId: 5, FunctionType: 3},
+		&OpLabel{ResultId: 6},
+		&OpLogicalNot{ResultType: 4, ResultId: 7, Operand: 8},
+		&OpLogicalNot{ResultType: 4, ResultId: 8, Operand: 50},
+		&OpReturn{},
+		&OpLabel{ResultId: 9},
+		&OpFunctionEnd{},
+	}
+
+	var rules []string
+	for _, d := range mod.VerifyAll() {
+		if d.Severity == SeverityError {
+			rules = append(rules, d.Rule)
+		}
+	}
+
+	if want := []string{"2.4", "2.3", "2.16.1"}; !reflect.DeepEqual(rules, want) {
+		t.Fatalf("rules mismatch:\nHave: %v\nWant: %v", rules, want)
+	}
+
+	// A valid module has no errors.
+	mod.Code = InstructionList{
+		&OpCapability{Capability: CapabilityShader},
+		&OpExtInstImport{ResultId: 1, Name: "Unknown.ext"},
+		&OpMemoryModel{AddressingModel: AddressingModelLogical, MemoryModel: MemoryModelGLSL450},
+		&OpEntryPoint{ExecutionModel: ExecutionModelGLCompute, EntryPoint: 5, Name: "main"},
+		&OpTypeVoid{ResultId: 2},
+		&OpTypeFunction{ResultId: 3, ReturnType: 2},
+		&OpTypeBool{ResultId: 4},
+		&OpConstantTrue{ResultType: 4, ResultId: 9},
+		&OpFunction{ResultType: 2, ResultId: 5, FunctionType: 3},
+		&OpLabel{ResultId: 6},
+		&OpLogicalNot{ResultType: 4, ResultId: 7, Operand: 9},
+		&OpLogicalNot{ResultType: 4, ResultId: 8, Operand: 7},
+		&OpReturn{},
+		&OpFunctionEnd{},
+	}
+
+	for _, d := range mod.VerifyAll() {
+		if d.Severity == SeverityError {
+			t.Fatalf("unexpected error: %v", d)
+		}
+	}
+
+	if err := mod.Verify(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVerifierDuplicates(t *testing.T) {
+	v := verifier{all: true}
+	err := NewLayoutError(3, "duplicate problem")
+
+	// A pass fails on an error reported before, but it is only
+	// recorded once per rule.
+	for _, rule := range []string{ruleUniversal, ruleUniversal, ruleLayout} {
+		failures := v.failures
+		v.rule = rule
+		v.report(err)
+
+		if v.failures == failures {
+			t.Fatalf("%s: error not counted as a failure", rule)
+		}
+	}
+
+	var have []string
+	for _, d := range v.diags {
+		have = append(have, d.String())
+	}
+
+	want := []string{
+		"error [2.16.1] at $00000003: duplicate problem",
+		"error [2.4] at $00000003: duplicate problem",
+	}
+
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("diagnostics mismatch:\nHave: %q\nWant: %q", have, want)
+	}
+}
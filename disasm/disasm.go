@@ -0,0 +1,447 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package disasm
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/andreas-jonsson/spirv"
+
+	// The extended instruction sets are needed to name their instructions.
+	_ "github.com/andreas-jonsson/spirv/glslstd450"
+	_ "github.com/andreas-jonsson/spirv/openclstd"
+)
+
+// Options defines how a module is disassembled.
+type Options struct {
+	// RawIds prints all Ids as numbers, rather than by their
+	// friendly names.
+	RawIds bool
+
+	// NoHeader omits the comments describing the module header.
+	NoHeader bool
+
+	// NoIndent omits the padding which aligns the opcodes of all
+	// instructions in a single column.
+	NoIndent bool
+}
+
+// indent is the column at which opcodes start, unless Options.NoIndent
+// is set. Result Ids are right-aligned before it.
+const indent = 15
+
+// Write writes the disassembly of the given module to w.
+func Write(w io.Writer, mod *spirv.Module) error {
+	return WriteWithOptions(w, mod, Options{})
+}
+
+// WriteWithOptions writes the disassembly of the given module to w,
+// using the given options.
+func WriteWithOptions(w io.Writer, mod *spirv.Module, opts Options) error {
+	d := newDisassembler(mod, opts)
+	bw := bufio.NewWriter(w)
+
+	if !opts.NoHeader {
+		d.writeHeader(bw, &mod.Header)
+	}
+
+	for _, instr := range mod.Code {
+		d.writeInstruction(bw, instr)
+	}
+
+	return bw.Flush()
+}
+
+// String returns the disassembly of the given module.
+func String(mod *spirv.Module) string {
+	var buf bytes.Buffer
+	WriteWithOptions(&buf, mod, Options{})
+	return buf.String()
+}
+
+// disassembler holds the state needed to format the instructions of
+// a single module.
+type disassembler struct {
+	opts  Options
+	names map[spirv.Id]string
+	types *spirv.TypeTable
+	sets  map[spirv.Id]spirv.ExtInstSet
+}
+
+func newDisassembler(mod *spirv.Module, opts Options) *disassembler {
+	d := &disassembler{
+		opts:  opts,
+		types: mod.Types(),
+		sets:  make(map[spirv.Id]spirv.ExtInstSet),
+	}
+
+	if !opts.RawIds {
+		d.names = friendlyNames(mod.Code, d.types)
+	}
+
+	for _, instr := range mod.Code {
+		if v, ok := instr.(*spirv.OpExtInstImport); ok {
+			if set, ok := spirv.LookupExtInstSet(string(v.Name)); ok {
+				d.sets[v.ResultId] = set
+			}
+		}
+	}
+
+	return d
+}
+
+// writeHeader writes the module header as a series of comments.
+func (d *disassembler) writeHeader(w io.Writer, hdr *spirv.Header) {
+	fmt.Fprintln(w, "; SPIR-V")
+
+	if hdr.Version == spirv.VersionPreRelease {
+		fmt.Fprintln(w, "; Version: pre-release")
+	} else {
+		fmt.Fprintf(w, "; Version: %d.%d\n", hdr.VersionMajor(), hdr.VersionMinor())
+	}
+
+	fmt.Fprintf(w, "; Generator: %s; %d\n", generatorName(hdr.GeneratorMagic>>16), hdr.GeneratorMagic&0xffff)
+	fmt.Fprintf(w, "; Bound: %d\n", hdr.Bound)
+	fmt.Fprintf(w, "; Schema: %d\n", hdr.Reserved)
+}
+
+// writeInstruction writes a single instruction on its own line.
+func (d *disassembler) writeInstruction(w io.Writer, instr spirv.Instruction) {
+	if raw, ok := instr.(*spirv.RawInstruction); ok {
+		instr, ok = decodeRaw(raw)
+		if !ok {
+			fmt.Fprintf(w, "; Raw instruction %d: %v\n", raw.Code, raw.Argv)
+			return
+		}
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(instr))
+
+	if field := rv.FieldByName("ResultId"); field.IsValid() {
+		name := "%" + d.name(spirv.Id(field.Uint()))
+		if d.opts.NoIndent {
+			fmt.Fprintf(w, "%s = ", name)
+		} else {
+			fmt.Fprintf(w, "%*s = ", indent-3, name)
+		}
+	} else if !d.opts.NoIndent {
+		io.WriteString(w, strings.Repeat(" ", indent))
+	}
+
+	io.WriteString(w, rv.Type().Name())
+
+	for _, operand := range d.operands(instr) {
+		io.WriteString(w, " ")
+		io.WriteString(w, operand)
+	}
+
+	io.WriteString(w, "\n")
+}
+
+// decodeRaw tries to decode a raw instruction into its typed form. Raw
+// instructions are kept by the decoder if they would not encode to the
+// same words, but their meaning is still the same.
+func decodeRaw(raw *spirv.RawInstruction) (spirv.Instruction, bool) {
+	fun, ok := spirv.Lookup(raw.Code)
+	if !ok {
+		return nil, false
+	}
+
+	instr := fun()
+	codec, ok := instr.(spirv.OperandCodec)
+	if !ok || codec.DecodeOperands(raw.Argv) != nil {
+		return nil, false
+	}
+
+	return instr, true
+}
+
+// operands returns the formatted operands of the given instruction,
+// excluding its result Id.
+func (d *disassembler) operands(instr spirv.Instruction) []string {
+	rv := reflect.Indirect(reflect.ValueOf(instr))
+	rt := rv.Type()
+
+	var out []string
+
+	for i := 0; i < rv.NumField(); i++ {
+		ft := rt.Field(i)
+		fv := rv.Field(i)
+
+		if ft.Name == "ResultId" {
+			continue
+		}
+
+		// Absent optional operands are left at their zero value.
+		if ft.Tag.Get("spirv") == "optional" && fv.IsZero() {
+			continue
+		}
+
+		if ops, ok := d.special(instr, ft.Name); ok {
+			out = append(out, ops...)
+			continue
+		}
+
+		out = append(out, d.value(fv)...)
+	}
+
+	return out
+}
+
+// value formats the operands held by a single field. The type of the
+// field determines how they are formatted.
+func (d *disassembler) value(fv reflect.Value) []string {
+	switch fv.Kind() {
+	case reflect.Ptr:
+		return d.value(fv.Elem())
+
+	case reflect.Slice:
+		var out []string
+		for i := 0; i < fv.Len(); i++ {
+			out = append(out, d.value(fv.Index(i))...)
+		}
+		return out
+
+	case reflect.String:
+		return []string{quote(fv.String())}
+	}
+
+	// Instructions from the pre-release instruction set use their own
+	// Id type, so match it by name.
+	if fv.Type().Name() == "Id" {
+		return []string{d.id(spirv.Id(fv.Uint()))}
+	}
+
+	if e, ok := fv.Interface().(spirv.Enum); ok {
+		return []string{enumString(e, uint32(fv.Uint()))}
+	}
+
+	return []string{fmt.Sprint(fv.Uint())}
+}
+
+// special formats the operands of fields whose meaning depends on the
+// rest of the instruction. Returns false for all other fields.
+func (d *disassembler) special(instr spirv.Instruction, field string) ([]string, bool) {
+	switch v := instr.(type) {
+	case *spirv.OpConstant:
+		if field == "Value" {
+			return []string{literal(d.types, v.ResultType, v.Value)}, true
+		}
+
+	case *spirv.OpSpecConstant:
+		if field == "Value" {
+			return []string{literal(d.types, v.ResultType, v.Value)}, true
+		}
+
+	case *spirv.OpSwitch:
+		if field == "Target" {
+			return d.switchTargets(v), true
+		}
+
+	case *spirv.OpLoad:
+		if field == "Argv" {
+			return d.memoryAccess(v.MemoryAccess, v.Argv), true
+		}
+
+	case *spirv.OpStore:
+		if field == "Argv" {
+			return d.memoryAccess(v.MemoryAccess, v.Argv), true
+		}
+
+	case *spirv.OpCopyMemory:
+		if field == "Argv" {
+			return d.memoryAccess(v.MemoryAccess, v.Argv), true
+		}
+
+	case *spirv.OpCopyMemorySized:
+		if field == "Argv" {
+			return d.memoryAccess(v.MemoryAccess, v.Argv), true
+		}
+
+	case *spirv.OpDecorate:
+		if field == "Argv" {
+			return decoration(v.Decoration, v.Argv), true
+		}
+
+	case *spirv.OpMemberDecorate:
+		if field == "Argv" {
+			return decoration(v.Decoration, v.Argv), true
+		}
+
+	case *spirv.OpGroupMemberDecorate:
+		if field == "Targets" {
+			var out []string
+			for i, word := range v.Targets {
+				if i%2 == 0 {
+					out = append(out, d.id(spirv.Id(word)))
+				} else {
+					out = append(out, fmt.Sprint(word))
+				}
+			}
+			return out, true
+		}
+
+	case *spirv.OpExtInst:
+		if field == "Instruction" {
+			return []string{d.extInstName(v.Set, v.Instruction)}, true
+		}
+
+	case *spirv.OpSpecConstantOp:
+		if field == "Operation" {
+			return []string{opcodeName(v.Operation)}, true
+		}
+	}
+
+	return nil, false
+}
+
+// id returns the formatted reference to the given Id.
+func (d *disassembler) id(id spirv.Id) string {
+	return "%" + d.name(id)
+}
+
+// name returns the friendly name of the given Id. Ids without one are
+// named by their number.
+func (d *disassembler) name(id spirv.Id) string {
+	if name, ok := d.names[id]; ok {
+		return name
+	}
+	return fmt.Sprint(uint32(id))
+}
+
+// enumString returns the symbolic name of an enum value. Invalid values
+// are printed as numbers.
+func enumString(e spirv.Enum, v uint32) string {
+	if e.Verify() != nil {
+		return fmt.Sprint(v)
+	}
+	return e.String()
+}
+
+// switchTargets formats the (literal, label) pairs of an OpSwitch. The
+// literals take the width of the selector type.
+func (d *disassembler) switchTargets(v *spirv.OpSwitch) []string {
+	typ, _ := d.types.ResultType(v.Selector)
+
+	n := 1
+	if t, ok := d.types.Type(typ); ok && t.Width > 32 {
+		n = 2
+	}
+
+	var out []string
+	for i := 0; i+n < len(v.Target); i += n + 1 {
+		out = append(out, literal(d.types, typ, v.Target[i:i+n]), d.id(spirv.Id(v.Target[i+n])))
+	}
+
+	return out
+}
+
+// memoryAccess formats the extra operands of a memory access mask. For
+// the copy instructions, these can be followed by a second mask, which
+// applies to the source.
+func (d *disassembler) memoryAccess(mask *spirv.MemoryAccess, argv []uint32) []string {
+	var out []string
+
+	for mask != nil {
+		n := spirv.ForEachMemoryAccessOperand(*mask, func(i int, _ spirv.MemoryAccess, id bool) {
+			switch {
+			case i >= len(argv):
+			case id:
+				out = append(out, d.id(spirv.Id(argv[i])))
+			default:
+				out = append(out, fmt.Sprint(argv[i]))
+			}
+		})
+
+		if n > len(argv) {
+			n = len(argv)
+		}
+		argv = argv[n:]
+
+		mask = nil
+		if len(argv) > 0 {
+			m := spirv.MemoryAccess(argv[0])
+			out = append(out, enumString(m, argv[0]))
+			mask, argv = &m, argv[1:]
+		}
+	}
+
+	return append(out, wordsOf(argv)...)
+}
+
+// decoration formats the extra operands of a decoration.
+func decoration(dec spirv.Decoration, argv []uint32) []string {
+	if len(argv) == 0 {
+		return nil
+	}
+
+	var e spirv.Enum
+	switch dec {
+	case spirv.DecorationBuiltIn:
+		e = spirv.BuiltIn(argv[0])
+	case spirv.DecorationFuncParamAttr:
+		e = spirv.FunctionParameterAttribute(argv[0])
+	case spirv.DecorationFPRoundingMode:
+		e = spirv.FPRoundingMode(argv[0])
+	case spirv.DecorationFPFastMathMode:
+		e = spirv.FPFastMathMode(argv[0])
+
+	case spirv.DecorationLinkageAttributes:
+		// The name of the symbol, followed by its linkage type.
+		name := spirv.DecodeString(argv)
+		n := int(name.EncodedLen())
+		if n < len(argv) {
+			return append([]string{quote(string(name)), enumString(spirv.LinkageType(argv[n]), argv[n])},
+				wordsOf(argv[n+1:])...)
+		}
+	}
+
+	if e != nil {
+		return append([]string{enumString(e, argv[0])}, wordsOf(argv[1:])...)
+	}
+
+	return wordsOf(argv)
+}
+
+// extInstName returns the name of an instruction from the extended
+// instruction set imported as set.
+func (d *disassembler) extInstName(set spirv.Id, opcode uint32) string {
+	s, ok := d.sets[set]
+	if !ok {
+		return fmt.Sprint(opcode)
+	}
+
+	// The sets in this repository name their instructions as the
+	// specification spells them.
+	if named, ok := s.(interface {
+		OpcodeName(uint32) (string, bool)
+	}); ok {
+		if name, ok := named.OpcodeName(opcode); ok {
+			return name
+		}
+	}
+
+	if fun, ok := s.Lookup(opcode); ok {
+		return reflect.Indirect(reflect.ValueOf(fun())).Type().Name()
+	}
+
+	return fmt.Sprint(opcode)
+}
+
+// opcodeName returns the name of the given opcode, without its "Op"
+// prefix, as used by OpSpecConstantOp.
+func opcodeName(opcode uint32) string {
+	fun, ok := spirv.Lookup(opcode)
+	if !ok {
+		return fmt.Sprint(opcode)
+	}
+
+	name := reflect.Indirect(reflect.ValueOf(fun())).Type().Name()
+	return strings.TrimPrefix(name, "Op")
+}
@@ -0,0 +1,207 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package disasm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/andreas-jonsson/spirv"
+	"github.com/andreas-jonsson/spirv/glslstd450"
+)
+
+func TestWrite(t *testing.T) {
+	mod := spirv.NewModule()
+	mod.Header.Version = spirv.Version10
+	mod.Header.GeneratorMagic = 8<<16 | 10
+	mod.Header.Bound = 20
+	mod.Code = spirv.InstructionList{
+		&spirv.OpCapability{Capability: spirv.CapabilityShader},
+		&spirv.OpExtInstImport{ResultId: 1, Name: "GLSL.std.450"},
+		&spirv.OpMemoryModel{AddressingModel: spirv.AddressingModelLogical, MemoryModel: spirv.MemoryModelGLSL450},
+		&spirv.OpEntryPoint{ExecutionModel: spirv.ExecutionModelFragment, EntryPoint: 4, Name: "main", Interface: []spirv.Id{12}},
+		&spirv.OpExecutionMode{EntryPoint: 4, Mode: spirv.ExecutionModeOriginUpperLeft},
+		&spirv.OpName{Target: 4, Name: "main"},
+		&spirv.OpName{Target: 12, Name: "color out"},
+		&spirv.OpDecorate{Target: 12, Decoration: spirv.DecorationLocation, Argv: []uint32{0}},
+		&spirv.OpTypeVoid{ResultId: 2},
+		&spirv.OpTypeFunction{ResultId: 3, ReturnType: 2},
+		&spirv.OpTypeFloat{ResultId: 5, Width: 32},
+		&spirv.OpTypeVector{ResultId: 6, ComponentType: 5, ComponentCount: 4},
+		&spirv.OpTypePointer{ResultId: 7, StorageClass: spirv.StorageClassOutput, Type: 6},
+		&spirv.OpConstant{ResultType: 5, ResultId: 8, Value: []uint32{0xbf000000}},
+		&spirv.OpVariable{ResultType: 7, ResultId: 12, StorageClass: spirv.StorageClassOutput},
+		&spirv.OpFunction{ResultType: 2, ResultId: 4, FunctionControl: spirv.FunctionControlNone, FunctionType: 3},
+		&spirv.OpLabel{ResultId: 13},
+		&spirv.OpExtInst{ResultType: 5, ResultId: 14, Set: 1, Instruction: glslstd450.OpFAbs, Operands: []spirv.Id{8}},
+		&spirv.OpCompositeConstruct{ResultType: 6, ResultId: 15, Constituents: []spirv.Id{14, 14, 14, 8}},
+		&spirv.OpStore{Pointer: 12, Object: 15},
+		&spirv.OpReturn{},
+		&spirv.OpFunctionEnd{},
+	}
+
+	want := `; SPIR-V
+; Version: 1.0
+; Generator: Khronos Glslang Reference Front End; 10
+; Bound: 20
+; Schema: 0
+               OpCapability Shader
+          %1 = OpExtInstImport "GLSL.std.450"
+               OpMemoryModel Logical GLSL450
+               OpEntryPoint Fragment %main "main" %color_out
+               OpExecutionMode %main OriginUpperLeft
+               OpName %main "main"
+               OpName %color_out "color out"
+               OpDecorate %color_out Location 0
+       %void = OpTypeVoid
+          %3 = OpTypeFunction %void
+      %float = OpTypeFloat 32
+    %v4float = OpTypeVector %float 4
+%_ptr_Output_v4float = OpTypePointer Output %v4float
+ %float_n0_5 = OpConstant %float -0.5
+  %color_out = OpVariable %_ptr_Output_v4float Output
+       %main = OpFunction %void None %3
+         %13 = OpLabel
+         %14 = OpExtInst %float %1 FAbs %float_n0_5
+         %15 = OpCompositeConstruct %v4float %14 %14 %14 %float_n0_5
+               OpStore %color_out %15
+               OpReturn
+               OpFunctionEnd
+`
+
+	if have := String(mod); have != want {
+		t.Fatalf("disassembly mismatch:\nHave:\n%s\nWant:\n%s", have, want)
+	}
+
+	var sb strings.Builder
+	err := WriteWithOptions(&sb, mod, Options{RawIds: true, NoHeader: true, NoIndent: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(sb.String(), "\n")
+	for i, want := range map[int]string{
+		0:  "OpCapability Shader",
+		13: "%8 = OpConstant %5 -0.5",
+		17: "%14 = OpExtInst %5 %1 FAbs %8",
+	} {
+		if lines[i] != want {
+			t.Fatalf("line %d mismatch:\nHave: %s\nWant: %s", i, lines[i], want)
+		}
+	}
+}
+
+func TestWriteOperands(t *testing.T) {
+	aligned := spirv.MemoryAccess(spirv.MemoryAccessAligned | spirv.MemoryAccessMakePointerAvailable)
+	volatile := spirv.MemoryAccess(spirv.MemoryAccessVolatile)
+
+	for _, st := range []struct {
+		instr spirv.Instruction
+		want  string
+	}{
+		{&spirv.OpSource{SourceLanguage: spirv.SourceLanguageGLSL, Version: 450, File: 10, Source: "a \"b\" \\c"},
+			`OpSource GLSL 450 %10 "a \"b\" \\c"`},
+		{&spirv.OpDecorate{Target: 10, Decoration: spirv.DecorationBuiltIn, Argv: []uint32{spirv.BuiltInPosition}},
+			"OpDecorate %10 BuiltIn Position"},
+		{&spirv.OpDecorate{Target: 10, Decoration: spirv.DecorationLinkageAttributes, Argv: []uint32{0x006e6966, spirv.LinkageTypeExport}},
+			`OpDecorate %10 LinkageAttributes "fin" Export`},
+		{&spirv.OpMemberDecorate{StructureType: 10, Member: 1, Decoration: spirv.DecorationOffset, Argv: []uint32{16}},
+			"OpMemberDecorate %10 1 Offset 16"},
+		{&spirv.OpLoad{ResultType: 1, ResultId: 11, Pointer: 10, MemoryAccess: &aligned, Argv: []uint32{4, 5}},
+			"%11 = OpLoad %1 %10 Aligned|MakePointerAvailable 4 %5"},
+		{&spirv.OpCopyMemory{Target: 10, Source: 11, MemoryAccess: &volatile, Argv: []uint32{spirv.MemoryAccessAligned, 8}},
+			"OpCopyMemory %10 %11 Volatile Aligned 8"},
+		{&spirv.OpSwitch{Selector: 21, Default: 10, Target: []uint32{1, 11, 0xffffffff, 12}},
+			"OpSwitch %21 %10 1 %11 -1 %12"},
+		{&spirv.OpSwitch{Selector: 22, Default: 10, Target: []uint32{1, 2, 11}},
+			"OpSwitch %22 %10 8589934593 %11"},
+		{&spirv.OpConstant{ResultType: 3, ResultId: 11, Value: []uint32{0, 0x3ff80000}},
+			"%11 = OpConstant %3 1.5"},
+		{&spirv.OpConstant{ResultType: 2, ResultId: 11, Value: []uint32{0x7fc00000}},
+			"%11 = OpConstant %2 0x1.8p+128"},
+		{&spirv.OpConstant{ResultType: 2, ResultId: 11, Value: []uint32{0xff800000}},
+			"%11 = OpConstant %2 -0x1p+128"},
+		{&spirv.OpConstant{ResultType: 6, ResultId: 11, Value: []uint32{0x3c00}},
+			"%11 = OpConstant %6 1"},
+		{&spirv.OpSpecConstantOp{ResultType: 4, ResultId: 11, Operation: 128, Operands: []spirv.Id{20, 20}},
+			"%11 = OpSpecConstantOp %4 IAdd %20 %20"},
+		{&spirv.OpSelectionMerge{MergeBlock: 10, SelectionControl: spirv.SelectionControlFlatten | spirv.SelectionControlDontFlatten},
+			"OpSelectionMerge %10 Flatten|DontFlatten"},
+		{&spirv.OpVariable{ResultType: 1, ResultId: 11, StorageClass: 999},
+			"%11 = OpVariable %1 999"},
+	} {
+		mod := spirv.NewModule()
+		mod.Code = spirv.InstructionList{
+			&spirv.OpTypeFloat{ResultId: 2, Width: 32},
+			&spirv.OpTypeFloat{ResultId: 3, Width: 64},
+			&spirv.OpTypeInt{ResultId: 4, Width: 32, Signedness: 1},
+			&spirv.OpTypeInt{ResultId: 5, Width: 64},
+			&spirv.OpTypeFloat{ResultId: 6, Width: 16},
+			&spirv.OpUndef{ResultType: 4, ResultId: 21},
+			&spirv.OpUndef{ResultType: 5, ResultId: 22},
+			st.instr,
+		}
+
+		var sb strings.Builder
+		err := WriteWithOptions(&sb, mod, Options{RawIds: true, NoHeader: true, NoIndent: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+		if have := lines[len(lines)-1]; have != st.want {
+			t.Fatalf("%T: mismatch:\nHave: %s\nWant: %s", st.instr, have, st.want)
+		}
+	}
+}
+
+func TestFriendlyNames(t *testing.T) {
+	code := spirv.InstructionList{
+		&spirv.OpName{Target: 1, Name: "x"},
+		&spirv.OpName{Target: 1, Name: "y"},
+		&spirv.OpName{Target: 2, Name: "x"},
+		&spirv.OpName{Target: 3, Name: "x"},
+		&spirv.OpName{Target: 4, Name: ""},
+		&spirv.OpName{Target: 5, Name: "42"},
+		&spirv.OpTypeInt{ResultId: 6, Width: 16},
+		&spirv.OpTypeInt{ResultId: 7, Width: 24, Signedness: 1},
+		&spirv.OpTypeBool{ResultId: 8},
+		&spirv.OpTypeVector{ResultId: 9, ComponentType: 8, ComponentCount: 3},
+		&spirv.OpTypeMatrix{ResultId: 10, ColumnType: 9, ColumnCount: 2},
+		&spirv.OpConstant{ResultType: 6, ResultId: 11, Value: []uint32{4}},
+		&spirv.OpTypeArray{ResultId: 12, ElementType: 10, Length: 11},
+		&spirv.OpTypeRuntimeArray{ResultId: 13, ElementType: 7},
+		&spirv.OpTypeStruct{ResultId: 14, Members: []spirv.Id{12}},
+		&spirv.OpConstantTrue{ResultType: 8, ResultId: 15},
+		&spirv.OpConstantTrue{ResultType: 8, ResultId: 16},
+	}
+
+	want := map[spirv.Id]string{
+		1:  "x",
+		2:  "x_0",
+		3:  "x_1",
+		4:  "_",
+		5:  "_42",
+		6:  "ushort",
+		7:  "i24",
+		8:  "bool",
+		9:  "v3bool",
+		10: "mat2v3bool",
+		11: "ushort_4",
+		12: "_arr_mat2v3bool_ushort_4",
+		13: "_runtimearr_i24",
+		14: "_struct_14",
+		15: "true",
+		16: "true_0",
+	}
+
+	mod := &spirv.Module{Code: code}
+	have := friendlyNames(code, mod.Types())
+
+	for id, name := range want {
+		if have[id] != name {
+			t.Fatalf("name mismatch for %d:\nHave: %s\nWant: %s", id, have[id], name)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+/*
+Package disasm turns SPIR-V modules into the textual assembly syntax used
+by the Khronos SPIR-V tools. Its output matches that of spirv-dis:
+
+	; SPIR-V
+	; Version: 1.0
+	; Generator: Khronos Glslang Reference Front End; 10
+	; Bound: 20
+	; Schema: 0
+	               OpCapability Shader
+	          %1 = OpExtInstImport "GLSL.std.450"
+	               OpMemoryModel Logical GLSL450
+	               OpEntryPoint Fragment %main "main" %color
+	               ...
+	       %void = OpTypeVoid
+	      %float = OpTypeFloat 32
+	    %v4float = OpTypeVector %float 4
+	...
+
+Ids are given the names assigned to them by OpName instructions. Types
+and constants without a name get one derived from their definition, as
+shown above. All other Ids are printed as numbers. Enumerated operands
+are spelled symbolically and the instructions of the GLSL.std.450 and
+OpenCL.std extended instruction sets are referred to by name.
+
+A module is disassembled with:
+
+	err := disasm.Write(w, module)
+	...
+*/
+package disasm
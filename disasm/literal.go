@@ -0,0 +1,193 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package disasm
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/andreas-jonsson/spirv"
+)
+
+// literal formats the words of a numeric literal with the given type.
+// Literals of unknown types are printed as a list of words.
+func literal(types *spirv.TypeTable, typ spirv.Id, words []uint32) string {
+	t, ok := types.Type(typ)
+	if !ok || len(words) == 0 || len(words) > 2 {
+		return strings.Join(wordsOf(words), " ")
+	}
+
+	v := uint64(words[0])
+	if len(words) > 1 {
+		v |= uint64(words[1]) << 32
+	}
+
+	switch t.Kind {
+	case spirv.TypeInt:
+		if t.Signed && t.Width > 0 && t.Width <= 64 {
+			shift := 64 - t.Width
+			return fmt.Sprint(int64(v<<shift) >> shift)
+		}
+		return fmt.Sprint(v)
+
+	case spirv.TypeFloat:
+		switch t.Width {
+		case 16:
+			return float16(uint16(v))
+		case 32:
+			return float32String(uint32(v))
+		case 64:
+			return float64String(v)
+		}
+	}
+
+	return strings.Join(wordsOf(words), " ")
+}
+
+// wordsOf formats the given words as numbers.
+func wordsOf(argv []uint32) []string {
+	out := make([]string, len(argv))
+	for i, word := range argv {
+		out[i] = fmt.Sprint(word)
+	}
+	return out
+}
+
+// float32String formats a 32-bit float with enough digits to represent
+// it exactly. Infinities and NaNs are printed as hexadecimal floats.
+func float32String(bits uint32) string {
+	f := math.Float32frombits(bits)
+	if math.IsInf(float64(f), 0) || math.IsNaN(float64(f)) {
+		return hexFloat(uint64(bits), 23, 8)
+	}
+	return fmt.Sprintf("%.9g", f)
+}
+
+// float64String formats a 64-bit float with enough digits to represent
+// it exactly. Infinities and NaNs are printed as hexadecimal floats.
+func float64String(bits uint64) string {
+	f := math.Float64frombits(bits)
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return hexFloat(bits, 52, 11)
+	}
+	return fmt.Sprintf("%.17g", f)
+}
+
+// float16 formats a 16-bit float.
+func float16(bits uint16) string {
+	sign := uint32(bits>>15) << 31
+	exp := uint32(bits>>10) & 0x1f
+	mant := uint32(bits) & 0x3ff
+
+	switch {
+	case exp == 0x1f:
+		return hexFloat(uint64(bits), 10, 5)
+
+	case exp == 0 && mant == 0:
+		return float32String(sign)
+
+	case exp == 0:
+		// Subnormal; normalize it for float32.
+		exp = 127 - 15 + 1
+		for mant&0x400 == 0 {
+			mant <<= 1
+			exp--
+		}
+		mant &= 0x3ff
+
+	default:
+		exp += 127 - 15
+	}
+
+	f := math.Float32frombits(sign | exp<<23 | mant<<13)
+	return fmt.Sprintf("%.5g", f)
+}
+
+// hexFloat formats an infinity or NaN with the given number of mantissa
+// and exponent bits, the same way as the SPIR-V tools do:
+//
+//	0x1p+128     Infinity
+//	-0x1.8p+128  Negative quiet NaN
+func hexFloat(bits uint64, mantBits, expBits uint) string {
+	var sign string
+	if bits>>(mantBits+expBits)&1 != 0 {
+		sign = "-"
+	}
+
+	// The fraction is printed in whole hex digits.
+	digits := (mantBits + 3) / 4
+	mant := (bits & (1<<mantBits - 1)) << (digits*4 - mantBits)
+	bias := 1 << (expBits - 1)
+
+	frac := strings.TrimRight(fmt.Sprintf("%0*x", digits, mant), "0")
+	if frac == "" {
+		return fmt.Sprintf("%s0x1p+%d", sign, bias)
+	}
+
+	return fmt.Sprintf("%s0x1.%sp+%d", sign, frac, bias)
+}
+
+// quote returns the given string as a quoted literal. Only quotes and
+// backslashes are escaped.
+func quote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	return `"` + s + `"`
+}
+
+// generators holds the names of the registered tool vendors, as stored
+// in the upper 16 bits of the generator magic number.
+var generators = []string{
+	"Khronos",
+	"LunarG",
+	"Valve",
+	"Codeplay",
+	"NVIDIA",
+	"ARM",
+	"Khronos LLVM/SPIR-V Translator",
+	"Khronos SPIR-V Tools Assembler",
+	"Khronos Glslang Reference Front End",
+	"Qualcomm",
+	"AMD",
+	"Intel",
+	"Imagination",
+	"Google Shaderc over Glslang",
+	"Google spiregg",
+	"Google rspirv",
+	"X-LEGEND Mesa-IR/SPIR-V Translator",
+	"Khronos SPIR-V Tools Linker",
+	"Wine VKD3D Shader Compiler",
+	"Clay Clay Shader Compiler",
+	"W3C WebGPU Group WHLSL Shader Translator",
+	"Google Clspv",
+	"Google MLIR SPIR-V Serializer",
+	"Google Tint Compiler",
+}
+
+// generatorName returns the name of the given tool vendor.
+func generatorName(vendor uint32) string {
+	if int(vendor) < len(generators) {
+		return generators[vendor]
+	}
+	return fmt.Sprintf("Unknown(%d)", vendor)
+}
+
+// LookupGenerator returns the tool vendor with the given name, as written
+// in the "; Generator:" header comment. This includes names of the form
+// "Unknown(n)", used for vendors which are not listed.
+func LookupGenerator(name string) (uint32, bool) {
+	for vendor, have := range generators {
+		if have == name {
+			return uint32(vendor), true
+		}
+	}
+
+	var vendor uint32
+	if _, err := fmt.Sscanf(name, "Unknown(%d)", &vendor); err == nil && vendor <= 0xffff && name == generatorName(vendor) {
+		return vendor, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,183 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package disasm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andreas-jonsson/spirv"
+)
+
+// namer assigns unique friendly names to Ids.
+type namer struct {
+	names map[spirv.Id]string
+	used  map[string]bool
+}
+
+// friendlyNames returns the friendly names of the Ids defined in the given
+// code. Names from OpName take precedence. Types and constants are named
+// after their definition, the same way spirv-dis does:
+//
+//	%v4float = OpTypeVector %float 4
+//	%_ptr_Input_v4float = OpTypePointer Input %v4float
+//	%float_0_5 = OpConstant %float 0.5
+//
+// All names are unique. Conflicts are resolved with a numeric suffix.
+func friendlyNames(code spirv.InstructionList, types *spirv.TypeTable) map[spirv.Id]string {
+	n := namer{
+		names: make(map[spirv.Id]string),
+		used:  make(map[string]bool),
+	}
+
+	for _, instr := range code {
+		if v, ok := instr.(*spirv.OpName); ok {
+			n.save(v.Target, string(v.Name))
+		}
+	}
+
+	for _, instr := range code {
+		switch v := instr.(type) {
+		case *spirv.OpTypeVoid:
+			n.save(v.ResultId, "void")
+		case *spirv.OpTypeBool:
+			n.save(v.ResultId, "bool")
+		case *spirv.OpTypeInt:
+			n.save(v.ResultId, intName(v.Width, v.Signedness != 0))
+		case *spirv.OpTypeFloat:
+			n.save(v.ResultId, floatName(v.Width))
+		case *spirv.OpTypeVector:
+			n.save(v.ResultId, fmt.Sprintf("v%d%s", v.ComponentCount, n.name(v.ComponentType)))
+		case *spirv.OpTypeMatrix:
+			n.save(v.ResultId, fmt.Sprintf("mat%d%s", v.ColumnCount, n.name(v.ColumnType)))
+		case *spirv.OpTypeArray:
+			n.save(v.ResultId, "_arr_"+n.name(v.ElementType)+"_"+n.name(v.Length))
+		case *spirv.OpTypeRuntimeArray:
+			n.save(v.ResultId, "_runtimearr_"+n.name(v.ElementType))
+		case *spirv.OpTypePointer:
+			n.save(v.ResultId, "_ptr_"+enumString(v.StorageClass, uint32(v.StorageClass))+"_"+n.name(v.Type))
+		case *spirv.OpTypeStruct:
+			n.save(v.ResultId, fmt.Sprintf("_struct_%d", v.ResultId))
+		case *spirv.OpTypeOpaque:
+			n.save(v.ResultId, "Opaque_"+string(v.Name))
+		case *spirv.OpTypePipe:
+			n.save(v.ResultId, "Pipe"+enumString(v.Qualifier, uint32(v.Qualifier)))
+		case *spirv.OpTypeEvent:
+			n.save(v.ResultId, "Event")
+		case *spirv.OpTypeDeviceEvent:
+			n.save(v.ResultId, "DeviceEvent")
+		case *spirv.OpTypeReserveId:
+			n.save(v.ResultId, "ReserveId")
+		case *spirv.OpTypeQueue:
+			n.save(v.ResultId, "Queue")
+		case *spirv.OpTypePipeStorage:
+			n.save(v.ResultId, "PipeStorage")
+		case *spirv.OpTypeNamedBarrier:
+			n.save(v.ResultId, "NamedBarrier")
+
+		case *spirv.OpConstantTrue:
+			n.save(v.ResultId, "true")
+		case *spirv.OpConstantFalse:
+			n.save(v.ResultId, "false")
+		case *spirv.OpConstant:
+			value := strings.Replace(literal(types, v.ResultType, v.Value), "-", "n", -1)
+			n.save(v.ResultId, n.name(v.ResultType)+"_"+value)
+		}
+	}
+
+	return n.names
+}
+
+// name returns the name of the given Id, or its number if it has none.
+func (n *namer) name(id spirv.Id) string {
+	if name, ok := n.names[id]; ok {
+		return name
+	}
+	return fmt.Sprint(uint32(id))
+}
+
+// save assigns the given name to id, unless it already has one. Invalid
+// characters are replaced and the name is made unique.
+func (n *namer) save(id spirv.Id, name string) {
+	if _, ok := n.names[id]; ok {
+		return
+	}
+
+	name = sanitize(name)
+
+	if n.used[name] {
+		base := name + "_"
+		for i := 0; n.used[name]; i++ {
+			name = fmt.Sprintf("%s%d", base, i)
+		}
+	}
+
+	n.used[name] = true
+	n.names[id] = name
+}
+
+// sanitize replaces all characters which can not appear in an Id name
+// with underscores. Names made up of digits alone are prefixed with one,
+// so they do not clash with the numbers of unnamed Ids.
+func sanitize(name string) string {
+	if name == "" {
+		return "_"
+	}
+
+	digits := true
+	out := []byte(name)
+
+	for i, c := range out {
+		switch {
+		case c >= '0' && c <= '9':
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+			digits = false
+		default:
+			out[i] = '_'
+			digits = false
+		}
+	}
+
+	if digits {
+		return "_" + string(out)
+	}
+
+	return string(out)
+}
+
+// intName returns the name of an integer type.
+func intName(width uint32, signed bool) string {
+	var name string
+	switch width {
+	case 8:
+		name = "char"
+	case 16:
+		name = "short"
+	case 32:
+		name = "int"
+	case 64:
+		name = "long"
+	default:
+		name = fmt.Sprintf("i%d", width)
+	}
+
+	if !signed {
+		return "u" + name
+	}
+
+	return name
+}
+
+// floatName returns the name of a floating point type.
+func floatName(width uint32) string {
+	switch width {
+	case 16:
+		return "half"
+	case 32:
+		return "float"
+	case 64:
+		return "double"
+	}
+	return fmt.Sprintf("fp%d", width)
+}
@@ -3,12 +3,16 @@
 
 /*
 Package spirv is a Go encoder/decoder for the Vulkan SPIR-V format.
-This is based on the priliminary specification and is subject to
-change as the specification matures.
+It supports the released specification, versions 1.0 through 1.6.
 
-	Specification:   https://www.khronos.org/registry/spir-v/specs/1.0/SPIRV.pdf
+	Specification:   https://registry.khronos.org/SPIR-V/specs/unified1/SPIRV.html
 	Additional info: https://www.khronos.org/registry/spir-v/
 
+Modules written against the provisional specification carry version
+number 99 in their header. Their instructions are defined in package
+prerelease, and the Decoder selects that instruction set automatically
+when it encounters such a header.
+
 Usage
 
 At the highest level, one can operate on complete modules.
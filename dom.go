@@ -0,0 +1,390 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// DomTree defines a dominator or post-dominator tree over the blocks of
+// a function. Blocks which can not be reached from the root, are not part
+// of the tree.
+type DomTree struct {
+	cfg      *CFG
+	idom     []int // Immediate dominator by block index; -1 if none.
+	children [][]*Block
+	pre      []int // Preorder number of each node in the tree.
+	post     []int // Postorder number of each node in the tree.
+}
+
+// Dominators computes the dominator tree of the function. Its root is
+// the entry block.
+func (c *CFG) Dominators() *DomTree {
+	n := len(c.Blocks)
+	if n == 0 {
+		return newDomTree(c, nil, 0)
+	}
+
+	succs := func(i int) []int { return blockIndices(c.Blocks[i].Succs) }
+	preds := func(i int) []int { return blockIndices(c.Blocks[i].Preds) }
+
+	idom := computeIdom(n, 0, succs, preds)
+	return newDomTree(c, idom, 0)
+}
+
+// PostDominators computes the post-dominator tree of the function.
+// As a function can have multiple exits, the tree has a virtual root
+// which is the immediate post-dominator of all exit blocks. Blocks from
+// which no exit can be reached, like those in infinite loops, are not
+// part of the tree.
+func (c *CFG) PostDominators() *DomTree {
+	n := len(c.Blocks)
+	if n == 0 {
+		return newDomTree(c, nil, 0)
+	}
+
+	// The virtual exit has index n. Edges are reversed.
+	exits := blockIndices(c.Exits)
+
+	succs := func(i int) []int {
+		if i == n {
+			return exits
+		}
+		return blockIndices(c.Blocks[i].Preds)
+	}
+
+	preds := func(i int) []int {
+		if i == n {
+			return nil
+		}
+
+		out := blockIndices(c.Blocks[i].Succs)
+		if len(c.Blocks[i].Succs) == 0 {
+			out = append(out, n)
+		}
+
+		return out
+	}
+
+	idom := computeIdom(n+1, n, succs, preds)
+	return newDomTree(c, idom, n)
+}
+
+// newDomTree creates a tree from the given immediate dominators.
+// The tree's root has index root, which may be a virtual node.
+func newDomTree(c *CFG, idom []int, root int) *DomTree {
+	size := len(idom)
+
+	t := &DomTree{
+		cfg:      c,
+		idom:     make([]int, len(c.Blocks)),
+		children: make([][]*Block, len(c.Blocks)),
+		pre:      make([]int, size),
+		post:     make([]int, size),
+	}
+
+	for i := range t.idom {
+		t.idom[i] = -1
+	}
+
+	if size == 0 {
+		return t
+	}
+
+	kids := make([][]int, size)
+
+	for i, d := range idom {
+		if d < 0 || i == root {
+			continue
+		}
+
+		kids[d] = append(kids[d], i)
+
+		if d < len(c.Blocks) {
+			t.idom[i] = d
+			t.children[d] = append(t.children[d], c.Blocks[i])
+		}
+	}
+
+	// Number the tree, so we can answer dominance queries quickly.
+	for i := range t.pre {
+		t.pre[i] = -1
+	}
+
+	type frame struct{ node, next int }
+
+	var clock int
+	stack := []frame{{node: root}}
+	t.pre[root] = clock
+	clock++
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+
+		if top.next < len(kids[top.node]) {
+			k := kids[top.node][top.next]
+			top.next++
+
+			t.pre[k] = clock
+			clock++
+			stack = append(stack, frame{node: k})
+			continue
+		}
+
+		t.post[top.node] = clock
+		clock++
+		stack = stack[:len(stack)-1]
+	}
+
+	return t
+}
+
+// Idom returns the immediate dominator of b. Returns nil for the root of
+// the tree, for blocks whose immediate post-dominator is the virtual exit
+// and for blocks which are not part of the tree.
+func (t *DomTree) Idom(b *Block) *Block {
+	d := t.idom[b.Index]
+	if d < 0 {
+		return nil
+	}
+	return t.cfg.Blocks[d]
+}
+
+// Children returns the blocks which b immediately dominates.
+func (t *DomTree) Children(b *Block) []*Block {
+	return t.children[b.Index]
+}
+
+// Contains returns true if b is part of the tree.
+func (t *DomTree) Contains(b *Block) bool {
+	return b.Index < len(t.pre) && t.pre[b.Index] > -1
+}
+
+// Dominates returns true if a dominates b. Every block in the tree
+// dominates itself.
+func (t *DomTree) Dominates(a, b *Block) bool {
+	if !t.Contains(a) || !t.Contains(b) {
+		return false
+	}
+
+	return t.pre[a.Index] <= t.pre[b.Index] && t.post[b.Index] <= t.post[a.Index]
+}
+
+// computeIdom computes the immediate dominators of a graph with n nodes,
+// using the algorithm by Cooper, Harvey and Kennedy. The root dominates
+// itself. Nodes which can not be reached from the root yield -1.
+func computeIdom(n, root int, succs, preds func(int) []int) []int {
+	// Number the nodes in reverse postorder.
+	order := make([]int, 0, n)
+	visited := make([]bool, n)
+
+	type frame struct {
+		node int
+		next int
+	}
+
+	stack := []frame{{node: root}}
+	visited[root] = true
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		s := succs(top.node)
+
+		if top.next < len(s) {
+			k := s[top.next]
+			top.next++
+
+			if !visited[k] {
+				visited[k] = true
+				stack = append(stack, frame{node: k})
+			}
+			continue
+		}
+
+		order = append(order, top.node)
+		stack = stack[:len(stack)-1]
+	}
+
+	rpo := make([]int, n) // Reverse postorder number by node.
+	for i := range rpo {
+		rpo[i] = -1
+	}
+
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+
+	for i, node := range order {
+		rpo[node] = i
+	}
+
+	idom := make([]int, n)
+	for i := range idom {
+		idom[i] = -1
+	}
+
+	idom[root] = root
+
+	intersect := func(a, b int) int {
+		for a != b {
+			for rpo[a] > rpo[b] {
+				a = idom[a]
+			}
+			for rpo[b] > rpo[a] {
+				b = idom[b]
+			}
+		}
+		return a
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		for _, node := range order[1:] {
+			d := -1
+
+			for _, p := range preds(node) {
+				if idom[p] < 0 {
+					continue // Unreachable or not processed yet.
+				}
+
+				if d < 0 {
+					d = p
+				} else {
+					d = intersect(p, d)
+				}
+			}
+
+			if d != idom[node] {
+				idom[node] = d
+				changed = true
+			}
+		}
+	}
+
+	return idom
+}
+
+func blockIndices(set []*Block) []int {
+	out := make([]int, len(set))
+	for i, b := range set {
+		out[i] = b.Index
+	}
+	return out
+}
+
+// verifyDominance ensures the definition of every Id in a function
+// dominates all its uses, as defined in chapter 2.16.1 of the
+// specification. The exceptions are the operands of OpPhi, which need
+// only dominate the corresponding parent block, and functions, which
+// may be called before they are defined.
+func (m *Module) verifyDominance(v *verifier) {
+	cfgs, err := m.CFGs()
+	if err != nil {
+		v.report(err)
+		return
+	}
+
+	if len(cfgs) == 0 {
+		return
+	}
+
+	// Map each address in a function body to its function and block.
+	type location struct {
+		cfg   *CFG
+		block *Block // Nil for parameters.
+	}
+
+	where := make(map[int]location)
+	doms := make(map[*CFG]*DomTree, len(cfgs))
+
+	for _, cfg := range cfgs {
+		doms[cfg] = cfg.Dominators()
+
+		for addr := cfg.Start + 1; addr < cfg.End; addr++ {
+			where[addr] = location{cfg: cfg}
+		}
+
+		for _, b := range cfg.Blocks {
+			for addr := b.Start; addr <= b.End; addr++ {
+				where[addr] = location{cfg: cfg, block: b}
+			}
+		}
+	}
+
+	du := m.DefUse()
+
+	for addr := range m.Code {
+		def, ok := where[addr]
+		if !ok {
+			continue // Not inside a function body.
+		}
+
+		switch m.Code[addr].(type) {
+		case *OpLabel:
+			continue // Labels may be referenced before they are defined.
+		}
+
+		id, ok := instructionResultId(m.Code[addr])
+		if !ok {
+			continue
+		}
+
+		for _, u := range du.Uses(id) {
+			use, ok := where[u.Addr]
+			if !ok {
+				continue // Debug and annotation instructions.
+			}
+
+			instr := m.Code[u.Addr]
+			name := instructionName(instr)
+
+			if use.cfg != def.cfg {
+				if !v.report(NewLayoutError(u.Addr, "%s: %s(%d) is defined in another function, at $%08x",
+					name, u.Field, id, addr)) {
+					return
+				}
+				continue
+			}
+
+			if def.block == nil {
+				continue // Parameters dominate the entire function.
+			}
+
+			tree := doms[use.cfg]
+
+			// OpPhi operands must dominate the parent block they
+			// come from, rather than the OpPhi itself.
+			if phi, ok := instr.(*OpPhi); ok && u.Field == "Operands" {
+				if u.Index+1 >= len(phi.Operands) {
+					continue // Caught by OpPhi.Verify.
+				}
+
+				parent, ok := use.cfg.Block(phi.Operands[u.Index+1])
+				if !ok || !tree.Contains(parent) {
+					continue
+				}
+
+				if !tree.Dominates(def.block, parent) && !v.report(NewLayoutError(u.Addr,
+					"%s: %s(%d) does not dominate parent block %d; defined at $%08x",
+					name, u.Field, id, parent.Label, addr)) {
+					return
+				}
+
+				continue
+			}
+
+			if !tree.Contains(use.block) {
+				continue // Unreachable code.
+			}
+
+			if use.block == def.block && u.Addr > addr {
+				continue
+			}
+
+			if use.block == def.block || !tree.Dominates(def.block, use.block) {
+				if !v.report(NewLayoutError(u.Addr, "%s: %s(%d) is used before it is defined at $%08x",
+					name, u.Field, id, addr)) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDominators(t *testing.T) {
+	mod := NewModule()
+	mod.Code = cfgCode
+
+	cfg, err := mod.CFG(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testDomTree(t, cfg, cfg.Dominators(), []struct {
+		label, idom Id
+		children    []Id
+	}{
+		{10, 0, []Id{11}},
+		{11, 10, []Id{12, 16}},
+		{12, 11, []Id{13, 14, 15}},
+		{13, 12, []Id{}},
+		{16, 11, []Id{}},
+		{17, 0, []Id{}},
+	})
+
+	dt := cfg.Dominators()
+	b := func(label Id) *Block { b, _ := cfg.Block(label); return b }
+
+	for _, st := range []struct {
+		a, b Id
+		want bool
+	}{
+		{10, 16, true},
+		{11, 13, true},
+		{12, 12, true},
+		{13, 11, false},
+		{12, 16, false},
+		{10, 17, false},
+		{17, 17, false},
+	} {
+		if have := dt.Dominates(b(st.a), b(st.b)); have != st.want {
+			t.Fatalf("Dominates(%d, %d): have %v, want %v", st.a, st.b, have, st.want)
+		}
+	}
+
+	if dt.Contains(b(17)) {
+		t.Fatalf("unreachable block should not be in the tree")
+	}
+}
+
+func TestPostDominators(t *testing.T) {
+	mod := NewModule()
+	mod.Code = cfgCode
+
+	cfg, err := mod.CFG(7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Blocks whose immediate post-dominator is the virtual exit
+	// have no Idom.
+	testDomTree(t, cfg, cfg.PostDominators(), []struct {
+		label, idom Id
+		children    []Id
+	}{
+		{10, 11, []Id{}},
+		{11, 0, []Id{10, 13}},
+		{12, 0, []Id{}},
+		{13, 11, []Id{}},
+		{14, 16, []Id{}},
+		{15, 0, []Id{}},
+		{16, 0, []Id{14}},
+		{17, 0, []Id{}},
+	})
+
+	pdt := cfg.PostDominators()
+	b := func(label Id) *Block { b, _ := cfg.Block(label); return b }
+
+	if !pdt.Dominates(b(11), b(10)) || !pdt.Dominates(b(16), b(14)) {
+		t.Fatalf("post-dominance mismatch")
+	}
+
+	if pdt.Dominates(b(16), b(12)) {
+		t.Fatalf("block 16 should not post-dominate block 12")
+	}
+
+	// An infinite loop never reaches an exit.
+	mod.Code = InstructionList{
+		&OpFunction{ResultId: 1},
+		&OpLabel{ResultId: 2},
+		&OpBranchConditional{Condition: 5, TrueLabel: 3, FalseLabel: 4},
+		&OpLabel{ResultId: 3},
+		&OpBranch{TargetLabel: 3},
+		&OpLabel{ResultId: 4},
+		&OpReturn{},
+		&OpFunctionEnd{},
+	}
+
+	cfg, err = mod.CFG(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pdt = cfg.PostDominators()
+	if pdt.Contains(cfg.Blocks[1]) || !pdt.Contains(cfg.Blocks[0]) {
+		t.Fatalf("infinite loop should not be in the post-dominator tree")
+	}
+}
+
+func testDomTree(t *testing.T, cfg *CFG, tree *DomTree, want []struct {
+	label, idom Id
+	children    []Id
+}) {
+	t.Helper()
+
+	for _, st := range want {
+		b, ok := cfg.Block(st.label)
+		if !ok {
+			t.Fatalf("block %d not found", st.label)
+		}
+
+		var idom Id
+		if d := tree.Idom(b); d != nil {
+			idom = d.Label
+		}
+
+		if idom != st.idom {
+			t.Fatalf("block %d: idom mismatch: have %d, want %d", st.label, idom, st.idom)
+		}
+
+		if have := blockLabels(tree.Children(b)); !reflect.DeepEqual(have, st.children) {
+			t.Fatalf("block %d: children mismatch:\nHave: %v\nWant: %v", st.label, have, st.children)
+		}
+	}
+}
+
+var (
+	// dominanceHead and dominanceTail hold a function which branches from
+	// block 10 to either 11 or 12, both of which continue at 13. The body
+	// of block 11 goes between them.
+	dominanceHead = InstructionList{
+		&OpCapability{Capability: CapabilityShader},
+		&OpMemoryModel{AddressingModel: AddressingModelLogical, MemoryModel: MemoryModelGLSL450},
+		&OpTypeVoid{ResultId: 1},
+		&OpTypeFunction{ResultId: 2, ReturnType: 1},
+		&OpTypeBool{ResultId: 3},
+		&OpConstantTrue{ResultType: 3, ResultId: 4},
+		&OpFunction{ResultType: 1, ResultId: 5, FunctionType: 2},
+		&OpLabel{ResultId: 10},
+		&OpLogicalNot{ResultType: 3, ResultId: 20, Operand: 4},
+		&OpSelectionMerge{MergeBlock: 13},
+		&OpBranchConditional{Condition: 20, TrueLabel: 11, FalseLabel: 12},
+		&OpLabel{ResultId: 11},
+	}
+
+	dominanceTail = InstructionList{
+		&OpBranch{TargetLabel: 13},
+		&OpLabel{ResultId: 12},
+		&OpLogicalNot{ResultType: 3, ResultId: 22, Operand: 4},
+		&OpBranch{TargetLabel: 13},
+		&OpLabel{ResultId: 13},
+		&OpPhi{ResultType: 3, ResultId: 23, Operands: []Id{21, 11, 22, 12}},
+		&OpFunctionCall{ResultType: 1, ResultId: 24, Function: 6},
+		&OpReturn{},
+		&OpFunctionEnd{},
+		&OpFunction{ResultType: 1, ResultId: 6, FunctionType: 2},
+		&OpLabel{ResultId: 30},
+		&OpReturn{},
+		&OpFunctionEnd{},
+	}
+)
+
+func TestModuleVerifyDominance(t *testing.T) {
+	for _, st := range []struct {
+		code []Instruction
+		want error
+	}{
+		{
+			// Valid: OpPhi operands dominate their parent blocks and
+			// function 6 is called before it is defined.
+			code: []Instruction{
+				&OpLogicalNot{ResultType: 3, ResultId: 21, Operand: 20},
+			},
+		},
+		{
+			// Use before definition in the same block.
+			code: []Instruction{
+				&OpLogicalNot{ResultType: 3, ResultId: 25, Operand: 21},
+				&OpLogicalNot{ResultType: 3, ResultId: 21, Operand: 20},
+			},
+			want: NewLayoutError(12, "%s: %s(%d) is used before it is defined at $%08x",
+				"OpLogicalNot", "Operand", 21, 13),
+		},
+		{
+			// Use of a value defined in a sibling block.
+			code: []Instruction{
+				&OpLogicalNot{ResultType: 3, ResultId: 21, Operand: 22},
+			},
+			want: NewLayoutError(12, "%s: %s(%d) is used before it is defined at $%08x",
+				"OpLogicalNot", "Operand", 22, 15),
+		},
+	} {
+		mod := NewModule()
+		mod.Header.Bound = 100
+		mod.Code = append(append(dominanceHead[:len(dominanceHead):len(dominanceHead)], st.code...), dominanceTail...)
+
+		have := mod.check((*Module).verifyDominance)
+		if !reflect.DeepEqual(have, st.want) {
+			t.Fatalf("error mismatch:\nHave: %v\nWant: %v", have, st.want)
+		}
+	}
+}
+
+func TestModuleVerifyDominancePhi(t *testing.T) {
+	mod := NewModule()
+	mod.Header.Bound = 100
+	mod.Code = append(append(dominanceHead[:len(dominanceHead):len(dominanceHead)],
+		&OpLogicalNot{ResultType: 3, ResultId: 21, Operand: 20}), dominanceTail...)
+
+	// The parent blocks are swapped, so that 21 comes from block 12,
+	// which it does not dominate.
+	mod.Code[18] = &OpPhi{ResultType: 3, ResultId: 23, Operands: []Id{21, 12, 22, 11}}
+
+	want := NewLayoutError(18, "%s: %s(%d) does not dominate parent block %d; defined at $%08x",
+		"OpPhi", "Operands", 21, 12, 12)
+
+	if have := mod.check((*Module).verifyDominance); !reflect.DeepEqual(have, want) {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+}
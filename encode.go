@@ -4,17 +4,27 @@
 package spirv
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io"
 	"reflect"
 	"strings"
 )
 
+// encoderBufferSize is the number of bytes an Encoder collects, before
+// it writes them to the underlying stream.
+const encoderBufferSize = 32 << 10
+
 // Encoder defines an encoder for the SPIR-V format.
 // It writes SPIR-V sequences of words into a binary stream.
+//
+// Output is buffered. Call Flush once all data has been encoded,
+// to ensure it is written to the underlying stream.
 type Encoder struct {
 	w      io.Writer
 	buf    []uint32
+	out    []byte // Encoded data not yet written to w.
+	err    error  // First write error; returned by all later calls.
 	endian Endian
 }
 
@@ -25,7 +35,23 @@ func NewEncoder(w io.Writer) *Encoder {
 		w:      w,
 		endian: LittleEndian,
 		buf:    make([]uint32, 12),
+		out:    make([]byte, 0, encoderBufferSize),
+	}
+}
+
+// Flush writes any buffered data to the underlying stream.
+func (e *Encoder) Flush() error {
+	if e.err != nil {
+		return e.err
 	}
+
+	if len(e.out) == 0 {
+		return nil
+	}
+
+	_, e.err = e.w.Write(e.out)
+	e.out = e.out[:0]
+	return e.err
 }
 
 // EncodeHeader writes the SPIR-V encoding of header h to the
@@ -35,32 +61,19 @@ func NewEncoder(w io.Writer) *Encoder {
 //
 // This assumes the header has been validated and is correct.
 func (e *Encoder) EncodeHeader(h Header) error {
-	// The magic value should be written byte-for-byte, regardless
-	// of the endianess. Infact, its byte order defines the byte order
-	// for the remaining stream.
+	if e.err != nil {
+		return e.err
+	}
+
+	e.out = appendHeader(e.out, h)
+
 	if h.Magic == MagicLE {
 		e.endian = LittleEndian
 	} else {
 		e.endian = BigEndian
 	}
 
-	_, err := e.w.Write([]byte{
-		byte(h.Magic),
-		byte(h.Magic >> 8),
-		byte(h.Magic >> 16),
-		byte(h.Magic >> 24),
-	})
-
-	if err != nil {
-		return err
-	}
-
-	return e.write([]uint32{
-		h.Version,
-		h.GeneratorMagic,
-		h.Bound,
-		h.Reserved,
-	})
+	return nil
 }
 
 // EncodeInstructionWords writes the SPIR-V encoding of the given instruction
@@ -94,10 +107,7 @@ func (e *Encoder) EncodeInstruction(i Instruction) error {
 	}
 
 	// Encode the instruction arguments.
-	rv := reflect.ValueOf(i)
-	rv = reflect.Indirect(rv)
-
-	argc, err := encodeValue(rv, e.buf[1:])
+	argc, err := encodeOperands(i, e.buf[1:])
 	if err != nil {
 		return err
 	}
@@ -105,7 +115,7 @@ func (e *Encoder) EncodeInstruction(i Instruction) error {
 	argc++
 
 	// Set the first instruction word.
-	e.buf[0] = EncodeOpcode(argc, i.Opcode())
+	e.buf[0] = EncodeOpcode(uint32(argc), i.Opcode())
 
 	// Write the words to the underlying stream.
 	return e.EncodeInstructionWords(e.buf[:argc])
@@ -114,40 +124,73 @@ func (e *Encoder) EncodeInstruction(i Instruction) error {
 // Write writes exactly len(p) words to the underlying stream.
 // It returns an error if this failed.
 func (e *Encoder) write(p []uint32) error {
-	var buf [4]byte
-
-	for _, word := range p {
-		if e.endian == LittleEndian {
-			buf[0] = byte(word)
-			buf[1] = byte(word >> 8)
-			buf[2] = byte(word >> 16)
-			buf[3] = byte(word >> 24)
-		} else {
-			buf[0] = byte(word >> 24)
-			buf[1] = byte(word >> 16)
-			buf[2] = byte(word >> 8)
-			buf[3] = byte(word)
-		}
+	if e.err != nil {
+		return e.err
+	}
 
-		_, err := e.w.Write(buf[:])
-		if err != nil {
-			return err
-		}
+	e.out = appendWords(e.out, e.endian, p)
+
+	if len(e.out) >= encoderBufferSize {
+		return e.Flush()
 	}
 
 	return nil
 }
 
+// appendHeader appends the encoding of header h to b. The magic value
+// determines the byte order of the remaining header fields.
+func appendHeader(b []byte, h Header) []byte {
+	// The magic value should be written byte-for-byte, regardless
+	// of the endianess. Infact, its byte order defines the byte order
+	// for the remaining stream.
+	endian := BigEndian
+	if h.Magic == MagicLE {
+		endian = LittleEndian
+	}
+
+	b = append(b,
+		byte(h.Magic),
+		byte(h.Magic>>8),
+		byte(h.Magic>>16),
+		byte(h.Magic>>24),
+	)
+
+	return appendWords(b, endian, []uint32{
+		h.Version,
+		h.GeneratorMagic,
+		h.Bound,
+		h.Reserved,
+	})
+}
+
+// appendWords appends the given words to b, in the given byte order.
+func appendWords(b []byte, endian Endian, p []uint32) []byte {
+	if endian == LittleEndian {
+		for _, word := range p {
+			b = binary.LittleEndian.AppendUint32(b, word)
+		}
+	} else {
+		for _, word := range p {
+			b = binary.BigEndian.AppendUint32(b, word)
+		}
+	}
+
+	return b
+}
+
 // EncodedLen returns the number of words the given instruction
 // will occupy once encoded.
 func EncodedLen(i Instruction) int {
-	rv := reflect.ValueOf(i)
-	rv = reflect.Indirect(rv)
-	return encodedValueLen(rv) + 1
+	return operandLen(i) + 1
 }
 
 func encodedValueLen(rv reflect.Value) int {
 	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return 0
+		}
+		return encodedValueLen(rv.Elem())
 	case reflect.Struct:
 		return encodedStructLen(rv)
 	case reflect.Slice, reflect.Array:
@@ -163,8 +206,17 @@ func encodedValueLen(rv reflect.Value) int {
 func encodedStructLen(rv reflect.Value) int {
 	var len int
 
+	rt := rv.Type()
 	for i := 0; i < rv.NumField(); i++ {
-		len += encodedValueLen(rv.Field(i))
+		fv := rv.Field(i)
+
+		// Empty optional fields are not encoded at all.
+		tag := rt.Field(i).Tag.Get("spirv")
+		if hasFieldOption(tag, "optional") && valueIsNil(fv) {
+			continue
+		}
+
+		len += encodedValueLen(fv)
 	}
 
 	return len
@@ -182,6 +234,12 @@ func encodedSliceLen(rv reflect.Value) int {
 
 func encodeValue(rv reflect.Value, out []uint32) (uint32, error) {
 	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return 0, nil
+		}
+		return encodeValue(rv.Elem(), out)
+
 	case reflect.Struct:
 		return encodeStruct(rv, out)
 
@@ -236,6 +294,12 @@ func valueIsNil(rv reflect.Value) bool {
 
 	case reflect.Uint32:
 		return rv.Uint() == 0
+
+	case reflect.String:
+		return rv.Len() == 0
+
+	case reflect.Ptr:
+		return rv.IsNil()
 	}
 
 	return false
@@ -49,6 +49,9 @@ func TestEncodeWords(t *testing.T) {
 		var have bytes.Buffer
 		enc := NewEncoder(&have)
 		err := enc.EncodeInstructionWords(st.in)
+		if err == nil {
+			err = enc.Flush()
+		}
 
 		if err != nil {
 			if !reflect.DeepEqual(err, st.err) {
@@ -88,6 +91,26 @@ func TestEncodeHeader(t *testing.T) {
 			want: nil,
 			err:  ErrInvalidVersion,
 		},
+		{
+			in:   Header{MagicLE, Version16 + 0x00000100, 1, 255, 0},
+			want: nil,
+			err:  ErrInvalidVersion,
+		},
+		{
+			in:   Header{MagicLE, Version10 | 0x01000000, 1, 255, 0},
+			want: nil,
+			err:  ErrInvalidVersion,
+		},
+		{
+			in: Header{MagicLE, Version13, 1, 255, 0},
+			want: []byte{
+				0x03, 0x02, 0x23, 0x07,
+				0x00, 0x03, 0x01, 0x00,
+				0x01, 0x00, 0x00, 0x00,
+				0xff, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00,
+			},
+		},
 		{
 			in: Header{MagicLE, 99, 1, 255, 0},
 			want: []byte{
@@ -121,6 +144,9 @@ func TestEncodeHeader(t *testing.T) {
 		var have bytes.Buffer
 		enc := NewEncoder(&have)
 		err = enc.EncodeHeader(st.in)
+		if err == nil {
+			err = enc.Flush()
+		}
 		if err != nil {
 			if !reflect.DeepEqual(err, st.err) {
 				t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v",
@@ -135,3 +161,82 @@ func TestEncodeHeader(t *testing.T) {
 		}
 	}
 }
+
+// countingWriter counts the calls to Write.
+type countingWriter struct {
+	bytes.Buffer
+	writes int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	return w.Buffer.Write(p)
+}
+
+func TestEncoderBuffered(t *testing.T) {
+	mod := benchmarkModule(1 << 20)
+
+	var w countingWriter
+	err := mod.Save(&w)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if w.Len() != mod.EncodedLen() {
+		t.Fatalf("size mismatch:\nHave: %d\nWant: %d", w.Len(), mod.EncodedLen())
+	}
+
+	if max := w.Len()/encoderBufferSize + 1; w.writes > max {
+		t.Fatalf("expected at most %d writes; have %d", max, w.writes)
+	}
+}
+
+func TestModuleBytes(t *testing.T) {
+	mod := benchmarkModule(1 << 10)
+
+	for _, magic := range []uint32{MagicLE, MagicBE} {
+		mod.Header.Magic = magic
+
+		var want bytes.Buffer
+		err := mod.Save(&want)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		have, err := mod.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(have, want.Bytes()) {
+			t.Fatalf("magic %08x: Bytes does not match Save", magic)
+		}
+
+		prefix := []byte{1, 2, 3}
+		have, err = mod.AppendBinary(prefix)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(have[:3], prefix) || !bytes.Equal(have[3:], want.Bytes()) {
+			t.Fatalf("magic %08x: AppendBinary does not match Save", magic)
+		}
+	}
+}
+
+func TestModuleAppendBinaryAllocs(t *testing.T) {
+	mod := benchmarkModule(1 << 10)
+	buf := make([]byte, 0, mod.EncodedLen())
+
+	allocs := testing.AllocsPerRun(10, func() {
+		_, err := mod.AppendBinary(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// One for the scratch buffer.
+	if allocs > 1 {
+		t.Fatalf("expected at most 1 allocation; have %v", allocs)
+	}
+}
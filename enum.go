@@ -0,0 +1,112 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Enum is implemented by all enumerated operand types, like StorageClass
+// and MemoryAccess.
+type Enum interface {
+	Verify() error
+	String() string
+}
+
+// valueString returns the name of the given value of a value enum, as
+// it is spelled in the specification. Unknown values are formatted as
+// "Kind(value)".
+func valueString(kind string, v uint32) string {
+	if name, ok := enumNames[kind][v]; ok {
+		return name
+	}
+	return fmt.Sprintf("%s(%d)", kind, v)
+}
+
+// bitString returns the names of the bits set in the given value of a
+// bit enum, separated by "|". Unknown bits are formatted in hexadecimal.
+func bitString(kind string, v uint32) string {
+	names := enumNames[kind]
+
+	if v == 0 {
+		if name, ok := names[0]; ok {
+			return name
+		}
+		return "0"
+	}
+
+	var out []string
+	var unknown uint32
+
+	for bit := uint32(1); bit != 0; bit <<= 1 {
+		if v&bit == 0 {
+			continue
+		}
+
+		if name, ok := names[bit]; ok {
+			out = append(out, name)
+		} else {
+			unknown |= bit
+		}
+	}
+
+	if unknown != 0 {
+		out = append(out, fmt.Sprintf("0x%x", unknown))
+	}
+
+	return strings.Join(out, "|")
+}
+
+var (
+	enumValuesOnce sync.Once
+	enumValues     map[string]map[string]uint32
+)
+
+// EnumValue returns the value of the enumerant with the given name, for
+// the enum type with the given name. Names are spelled the same way as in
+// the specification. For example, "Function" for StorageClassFunction:
+//
+//	v, ok := spirv.EnumValue("StorageClass", "Function")
+//
+// For bit enums, like MemoryAccess, several names can be combined with
+// "|". Returns false if the type or any of the names is unknown.
+func EnumValue(kind, name string) (uint32, bool) {
+	enumValuesOnce.Do(func() {
+		enumValues = make(map[string]map[string]uint32)
+
+		for kind, names := range enumNames {
+			values := make(map[string]uint32)
+			for v, name := range names {
+				values[name] = v
+			}
+			for name, v := range enumAliases[kind] {
+				values[name] = v
+			}
+			enumValues[kind] = values
+		}
+	})
+
+	values, ok := enumValues[kind]
+	if !ok {
+		return 0, false
+	}
+
+	if !bitEnums[kind] {
+		v, ok := values[name]
+		return v, ok
+	}
+
+	var mask uint32
+	for _, part := range strings.Split(name, "|") {
+		v, ok := values[part]
+		if !ok {
+			return 0, false
+		}
+		mask |= v
+	}
+
+	return mask, true
+}
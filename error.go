@@ -13,8 +13,10 @@ var (
 	ErrInvalidInstructionSize = errors.New("instruction has invalid size")
 	ErrMissingInstructionArgs = errors.New("insufficient instruction arguments")
 	ErrUnacceptable           = errors.New("use of this instruction is not allowed")
-	ErrInstructionNotPointer  = errors.New("value from Codec.New is not a pointer type")
+	ErrUnknownInstruction     = errors.New("unknown instruction")
+	ErrInstructionNotPointer  = errors.New("instruction constructor does not yield a pointer type")
 	ErrDuplicateInstruction   = errors.New("duplicate opcode being registered")
+	ErrDuplicateExtInstSet    = errors.New("duplicate extended instruction set being registered")
 	ErrInvalidMagicValue      = errors.New("Header: invalid magic value")
 	ErrInvalidVersion         = errors.New("Header: invalid version number")
 	ErrMemoryModel            = errors.New("a module must define one and only one OpMemoryModel")
@@ -25,7 +27,13 @@ var (
 // LayoutError defines an error in a module's structural layout.
 type LayoutError struct {
 	Msg     string
-	Address int
+	Address int // Index of the offending instruction in Module.Code.
+
+	// Opcode and Section are set for instructions which appear out of
+	// order. Section is the section the instruction was expected to
+	// belong to, given the instructions preceding it.
+	Opcode  uint32
+	Section Section
 }
 
 // NewLayoutError creates a new layout error for the given address and
@@ -37,6 +45,63 @@ func NewLayoutError(addr int, msg string, argv ...interface{}) *LayoutError {
 	}
 }
 
+// newSectionError creates a new layout error for an instruction which
+// does not belong in the given section.
+func newSectionError(addr int, instr Instruction, section Section, msg string, argv ...interface{}) *LayoutError {
+	return &LayoutError{
+		Msg:     fmt.Sprintf(msg, argv...),
+		Address: addr,
+		Opcode:  instr.Opcode(),
+		Section: section,
+	}
+}
+
 func (e *LayoutError) Error() string {
+	if e.Section != SectionUnknown {
+		return fmt.Sprintf("at $%08x: %s; expected %s section", e.Address, e.Msg, e.Section)
+	}
 	return fmt.Sprintf("at $%08x: %s", e.Address, e.Msg)
 }
+
+// DecodeError defines an error which occurred while decoding a module.
+// It describes where in the binary data the problem was found and
+// wraps the underlying cause, which can be examined with errors.Is
+// and errors.As.
+type DecodeError struct {
+	Offset int    // Offset of the instruction in words, from the start of the module.
+	Index  int    // Index of the instruction in the module; -1 for the header.
+	Opcode uint32 // Opcode of the instruction, if its first word could be read.
+	Name   string // Name of the instruction, if the opcode is known.
+	Err    error  // The underlying error.
+}
+
+func (e *DecodeError) Error() string {
+	switch {
+	case e.Index < 0:
+		return fmt.Sprintf("at word %d, header: %v", e.Offset, e.Err)
+	case e.Name != "":
+		return fmt.Sprintf("at word %d, instruction %d (%s): %v",
+			e.Offset, e.Index, e.Name, e.Err)
+	case e.Opcode != 0:
+		return fmt.Sprintf("at word %d, instruction %d (opcode %d): %v",
+			e.Offset, e.Index, e.Opcode, e.Err)
+	}
+
+	return fmt.Sprintf("at word %d, instruction %d: %v", e.Offset, e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// LimitError defines an error which occurs when a decoder exceeds one
+// of the limits set in its DecoderOptions.
+type LimitError struct {
+	Limit string // Name of the exceeded limit in DecoderOptions.
+	Max   int    // Value of the exceeded limit.
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("decoder limit exceeded: %s is %d", e.Limit, e.Max)
+}
@@ -0,0 +1,223 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ExtInstruction defines an instruction from an extended instruction set.
+// These are not encoded by themselves, but make up the operands of an
+// OpExtInst instruction.
+type ExtInstruction interface {
+	Verifiable
+	OperandCodec
+
+	// Opcode returns the opcode of the instruction within its set.
+	Opcode() uint32
+}
+
+// ExtInstSet defines an extended instruction set, as imported by a module
+// through OpExtInstImport.
+//
+// The sets defined in packages glslstd450 and openclstd register themselves
+// when imported. Other sets can be added with RegisterExtInstSet.
+type ExtInstSet interface {
+	// Name returns the name by which modules import the set.
+	Name() string
+
+	// Lookup returns the constructor for the instruction with the
+	// given opcode.
+	Lookup(opcode uint32) (func() ExtInstruction, bool)
+
+	// Opcodes returns a sorted list of all opcodes in the set.
+	Opcodes() []int
+}
+
+var (
+	extInstSetLock sync.RWMutex
+	extInstSets    = make(map[string]ExtInstSet)
+)
+
+// RegisterExtInstSet makes the given extended instruction set available
+// to Module.ExtInst. Returns ErrDuplicateExtInstSet if there already is
+// a set registered with the same name.
+func RegisterExtInstSet(set ExtInstSet) error {
+	extInstSetLock.Lock()
+	defer extInstSetLock.Unlock()
+
+	if _, ok := extInstSets[set.Name()]; ok {
+		return ErrDuplicateExtInstSet
+	}
+
+	extInstSets[set.Name()] = set
+	return nil
+}
+
+// LookupExtInstSet returns the extended instruction set registered
+// under the given name.
+func LookupExtInstSet(name string) (ExtInstSet, bool) {
+	extInstSetLock.RLock()
+	set, ok := extInstSets[name]
+	extInstSetLock.RUnlock()
+	return set, ok
+}
+
+// ExtInst resolves the given OpExtInst instruction into its typed form.
+// The extended instruction set is found through the OpExtInstImport
+// instruction in m, which defines the Id referenced by i.Set.
+//
+// Returns an error if the set is not imported or registered, or if the
+// operands of i do not match the instruction.
+func (m *Module) ExtInst(i *OpExtInst) (ExtInstruction, error) {
+	name, ok := m.extInstImport(i.Set)
+	if !ok {
+		return nil, fmt.Errorf("OpExtInst: Set(%d) is not imported", i.Set)
+	}
+
+	set, ok := LookupExtInstSet(name)
+	if !ok {
+		return nil, fmt.Errorf("OpExtInst: unknown extended instruction set %q", name)
+	}
+
+	return DecodeExtInst(set, i)
+}
+
+// DecodeExtInst decodes the operands of the given OpExtInst instruction
+// into an instruction from the given set.
+//
+// Returns an error if the instruction is unknown to the set, if the
+// operands do not match it, or if the decoded instruction does not verify.
+func DecodeExtInst(set ExtInstSet, i *OpExtInst) (ExtInstruction, error) {
+	ext, err := decodeExtInstOperands(set, i)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ext.Verify()
+	if err != nil {
+		return nil, err
+	}
+
+	return ext, nil
+}
+
+// decodeExtInstOperands is DecodeExtInst, without verifying the decoded
+// instruction. It is used where only the operands are of interest.
+func decodeExtInstOperands(set ExtInstSet, i *OpExtInst) (ExtInstruction, error) {
+	fun, ok := set.Lookup(i.Instruction)
+	if !ok {
+		return nil, fmt.Errorf("OpExtInst: unknown %s instruction %d",
+			set.Name(), i.Instruction)
+	}
+
+	argv := make([]uint32, len(i.Operands))
+	for j, id := range i.Operands {
+		argv[j] = uint32(id)
+	}
+
+	ext := fun()
+	name := reflect.Indirect(reflect.ValueOf(ext)).Type().Name()
+
+	err := ext.DecodeOperands(argv)
+	if err != nil {
+		return nil, fmt.Errorf("OpExtInst %s: %v", name, err)
+	}
+
+	if ext.OperandLen() != len(argv) {
+		return nil, fmt.Errorf("OpExtInst %s: expected %d operands; have %d",
+			name, ext.OperandLen(), len(argv))
+	}
+
+	return ext, nil
+}
+
+// EncodeExtInst returns an OpExtInst instruction, which invokes the given
+// instruction from the set imported as set.
+func EncodeExtInst(resultType, resultId, set Id, ext ExtInstruction) *OpExtInst {
+	argv := make([]uint32, ext.OperandLen())
+	ext.EncodeOperands(argv)
+
+	return &OpExtInst{
+		ResultType:  resultType,
+		ResultId:    resultId,
+		Set:         set,
+		Instruction: ext.Opcode(),
+		Operands:    decodeIds(argv),
+	}
+}
+
+// extInstImport returns the name of the extended instruction set,
+// imported with the given result Id.
+func (m *Module) extInstImport(set Id) (string, bool) {
+	for _, instr := range m.Code.Filter(opcodeExtInstImport) {
+		imp, ok := instr.(*OpExtInstImport)
+		if ok && imp.ResultId == set {
+			return string(imp.Name), true
+		}
+	}
+
+	return "", false
+}
+
+// extInstSets returns the registered extended instruction sets imported
+// by the module, indexed by the result Id of their OpExtInstImport.
+func (m *Module) extInstSets() map[Id]ExtInstSet {
+	sets := make(map[Id]ExtInstSet)
+
+	for _, instr := range m.Code.Filter(opcodeExtInstImport) {
+		imp, ok := instr.(*OpExtInstImport)
+		if !ok {
+			continue // Raw instruction.
+		}
+
+		if set, ok := LookupExtInstSet(string(imp.Name)); ok {
+			sets[imp.ResultId] = set
+		}
+	}
+
+	return sets
+}
+
+// verifyExtInsts ensures all OpExtInst instructions which use a registered
+// extended instruction set, are valid instructions of that set. Imports of
+// sets which are not registered yield a warning.
+func (m *Module) verifyExtInsts(v *verifier) {
+	sets := m.extInstSets()
+
+	for _, addr := range m.Code.FilterIndex(opcodeExtInstImport, 0) {
+		imp, ok := m.Code[addr].(*OpExtInstImport)
+		if !ok {
+			continue // Raw instruction.
+		}
+
+		if _, ok := sets[imp.ResultId]; !ok {
+			v.warn(addr, NewLayoutError(addr, "extended instruction set %q is not registered; its instructions are not checked",
+				string(imp.Name)))
+		}
+	}
+
+	if len(sets) == 0 {
+		return
+	}
+
+	for _, addr := range m.Code.FilterIndex(opcodeExtInst, 0) {
+		ei, ok := m.Code[addr].(*OpExtInst)
+		if !ok {
+			continue // Raw instruction.
+		}
+
+		set, ok := sets[ei.Set]
+		if !ok {
+			continue
+		}
+
+		_, err := DecodeExtInst(set, ei)
+		if err != nil && !v.report(NewLayoutError(addr, "%v", err)) {
+			return
+		}
+	}
+}
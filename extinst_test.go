@@ -0,0 +1,105 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// testExtNegate is the single instruction in testExtSet.
+type testExtNegate struct {
+	X Id
+}
+
+func (c *testExtNegate) Opcode() uint32  { return 1 }
+func (c *testExtNegate) Verify() error   { return nil }
+func (c *testExtNegate) OperandLen() int { return 1 }
+
+func (c *testExtNegate) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.X)
+	return 1
+}
+
+func (c *testExtNegate) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.X = Id(argv[0])
+	return nil
+}
+
+// testExtSet is an extended instruction set, used for testing.
+type testExtSet struct{}
+
+func (testExtSet) Name() string   { return "Test.ext" }
+func (testExtSet) Opcodes() []int { return []int{1} }
+
+func (testExtSet) Lookup(opcode uint32) (func() ExtInstruction, bool) {
+	if opcode != 1 {
+		return nil, false
+	}
+
+	return func() ExtInstruction { return &testExtNegate{} }, true
+}
+
+func init() {
+	RegisterExtInstSet(testExtSet{})
+}
+
+func TestRegisterExtInstSet(t *testing.T) {
+	err := RegisterExtInstSet(testExtSet{})
+	if err != ErrDuplicateExtInstSet {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", err, ErrDuplicateExtInstSet)
+	}
+}
+
+func TestModuleExtInst(t *testing.T) {
+	mod := NewModule()
+	mod.Code = []Instruction{
+		&OpExtInstImport{ResultId: 1, Name: "Test.ext"},
+		&OpExtInstImport{ResultId: 2, Name: "Unknown.ext"},
+		&OpExtInst{ResultType: 3, ResultId: 4, Set: 1, Instruction: 1, Operands: []Id{5}},
+		&OpExtInst{ResultType: 3, ResultId: 6, Set: 2, Instruction: 1, Operands: []Id{5}},
+		&OpExtInst{ResultType: 3, ResultId: 7, Set: 8, Instruction: 1},
+	}
+
+	have, err := mod.ExtInst(mod.Code[2].(*OpExtInst))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &testExtNegate{X: 5}
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("resolve mismatch:\nHave: %+v\nWant: %+v", have, want)
+	}
+
+	_, err = mod.ExtInst(mod.Code[3].(*OpExtInst))
+	wantErr := fmt.Errorf("OpExtInst: unknown extended instruction set %q", "Unknown.ext")
+	if !reflect.DeepEqual(err, wantErr) {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", err, wantErr)
+	}
+
+	_, err = mod.ExtInst(mod.Code[4].(*OpExtInst))
+	wantErr = fmt.Errorf("OpExtInst: Set(%d) is not imported", 8)
+	if !reflect.DeepEqual(err, wantErr) {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", err, wantErr)
+	}
+
+	// Instructions from unknown sets are not checked.
+	err = mod.check((*Module).verifyExtInsts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mod.Code[2].(*OpExtInst).Operands = []Id{5, 6}
+
+	err = mod.check((*Module).verifyExtInsts)
+	wantErr = NewLayoutError(2, "OpExtInst testExtNegate: expected 1 operands; have 2")
+	if !reflect.DeepEqual(err, wantErr) {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", err, wantErr)
+	}
+}
@@ -0,0 +1,73 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// FuzzLoad ensures loading arbitrary data never panics. Modules which do
+// load, must also survive encoding and verification.
+//
+// The seed corpus lives in testdata/fuzz/FuzzLoad. Run the fuzzer with:
+//
+//	go test -fuzz=FuzzLoad
+func FuzzLoad(f *testing.F) {
+	data, err := os.ReadFile("testdata/test.spirv")
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	f.Add(data)
+
+	data, err = benchmarkModule(1 << 8).Bytes()
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	f.Add(data)
+
+	opts := DecoderOptions{
+		MaxModuleSize:   1 << 20,
+		MaxInstructions: 1 << 14,
+		MaxStringLength: 1 << 10,
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, keepRaw := range []bool{false, true} {
+			opts.KeepRaw = keepRaw
+
+			dec := NewDecoderBytes(data)
+			dec.Options = opts
+			memMod, memErr := dec.DecodeModule()
+
+			mod, err := LoadWithOptions(bytes.NewReader(data), opts)
+
+			// Stream and in-memory decoding must agree on whether the
+			// data is valid. They may disagree on the cause, as streams
+			// report trailing partial words differently.
+			if (err == nil) != (memErr == nil) {
+				t.Fatalf("decoder mismatch:\nStream: %v\nMemory: %v", err, memErr)
+			}
+
+			if err != nil {
+				continue
+			}
+
+			if len(mod.Code) != len(memMod.Code) {
+				t.Fatalf("instruction count mismatch:\nStream: %d\nMemory: %d",
+					len(mod.Code), len(memMod.Code))
+			}
+
+			mod.Verify()
+
+			_, err = mod.Bytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
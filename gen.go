@@ -0,0 +1,1261 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+//go:build ignore
+
+// This program generates the instruction set, operand codecs and operand
+// kinds of package spirv from the machine-readable SPIR-V grammar published by Khronos.
+// It is invoked through go generate:
+//
+//	go generate github.com/andreas-jonsson/spirv
+//
+// The grammar itself does not describe what instructions and operand kinds
+// mean, so their documentation is read from a separate file. Instruction
+// methods which are written by hand in this package are detected and left
+// out of the generated code. This allows an instruction to carry custom
+// validation logic in its Verify method.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = `// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
+package spirv
+
+`
+
+// Grammar defines the parts of spirv.core.grammar.json we care about.
+type Grammar struct {
+	MajorVersion int           `json:"major_version"`
+	MinorVersion int           `json:"minor_version"`
+	Instructions []Instruction `json:"instructions"`
+	OperandKinds []OperandKind `json:"operand_kinds"`
+}
+
+// Instruction defines a single instruction in the grammar.
+type Instruction struct {
+	Opname   string    `json:"opname"`
+	Class    string    `json:"class"`
+	Opcode   int       `json:"opcode"`
+	Operands []Operand `json:"operands"`
+	Version  string    `json:"version"`
+}
+
+// Operand defines a single instruction operand in the grammar.
+type Operand struct {
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Quantifier string `json:"quantifier"`
+}
+
+// OperandKind defines a kind of operand.
+type OperandKind struct {
+	Category   string      `json:"category"`
+	Kind       string      `json:"kind"`
+	Enumerants []Enumerant `json:"enumerants"`
+}
+
+// Enumerant defines a single value of an enumerated operand kind.
+type Enumerant struct {
+	Enumerant  string          `json:"enumerant"`
+	Value      json.RawMessage `json:"value"`
+	Parameters []Operand       `json:"parameters"`
+	Version    string          `json:"version"`
+}
+
+// value returns the numeric value of the enumerant.
+// Bit enums define these as hexadecimal strings.
+func (e *Enumerant) value() uint32 {
+	var s string
+	if json.Unmarshal(e.Value, &s) != nil {
+		s = string(e.Value)
+	}
+
+	v, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		fatal("enumerant %s: %v", e.Enumerant, err)
+	}
+
+	return uint32(v)
+}
+
+// Docs holds the documentation which is not part of the grammar.
+type Docs struct {
+	Instructions map[string]string            `json:"instructions"`
+	Operands     map[string]map[string]string `json:"operands"`
+	OperandKinds map[string]string            `json:"operand_kinds"`
+
+	// Instruction documentation per extended instruction set.
+	ExtInstructions map[string]map[string]string `json:"ext_instructions"`
+}
+
+// removedExtInstructions lists the extended instructions which were removed
+// from their instruction set, per set. Their Verify method always fails.
+var removedExtInstructions = map[string]map[string]bool{
+	"GLSL.std.450": {"IMix": true},
+}
+
+// files maps instruction classes to the file holding them.
+var files = map[string]string{
+	"Miscellaneous":          "miscellaneous",
+	"Debug":                  "debug",
+	"Annotation":             "annotations",
+	"Extension":              "extension",
+	"Mode-Setting":           "modesetting",
+	"Type-Declaration":       "typedeclaration",
+	"Constant-Creation":      "constantcreation",
+	"Memory":                 "memory",
+	"Function":               "functions",
+	"Image":                  "image",
+	"Conversion":             "conversion",
+	"Composite":              "composite",
+	"Arithmetic":             "arithmetic",
+	"Bit":                    "bit",
+	"Relational_and_Logical": "relational",
+	"Derivative":             "derivative",
+	"Control-Flow":           "flowcontrol",
+	"Atomic":                 "atomic",
+	"Primitive":              "primitive",
+	"Barrier":                "barrier",
+	"Group":                  "group",
+	"Non-Uniform":            "nonuniform",
+	"Pipe":                   "pipe",
+	"Device-Side_Enqueue":    "devicesideenqueue",
+}
+
+// fieldNames overrides the field names derived from operand names
+// which are too unwieldy to be used as-is.
+var fieldNames = map[string]string{
+	"Operand 1, +\nOperand 2, +\n...":               "Operands",
+	"Member 0 type, +\nmember 1 type, +\n...":       "Members",
+	"Parameter 0 Type, +\nParameter 1 Type, +\n...": "Parameters",
+	"Argument 0, +\nArgument 1, +\n...":             "Argv",
+	"Variable, Parent, ...":                         "Operands",
+	"Indexes":                                       "Indices",
+	"The name of the opaque type.":                  "Name",
+	"Opcode":                                        "Operation",
+}
+
+// Operand kinds whose values select additional operands. These operands
+// are collected in an Argv field following the kind's own field.
+var paramKinds = map[string]bool{
+	"Decoration":    true,
+	"ExecutionMode": true,
+	"ImageOperands": true,
+	"LoopControl":   true,
+	"MemoryAccess":  true,
+}
+
+// extInstSets lists the extended instruction sets, the grammar file
+// describing them and the package holding their instructions.
+var extInstSets = []struct {
+	name, grammar, pkg string
+}{
+	{"GLSL.std.450", "extinst.glsl.std.450.grammar.json", "glslstd450"},
+	{"OpenCL.std", "extinst.opencl.std.100.grammar.json", "openclstd"},
+}
+
+// Instruction classes which have no semantic impact on a module.
+var optionalClasses = map[string]bool{
+	"Debug": true,
+}
+
+var (
+	grammarFile = flag.String("grammar", "grammar/spirv.core.grammar.json", "Path to the SPIR-V core grammar.")
+	docsFile    = flag.String("docs", "grammar/docs.json", "Path to the grammar documentation.")
+	outDir      = flag.String("out", ".", "Output directory.")
+)
+
+func main() {
+	flag.Parse()
+
+	var g Grammar
+	readJSON(*grammarFile, &g)
+
+	var d Docs
+	readJSON(*docsFile, &d)
+
+	methods := handWritten(*outDir)
+
+	writeInstructions(&g, &d, methods)
+	writeCodecs(&g, methods)
+	writeOpcodes(&g)
+	writeOperandKinds(&g, &d)
+
+	for _, set := range extInstSets {
+		writeExtInstSet(set.name, set.grammar, set.pkg, &d)
+	}
+}
+
+// writeInstructions writes the instruction structures, one file per
+// instruction class.
+func writeInstructions(g *Grammar, d *Docs, methods map[string]bool) {
+	classes := make(map[string][]*Instruction)
+	var order []string
+
+	for i := range g.Instructions {
+		ins := &g.Instructions[i]
+
+		file, ok := files[ins.Class]
+		if !ok {
+			fatal("%s: unknown instruction class %q", ins.Opname, ins.Class)
+		}
+
+		if _, ok := classes[file]; !ok {
+			order = append(order, file)
+		}
+
+		classes[file] = append(classes[file], ins)
+	}
+
+	for _, file := range order {
+		var body bytes.Buffer
+
+		for _, ins := range classes[file] {
+			writeInstruction(&body, ins, d, methods)
+		}
+
+		fmt.Fprintf(&body, "func init() {\n")
+		for _, ins := range classes[file] {
+			fmt.Fprintf(&body, "\tbind(func() Instruction { return &%s{} })\n", ins.Opname)
+		}
+		fmt.Fprintf(&body, "}\n")
+
+		var out bytes.Buffer
+		out.WriteString(header)
+		if bytes.Contains(body.Bytes(), []byte("fmt.")) {
+			out.WriteString("import \"fmt\"\n\n")
+		}
+		out.Write(body.Bytes())
+
+		writeSource("instructions_"+file+".go", out.Bytes())
+	}
+}
+
+// writeInstruction writes the structure and methods for a single instruction.
+func writeInstruction(w *bytes.Buffer, ins *Instruction, d *Docs, methods map[string]bool) {
+	name := ins.Opname
+
+	doc, ok := d.Instructions[name]
+	if !ok {
+		fatal("%s: missing documentation", name)
+	}
+
+	w.WriteString(comment("", doc))
+	if ins.Version != "" && ins.Version != "1.0" && ins.Version != "None" {
+		fmt.Fprintf(w, "//\n// This instruction requires SPIR-V %s or later.\n", ins.Version)
+	}
+
+	fields := instructionFields(ins)
+	if len(fields) == 0 {
+		fmt.Fprintf(w, "type %s struct{}\n\n", name)
+	} else {
+		fmt.Fprintf(w, "type %s struct {\n", name)
+
+		for i, f := range fields {
+			if doc, ok := d.Operands[name][f.name]; ok {
+				if i > 0 {
+					w.WriteString("\n")
+				}
+				w.WriteString(comment("\t", doc))
+			}
+
+			fmt.Fprintf(w, "\t%s %s %s\n", f.name, f.typ, f.tag)
+		}
+
+		fmt.Fprintf(w, "}\n\n")
+	}
+
+	short := strings.TrimPrefix(name, "Op")
+	fmt.Fprintf(w, "func (c *%s) Opcode() uint32 { return opcode%s }\n", name, short)
+	fmt.Fprintf(w, "func (c *%s) Optional() bool { return %v }\n", name, optionalClasses[ins.Class])
+
+	if !methods[name+".Verify"] {
+		writeVerify(w, ins)
+	}
+
+	w.WriteString("\n")
+}
+
+// writeVerify writes the Verify method for instructions which do not
+// define one by hand. Only instructions which take image operands get
+// more than a trivial implementation.
+func writeVerify(w *bytes.Buffer, ins *Instruction) {
+	name := ins.Opname
+
+	for _, op := range ins.Operands {
+		if op.Kind != "ImageOperands" {
+			continue
+		}
+
+		if op.Quantifier != "?" {
+			fmt.Fprintf(w, "func (c *%s) Verify() error { return verifyImageOperands(%q, c.ImageOperands, len(c.Argv)) }\n", name, name)
+			return
+		}
+
+		fmt.Fprintf(w, `func (c *%s) Verify() error {
+	if c.ImageOperands == nil {
+		if len(c.Argv) > 0 {
+			return fmt.Errorf("%s: extraneous arguments without ImageOperands")
+		}
+
+		return nil
+	}
+
+	return verifyImageOperands(%q, *c.ImageOperands, len(c.Argv))
+}
+`, name, name, name)
+		return
+	}
+
+	fmt.Fprintf(w, "func (c *%s) Verify() error { return nil }\n", name)
+}
+
+// writeCodecs writes the operand codecs for all instructions. These spare
+// the encoder and decoder from walking each instruction through reflect.
+func writeCodecs(g *Grammar, methods map[string]bool) {
+	var out bytes.Buffer
+	out.WriteString(header)
+
+	for i := range g.Instructions {
+		ins := &g.Instructions[i]
+		if methods[ins.Opname+".DecodeOperands"] {
+			continue
+		}
+
+		fields := instructionFields(ins)
+		writeOperandLen(&out, ins.Opname, fields)
+		writeEncodeOperands(&out, ins.Opname, fields)
+		writeDecodeOperands(&out, ins.Opname, fields, "")
+	}
+
+	writeSource("instructions_codec.go", out.Bytes())
+}
+
+// writeExtInstSet writes the instructions of an extended instruction set
+// into its own package. Each instruction is named after its opname, minus
+// underscores, and its opcode is available as a constant with prefix Op.
+func writeExtInstSet(name, grammar, pkg string, d *Docs) {
+	file := filepath.Join(filepath.Dir(*grammarFile), grammar)
+
+	var g Grammar
+	readJSON(file, &g)
+
+	dir := filepath.Join(*outDir, pkg)
+	methods := handWritten(dir)
+
+	var body bytes.Buffer
+
+	fmt.Fprintf(&body, "// Opcodes of the instructions in this set.\nconst (\n")
+	for _, ins := range g.Instructions {
+		fmt.Fprintf(&body, "\tOp%s = %d\n", extInstName(ins.Opname), ins.Opcode)
+	}
+	fmt.Fprintf(&body, ")\n\n")
+
+	fmt.Fprintf(&body, "// opnames maps opcodes to the instruction names used by the grammar.\nvar opnames = map[uint32]string{\n")
+	for _, ins := range g.Instructions {
+		fmt.Fprintf(&body, "\tOp%s: %q,\n", extInstName(ins.Opname), ins.Opname)
+	}
+	fmt.Fprintf(&body, "}\n\n")
+
+	for i := range g.Instructions {
+		ins := &g.Instructions[i]
+		typ := extInstName(ins.Opname)
+
+		doc, ok := d.ExtInstructions[name][ins.Opname]
+		if !ok {
+			fatal("%s %s: missing documentation", name, ins.Opname)
+		}
+
+		// Types of package spirv must be qualified.
+		fields := instructionFields(ins)
+		for j, f := range fields {
+			elem := strings.TrimPrefix(f.typ, "[]")
+			if elem != "uint32" {
+				fields[j].typ = f.typ[:len(f.typ)-len(elem)] + "spirv." + elem
+			}
+		}
+
+		body.WriteString(comment("", doc))
+		fmt.Fprintf(&body, "type %s struct {\n", typ)
+		for _, f := range fields {
+			fmt.Fprintf(&body, "\t%s %s %s\n", f.name, f.typ, f.tag)
+		}
+		fmt.Fprintf(&body, "}\n\n")
+
+		fmt.Fprintf(&body, "func (c *%s) Opcode() uint32 { return Op%s }\n", typ, typ)
+
+		switch {
+		case methods[typ+".Verify"]:
+		case removedExtInstructions[name][ins.Opname]:
+			fmt.Fprintf(&body, "func (c *%s) Verify() error  { return spirv.ErrUnacceptable }\n", typ)
+		default:
+			writeExtVerify(&body, typ, fields)
+		}
+
+		body.WriteString("\n")
+		writeOperandLen(&body, typ, fields)
+		writeEncodeOperands(&body, typ, fields)
+		writeDecodeOperands(&body, typ, fields, "spirv.")
+	}
+
+	fmt.Fprintf(&body, "func init() {\n")
+	for _, ins := range g.Instructions {
+		fmt.Fprintf(&body, "\tbind(func() spirv.ExtInstruction { return &%s{} })\n",
+			extInstName(ins.Opname))
+	}
+	fmt.Fprintf(&body, "}\n")
+
+	var out bytes.Buffer
+	out.WriteString(strings.NewReplacer(
+		"grammar/spirv.core.grammar.json", "grammar/"+grammar,
+		"package spirv", "package "+pkg,
+	).Replace(header))
+	out.WriteString("import \"github.com/andreas-jonsson/spirv\"\n\n")
+	out.Write(body.Bytes())
+
+	writeSource(filepath.Join(pkg, "instructions.go"), out.Bytes())
+}
+
+// writeExtVerify writes the Verify method for an extended instruction.
+// Only operands with an enumerated type need verification.
+func writeExtVerify(w *bytes.Buffer, name string, fields []field) {
+	var enums []string
+	for _, f := range fields {
+		if f.typ != "uint32" && f.typ != "spirv.Id" && f.typ[0] != '[' {
+			enums = append(enums, f.name)
+		}
+	}
+
+	if len(enums) == 0 {
+		fmt.Fprintf(w, "func (c *%s) Verify() error { return nil }\n", name)
+		return
+	}
+
+	fmt.Fprintf(w, "func (c *%s) Verify() error {\n", name)
+	for _, f := range enums[:len(enums)-1] {
+		fmt.Fprintf(w, "\tif err := c.%s.Verify(); err != nil {\n\t\treturn err\n\t}\n", f)
+	}
+	fmt.Fprintf(w, "\treturn c.%s.Verify()\n}\n", enums[len(enums)-1])
+}
+
+// extInstName returns the Go name for the given extended instruction.
+// The OpenCL instructions are named in snake case.
+func extInstName(opname string) string {
+	var out string
+	for _, word := range strings.Split(opname, "_") {
+		if len(word) > 0 {
+			out += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return out
+}
+
+// fixedLen returns the number of leading fields which always occupy
+// exactly one word.
+func fixedLen(fields []field) int {
+	for i, f := range fields {
+		if f.tag != "" || f.typ == "String" || f.typ[0] == '[' || f.typ[0] == '*' {
+			return i
+		}
+	}
+
+	return len(fields)
+}
+
+// writeOperandLen writes the OperandLen method for an instruction.
+func writeOperandLen(w *bytes.Buffer, name string, fields []field) {
+	k := fixedLen(fields)
+	if k == len(fields) {
+		fmt.Fprintf(w, "func (c *%s) OperandLen() int { return %d }\n\n", name, k)
+		return
+	}
+
+	fmt.Fprintf(w, "func (c *%s) OperandLen() int {\n\t%s\n", name, counter(k))
+
+	for _, f := range fields[k:] {
+		switch {
+		case f.typ[0] == '[':
+			fmt.Fprintf(w, "\tn += len(c.%s)\n", f.name)
+		case f.typ == "String" && f.tag != "":
+			fmt.Fprintf(w, "\tif len(c.%s) > 0 {\n\t\tn += int(c.%s.EncodedLen())\n\t}\n", f.name, f.name)
+		case f.typ == "String":
+			fmt.Fprintf(w, "\tn += int(c.%s.EncodedLen())\n", f.name)
+		case f.typ[0] == '*':
+			fmt.Fprintf(w, "\tif c.%s != nil {\n\t\tn++\n\t}\n", f.name)
+		case f.tag != "":
+			fmt.Fprintf(w, "\tif c.%s != 0 {\n\t\tn++\n\t}\n", f.name)
+		default:
+			fmt.Fprintf(w, "\tn++\n")
+		}
+	}
+
+	fmt.Fprintf(w, "\treturn n\n}\n\n")
+}
+
+// writeEncodeOperands writes the EncodeOperands method for an instruction.
+func writeEncodeOperands(w *bytes.Buffer, name string, fields []field) {
+	k := fixedLen(fields)
+	if k == 0 && len(fields) == 0 {
+		fmt.Fprintf(w, "func (c *%s) EncodeOperands(out []uint32) int { return 0 }\n\n", name)
+		return
+	}
+
+	fmt.Fprintf(w, "func (c *%s) EncodeOperands(out []uint32) int {\n", name)
+
+	for i, f := range fields[:k] {
+		fmt.Fprintf(w, "\tout[%d] = %s\n", i, wordOf(f.typ, "c."+f.name))
+	}
+
+	if k == len(fields) {
+		fmt.Fprintf(w, "\treturn %d\n}\n\n", k)
+		return
+	}
+
+	fmt.Fprintf(w, "\t%s\n", counter(k))
+
+	for _, f := range fields[k:] {
+		switch {
+		case f.typ == "[]uint32":
+			fmt.Fprintf(w, "\tn += copy(out[n:], c.%s)\n", f.name)
+		case f.typ[0] == '[':
+			fmt.Fprintf(w, "\tfor _, v := range c.%s {\n\t\tout[n] = uint32(v)\n\t\tn++\n\t}\n", f.name)
+		case f.typ == "String" && f.tag != "":
+			fmt.Fprintf(w, "\tif len(c.%s) > 0 {\n\t\tn += c.%s.Encode(out[n:])\n\t}\n", f.name, f.name)
+		case f.typ == "String":
+			fmt.Fprintf(w, "\tn += c.%s.Encode(out[n:])\n", f.name)
+		case f.typ[0] == '*':
+			fmt.Fprintf(w, "\tif c.%s != nil {\n\t\tout[n] = uint32(*c.%s)\n\t\tn++\n\t}\n", f.name, f.name)
+		case f.tag != "":
+			fmt.Fprintf(w, "\tif c.%s != 0 {\n\t\tout[n] = %s\n\t\tn++\n\t}\n", f.name, wordOf(f.typ, "c."+f.name))
+		default:
+			fmt.Fprintf(w, "\tout[n] = %s\n\tn++\n", wordOf(f.typ, "c."+f.name))
+		}
+	}
+
+	fmt.Fprintf(w, "\treturn n\n}\n\n")
+}
+
+// writeDecodeOperands writes the DecodeOperands method for an instruction.
+// Its behaviour matches that of the reflection based decoder: optional
+// operands are only read if there are words left, while slices consume
+// all remaining words. Identifiers from package spirv are qualified
+// with q.
+func writeDecodeOperands(w *bytes.Buffer, name string, fields []field, q string) {
+	k := fixedLen(fields)
+	if len(fields) == 0 {
+		fmt.Fprintf(w, "func (c *%s) DecodeOperands(argv []uint32) error { return nil }\n\n", name)
+		return
+	}
+
+	fmt.Fprintf(w, "func (c *%s) DecodeOperands(argv []uint32) error {\n", name)
+
+	if k > 0 {
+		fmt.Fprintf(w, "\tif len(argv) < %d {\n\t\treturn %sErrMissingInstructionArgs\n\t}\n\n", k, q)
+		for i, f := range fields[:k] {
+			fmt.Fprintf(w, "\tc.%s = %s\n", f.name, valueOf(f.typ, fmt.Sprintf("argv[%d]", i)))
+		}
+
+		if k < len(fields) {
+			fmt.Fprintf(w, "\targv = argv[%d:]\n", k)
+		}
+	}
+
+	for i, f := range fields[k:] {
+		last := k+i == len(fields)-1
+		if i > 0 || k > 0 {
+			w.WriteString("\n")
+		}
+
+		switch {
+		case f.typ[0] == '[':
+			fn := q + "Copy"
+			if strings.HasSuffix(f.typ, "Id") {
+				fn = "decodeIds"
+			}
+
+			fmt.Fprintf(w, "\tc.%s = %s(argv)\n", f.name, fn)
+			if !last {
+				fmt.Fprintf(w, "\targv = nil\n")
+			}
+			continue
+
+		case f.tag != "":
+			fmt.Fprintf(w, "\tif len(argv) > 0 {\n")
+
+		default:
+			fmt.Fprintf(w, "\tif len(argv) == 0 {\n\t\treturn %sErrMissingInstructionArgs\n\t}\n", q)
+		}
+
+		indent := "\t"
+		if f.tag != "" {
+			indent = "\t\t"
+		}
+
+		switch {
+		case f.typ == "String" && last:
+			fmt.Fprintf(w, "%sc.%s, _ = decodeStringOperand(argv)\n", indent, f.name)
+		case f.typ == "String":
+			fmt.Fprintf(w, "%sc.%s, argv = decodeStringOperand(argv)\n", indent, f.name)
+		case f.typ[0] == '*':
+			fmt.Fprintf(w, "%sv := %s\n%sc.%s = &v\n", indent, valueOf(f.typ[1:], "argv[0]"), indent, f.name)
+		default:
+			fmt.Fprintf(w, "%sc.%s = %s\n", indent, f.name, valueOf(f.typ, "argv[0]"))
+		}
+
+		if f.typ != "String" && !last {
+			fmt.Fprintf(w, "%sargv = argv[1:]\n", indent)
+		}
+
+		if f.tag != "" {
+			fmt.Fprintf(w, "\t}\n")
+		}
+	}
+
+	fmt.Fprintf(w, "\treturn nil\n}\n\n")
+}
+
+// counter returns the declaration of the word counter, starting at k.
+func counter(k int) string {
+	if k == 0 {
+		return "var n int"
+	}
+	return fmt.Sprintf("n := %d", k)
+}
+
+// wordOf returns the expression converting a single word operand to uint32.
+func wordOf(typ, expr string) string {
+	if typ == "uint32" {
+		return expr
+	}
+	return "uint32(" + expr + ")"
+}
+
+// valueOf returns the expression converting a word to the given type.
+func valueOf(typ, expr string) string {
+	if typ == "uint32" {
+		return expr
+	}
+	return typ + "(" + expr + ")"
+}
+
+// field defines a single structure field.
+type field struct {
+	name string
+	typ  string
+	tag  string
+}
+
+const optionalTag = "`spirv:\"optional\"`"
+
+// instructionFields returns the structure fields for the given instruction.
+func instructionFields(ins *Instruction) []field {
+	var out []field
+
+	for _, op := range ins.Operands {
+		quant := op.Quantifier
+		base := ""
+
+		switch op.Kind {
+		case "IdResultType", "IdResult", "IdRef", "IdScope", "IdMemorySemantics":
+			base = "Id"
+		case "LiteralInteger", "LiteralExtInstInteger", "LiteralSpecConstantOpInteger":
+			base = "uint32"
+		case "LiteralString":
+			base = "String"
+		case "LiteralContextDependentNumber":
+			base = "[]uint32"
+		case "PairLiteralIntegerIdRef", "PairIdRefLiteralInteger":
+			base, quant = "[]uint32", ""
+		case "PairIdRefIdRef":
+			base, quant = "[]Id", ""
+		default:
+			base = op.Kind
+		}
+
+		f := field{name: fieldName(op), typ: base}
+
+		switch quant {
+		case "*":
+			f.typ = "[]" + base
+		case "?":
+			// Enumerated operands are held by pointer, so an absent
+			// operand can be told apart from a zero value.
+			f.tag = optionalTag
+			if base != "Id" && base != "uint32" && base != "String" {
+				f.typ = "*" + base
+			}
+		}
+
+		out = append(out, f)
+
+		if paramKinds[op.Kind] {
+			out = append(out, argvField(ins.Opname, op))
+
+			// Memory operands for a second pointer follow those of the
+			// first. They all end up in the same Argv field.
+			if op.Kind == "MemoryAccess" {
+				break
+			}
+		}
+
+		// The operands of the specialized instruction follow its opcode.
+		if op.Kind == "LiteralSpecConstantOpInteger" {
+			out = append(out, field{name: "Operands", typ: "[]Id"})
+		}
+	}
+
+	return out
+}
+
+// argvField returns the field holding the extra operands selected by
+// the value of an operand with parameters.
+func argvField(opname string, op Operand) field {
+	f := field{name: "Argv", typ: "[]uint32"}
+
+	switch {
+	case strings.HasSuffix(opname, "String"):
+		f = field{name: "Value", typ: "String"}
+	case strings.HasSuffix(opname, "Id"), op.Kind == "ImageOperands":
+		f.typ = "[]Id"
+	}
+
+	if op.Quantifier == "?" || op.Kind == "ImageOperands" || op.Kind == "LoopControl" {
+		f.tag = optionalTag
+	}
+
+	return f
+}
+
+var nonAlnum = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// fieldName returns the Go field name for the given operand.
+func fieldName(op Operand) string {
+	name := strings.Replace(op.Name, "'", "", -1)
+
+	if name == "" {
+		switch op.Kind {
+		case "IdResultType":
+			return "ResultType"
+		case "IdResult":
+			return "ResultId"
+		}
+		return op.Kind
+	}
+
+	if v, ok := fieldNames[name]; ok {
+		return v
+	}
+
+	name = strings.Replace(name, "~", "", -1)
+
+	var out string
+	for _, word := range nonAlnum.Split(name, -1) {
+		if len(word) > 0 {
+			out += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	return out
+}
+
+// writeOpcodes writes the list of opcode constants.
+func writeOpcodes(g *Grammar) {
+	list := make([]*Instruction, len(g.Instructions))
+	for i := range g.Instructions {
+		list[i] = &g.Instructions[i]
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Opcode < list[j].Opcode
+	})
+
+	var out bytes.Buffer
+	out.WriteString(header)
+	fmt.Fprintf(&out, "// List of known opcodes, as of SPIR-V %d.%d.\n", g.MajorVersion, g.MinorVersion)
+	out.WriteString("const (\n")
+	for _, ins := range list {
+		fmt.Fprintf(&out, "\topcode%s = %d\n", strings.TrimPrefix(ins.Opname, "Op"), ins.Opcode)
+	}
+	out.WriteString(")\n")
+
+	writeSource("opcodes.go", out.Bytes())
+}
+
+// writeOperandKinds writes the enumerated operand kinds, along with
+// their validation logic.
+func writeOperandKinds(g *Grammar, d *Docs) {
+	var kinds []*OperandKind
+	for i := range g.OperandKinds {
+		k := &g.OperandKinds[i]
+		if k.Category == "ValueEnum" || k.Category == "BitEnum" {
+			kinds = append(kinds, k)
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header)
+
+	var body bytes.Buffer
+	body.WriteString("var (\n")
+	for _, k := range kinds {
+		fmt.Fprintf(&body, "\tErrInvalid%s = errors.New(\"invalid %s value\")\n", k.Kind, k.Kind)
+	}
+	body.WriteString(")\n\n")
+
+	body.WriteString(`// verifyBitFlag returns true if v is a valid bit flag in the
+// given range. This includes combinations of all possible values.
+func verifyBitFlag(v uint32, none bool, mask uint32) bool {
+	return v == (v&mask) && (none || v != 0)
+}
+
+`)
+
+	for _, k := range kinds {
+		doc, ok := d.OperandKinds[k.Kind]
+		if !ok {
+			fatal("%s: missing documentation", k.Kind)
+		}
+
+		// Aliases share a value. Refer to each value by its first name.
+		idents := make(map[uint32]string)
+		for i := range k.Enumerants {
+			e := &k.Enumerants[i]
+			if _, ok := idents[e.value()]; !ok {
+				idents[e.value()] = enumIdent(k.Kind, e.Enumerant)
+			}
+		}
+
+		fmt.Fprintf(&body, "type %s uint32\n\n", k.Kind)
+
+		if k.Category == "BitEnum" {
+			writeBitVerify(&body, k, idents)
+		} else {
+			writeValueVerify(&body, k, idents)
+		}
+
+		body.WriteString(comment("", doc))
+		body.WriteString("const (\n")
+		for i := range k.Enumerants {
+			e := &k.Enumerants[i]
+			v := e.value()
+
+			if k.Category == "BitEnum" && v != 0 {
+				fmt.Fprintf(&body, "\t%s = 0x%x\n", enumIdent(k.Kind, e.Enumerant), v)
+			} else {
+				fmt.Fprintf(&body, "\t%s = %d\n", enumIdent(k.Kind, e.Enumerant), v)
+			}
+		}
+		body.WriteString(")\n\n")
+
+		if paramKinds[k.Kind] {
+			writeArgc(&body, k)
+		}
+
+		if k.Category == "BitEnum" {
+			fmt.Fprintf(&body, "func (v %s) String() string { return bitString(%q, uint32(v)) }\n\n", k.Kind, k.Kind)
+		} else {
+			fmt.Fprintf(&body, "func (v %s) String() string { return valueString(%q, uint32(v)) }\n\n", k.Kind, k.Kind)
+		}
+	}
+
+	writeEnumNames(&body, kinds)
+
+	if bytes.Contains(body.Bytes(), []byte("bits.")) {
+		out.WriteString("import (\n\t\"errors\"\n\t\"math/bits\"\n)\n\n")
+	} else {
+		out.WriteString("import \"errors\"\n\n")
+	}
+
+	out.Write(body.Bytes())
+	writeSource("constant.go", out.Bytes())
+}
+
+// writeEnumNames writes the table of enumerant names, as they are spelled
+// in the grammar, for each operand kind. Aliases share a value; the
+// first name is used.
+func writeEnumNames(w *bytes.Buffer, kinds []*OperandKind) {
+	w.WriteString("// enumNames maps the values of each enum type to their names.\n")
+	w.WriteString("var enumNames = map[string]map[uint32]string{\n")
+
+	for _, k := range kinds {
+		fmt.Fprintf(w, "\t%q: {\n", k.Kind)
+
+		seen := make(map[uint32]bool)
+		for i := range k.Enumerants {
+			e := &k.Enumerants[i]
+			if !seen[e.value()] {
+				seen[e.value()] = true
+				fmt.Fprintf(w, "\t\t%s: %q,\n", enumIdent(k.Kind, e.Enumerant), e.Enumerant)
+			}
+		}
+
+		w.WriteString("\t},\n")
+	}
+
+	w.WriteString("}\n\n")
+
+	w.WriteString("// enumAliases maps the alternative names of enum values to their values.\n")
+	w.WriteString("var enumAliases = map[string]map[string]uint32{\n")
+
+	for _, k := range kinds {
+		var aliases []string
+
+		seen := make(map[uint32]bool)
+		for i := range k.Enumerants {
+			e := &k.Enumerants[i]
+			if seen[e.value()] {
+				aliases = append(aliases, fmt.Sprintf("\t\t%q: %s,\n", e.Enumerant, enumIdent(k.Kind, e.Enumerant)))
+			}
+			seen[e.value()] = true
+		}
+
+		if len(aliases) > 0 {
+			fmt.Fprintf(w, "\t%q: {\n%s\t},\n", k.Kind, strings.Join(aliases, ""))
+		}
+	}
+
+	w.WriteString("}\n\n")
+
+	w.WriteString("// bitEnums lists the enum types whose values are bit masks.\n")
+	w.WriteString("var bitEnums = map[string]bool{\n")
+	for _, k := range kinds {
+		if k.Category == "BitEnum" {
+			fmt.Fprintf(w, "\t%q: true,\n", k.Kind)
+		}
+	}
+	w.WriteString("}\n\n")
+}
+
+// writeBitVerify writes the Verify method for a bit enum.
+func writeBitVerify(w *bytes.Buffer, k *OperandKind, idents map[uint32]string) {
+	var mask []string
+	seen := make(map[uint32]bool)
+
+	for i := range k.Enumerants {
+		v := k.Enumerants[i].value()
+		if v != 0 && !seen[v] {
+			seen[v] = true
+			mask = append(mask, idents[v])
+		}
+	}
+
+	fmt.Fprintf(w, `func (v %s) Verify() error {
+	if verifyBitFlag(
+		uint32(v),
+		true,
+		%s,
+	) {
+		return nil
+	}
+	return ErrInvalid%s
+}
+
+`, k.Kind, strings.Join(mask, "|\n"), k.Kind)
+}
+
+// writeValueVerify writes the Verify method for a value enum.
+// It checks the value against the ranges of consecutive enumerant values.
+func writeValueVerify(w *bytes.Buffer, k *OperandKind, idents map[uint32]string) {
+	values := make([]uint32, 0, len(idents))
+	for v := range idents {
+		values = append(values, v)
+	}
+
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	var conds []string
+	for i := 0; i < len(values); {
+		lo := values[i]
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		hi := values[j]
+		i = j + 1
+
+		switch {
+		case lo == hi:
+			conds = append(conds, fmt.Sprintf("v == %s", idents[lo]))
+		case lo == 0:
+			conds = append(conds, fmt.Sprintf("v <= %s", idents[hi]))
+		default:
+			conds = append(conds, fmt.Sprintf("v >= %s && v <= %s", idents[lo], idents[hi]))
+		}
+	}
+
+	if len(conds) == 1 {
+		fmt.Fprintf(w, `func (v %s) Verify() error {
+	if %s {
+		return nil
+	}
+	return ErrInvalid%s
+}
+
+`, k.Kind, conds[0], k.Kind)
+		return
+	}
+
+	fmt.Fprintf(w, `func (v %s) Verify() error {
+	switch {
+	case %s:
+		return nil
+	}
+	return ErrInvalid%s
+}
+
+`, k.Kind, strings.Join(conds, ",\n"), k.Kind)
+}
+
+// writeArgc writes the argc method for an operand kind whose values
+// select additional operands.
+func writeArgc(w *bytes.Buffer, k *OperandKind) {
+	if k.Category == "BitEnum" {
+		// Group the bits by the number of operands they select.
+		masks := make(map[int][]string)
+		var counts []int
+
+		for i := range k.Enumerants {
+			e := &k.Enumerants[i]
+			n := len(e.Parameters)
+			if n == 0 || e.value() == 0 {
+				continue
+			}
+
+			if _, ok := masks[n]; !ok {
+				counts = append(counts, n)
+			}
+			masks[n] = append(masks[n], enumIdent(k.Kind, e.Enumerant))
+		}
+
+		sort.Ints(counts)
+
+		fmt.Fprintf(w, `// argc returns the number of extra operands which follow a %s
+// mask with these bits set.
+func (v %s) argc() int {
+`, k.Kind, k.Kind)
+
+		var terms []string
+		for _, n := range counts {
+			fmt.Fprintf(w, "\tconst argc%d = %s\n", n, wrapList(masks[n], " |", "\t\t"))
+
+			term := fmt.Sprintf("bits.OnesCount32(uint32(v)&argc%d)", n)
+			if n > 1 {
+				term = fmt.Sprintf("%d*%s", n, term)
+			}
+			terms = append(terms, term)
+		}
+
+		fmt.Fprintf(w, "\n\treturn %s\n}\n\n", strings.Join(terms, " + "))
+		return
+	}
+
+	// Values selecting <id> operands, or a single string, can not be
+	// carried by the plain literal operand list. They are grouped
+	// under -1.
+	cases := make(map[int][]string)
+	var counts []int
+
+	for i := range k.Enumerants {
+		e := &k.Enumerants[i]
+		n := len(e.Parameters)
+		if n == 0 {
+			continue
+		}
+
+		for _, p := range e.Parameters {
+			if strings.HasPrefix(p.Kind, "Id") {
+				n = -1
+			}
+		}
+
+		if n == 1 && e.Parameters[0].Kind == "LiteralString" {
+			n = -1
+		}
+
+		if _, ok := cases[n]; !ok {
+			counts = append(counts, n)
+		}
+		cases[n] = append(cases[n], enumIdent(k.Kind, e.Enumerant))
+	}
+
+	fmt.Fprintf(w, `// argc returns the number of extra operands which follow %s
+// value v. It returns -1 for values whose operands are <id>s or a string.
+func (v %s) argc() int {
+	switch v {
+`, article(k.Kind), k.Kind)
+
+	sort.Ints(counts)
+	for i := len(counts) - 1; i >= 0; i-- {
+		n := counts[i]
+		fmt.Fprintf(w, "\tcase %s:\n\t\treturn %d\n", wrapList(cases[n], ",", "\t\t"), n)
+	}
+
+	fmt.Fprintf(w, "\t}\n\treturn 0\n}\n\n")
+}
+
+// wrapList joins the given items with sep, breaking lines before they
+// exceed 72 columns. Continuation lines start with indent.
+func wrapList(items []string, sep, indent string) string {
+	var out, line string
+
+	for i, item := range items {
+		if i < len(items)-1 {
+			item += sep
+		}
+
+		if line != "" && len(line)+1+len(item) > 72 {
+			out += line + "\n" + indent
+			line = ""
+		}
+
+		if line != "" {
+			line += " "
+		}
+		line += item
+	}
+
+	return out + line
+}
+
+// article prefixes s with the appropriate indefinite article.
+func article(s string) string {
+	if strings.ContainsRune("AEIOU", rune(s[0])) {
+		return "an " + s
+	}
+	return "a " + s
+}
+
+// enumIdent returns the Go identifier for the given enumerant.
+func enumIdent(kind, name string) string {
+	parts := strings.Split(name, "_")
+
+	out := parts[0]
+	for _, p := range parts[1:] {
+		if len(p) > 0 {
+			out += strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+
+	if strings.HasPrefix(out, kind) {
+		return out
+	}
+
+	return kind + out
+}
+
+// comment turns the given text into a line comment, wrapped at 78 columns.
+// Explicit line breaks in the text are kept.
+func comment(indent, text string) string {
+	var out bytes.Buffer
+
+	for _, para := range strings.Split(text, "\n") {
+		line := "//"
+
+		for _, word := range strings.Fields(para) {
+			if len(line)+1+len(word) > 78 && line != "//" {
+				out.WriteString(indent + line + "\n")
+				line = "//"
+			}
+
+			line += " " + word
+		}
+
+		out.WriteString(indent + line + "\n")
+	}
+
+	return out.String()
+}
+
+// handWritten returns the set of methods defined on instruction types
+// in files which are not generated, as "Type.Method" keys.
+func handWritten(dir string) map[string]bool {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+
+	pkgs, err := parser.ParseDir(fset, dir, filter, parser.ParseComments)
+	if err != nil {
+		fatal("%v", err)
+	}
+
+	out := make(map[string]bool)
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			if ast.IsGenerated(file) {
+				continue
+			}
+
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+					continue
+				}
+
+				typ := fn.Recv.List[0].Type
+				if star, ok := typ.(*ast.StarExpr); ok {
+					typ = star.X
+				}
+
+				if ident, ok := typ.(*ast.Ident); ok {
+					out[ident.Name+"."+fn.Name.Name] = true
+				}
+			}
+		}
+	}
+
+	return out
+}
+
+// writeSource formats the given source and writes it to the named file
+// in the output directory.
+func writeSource(name string, src []byte) {
+	fmtd, err := format.Source(src)
+	if err != nil {
+		fatal("%s: %v", name, err)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(*outDir, name), fmtd, 0644)
+	if err != nil {
+		fatal("%v", err)
+	}
+}
+
+// readJSON decodes the given file into v.
+func readJSON(file string, v interface{}) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		fatal("%v", err)
+	}
+
+	err = json.Unmarshal(data, v)
+	if err != nil {
+		fatal("%s: %v", file, err)
+	}
+}
+
+func fatal(f string, argv ...interface{}) {
+	fmt.Fprintf(os.Stderr, "gen: "+f+"\n", argv...)
+	os.Exit(1)
+}
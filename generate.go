@@ -0,0 +1,10 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// The instruction set and operand kinds are generated from the SPIR-V
+// grammar. To update to a new revision of the specification, replace
+// grammar/spirv.core.grammar.json, describe any new instructions and
+// operand kinds in grammar/docs.json and run go generate.
+//go:generate go run gen.go
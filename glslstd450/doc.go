@@ -0,0 +1,24 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+/*
+Package glslstd450 holds the GLSL.std.450 extended instruction set.
+
+Shaders compiled from GLSL or HLSL import this set for most of their
+mathematical built-in functions. These are invoked through OpExtInst,
+which only carries the opcode of the extended instruction and a list of
+operands. Importing this package registers the set with package spirv,
+after which spirv.Module.ExtInst resolves such instructions into the types
+defined here:
+
+	ext, err := module.ExtInst(instr)
+	...
+
+	if mix, ok := ext.(*glslstd450.FMix); ok {
+		...
+	}
+
+Extended instructions are turned back into an OpExtInst instruction with
+spirv.EncodeExtInst.
+*/
+package glslstd450
@@ -0,0 +1,80 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package glslstd450
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andreas-jonsson/spirv"
+)
+
+func TestOpcodes(t *testing.T) {
+	have := Opcodes()
+	if len(have) != 81 || have[0] != OpRound || have[80] != OpNClamp {
+		t.Fatalf("unexpected opcodes: %v", have)
+	}
+
+	for _, opcode := range have {
+		fun, ok := Lookup(uint32(opcode))
+		if !ok {
+			t.Fatalf("missing instruction %d", opcode)
+		}
+
+		if fun().Opcode() != uint32(opcode) {
+			t.Fatalf("opcode mismatch for %T: %d", fun(), opcode)
+		}
+	}
+
+	if n, _ := OperandCount(OpFMix); n != 3 {
+		t.Fatalf("FMix operand count mismatch: %d", n)
+	}
+}
+
+func TestModuleExtInst(t *testing.T) {
+	mod := spirv.NewModule()
+	mod.Code = []spirv.Instruction{
+		&spirv.OpExtInstImport{ResultId: 1, Name: Name},
+		&spirv.OpExtInst{
+			ResultType:  2,
+			ResultId:    3,
+			Set:         1,
+			Instruction: OpFMix,
+			Operands:    []spirv.Id{4, 5, 6},
+		},
+		&spirv.OpExtInst{
+			ResultType:  2,
+			ResultId:    7,
+			Set:         1,
+			Instruction: OpSqrt,
+			Operands:    []spirv.Id{4, 5},
+		},
+	}
+
+	have, err := mod.ExtInst(mod.Code[1].(*spirv.OpExtInst))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &FMix{X: 4, Y: 5, A: 6}
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("resolve mismatch:\nHave: %+v\nWant: %+v", have, want)
+	}
+
+	if enc := spirv.EncodeExtInst(2, 3, 1, have); !reflect.DeepEqual(enc, mod.Code[1]) {
+		t.Fatalf("encode mismatch:\nHave: %+v\nWant: %+v", enc, mod.Code[1])
+	}
+
+	// Sqrt takes a single operand.
+	_, err = mod.ExtInst(mod.Code[2].(*spirv.OpExtInst))
+	if err == nil {
+		t.Fatalf("expected failure")
+	}
+
+	// IMix was removed from the set.
+	_, err = mod.ExtInst(spirv.EncodeExtInst(2, 8, 1, &IMix{X: 4, Y: 5, A: 6}))
+	if err != spirv.ErrUnacceptable {
+		t.Fatalf("error mismatch: have %v, want %v", err, spirv.ErrUnacceptable)
+	}
+}
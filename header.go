@@ -20,8 +20,26 @@ const (
 	MagicBE = 0x03022307
 )
 
+// Version numbers of the released specifications, as stored in a module
+// header. The major and minor version numbers are stored in the second and
+// third most significant bytes respectively.
+const (
+	Version10 = 0x00010000
+	Version11 = 0x00010100
+	Version12 = 0x00010200
+	Version13 = 0x00010300
+	Version14 = 0x00010400
+	Version15 = 0x00010500
+	Version16 = 0x00010600
+)
+
+// VersionPreRelease is the version number used by modules targeting the
+// provisional specification. Their instructions are defined in package
+// prerelease.
+const VersionPreRelease = 99
+
 // Version number of the specification this package was written for.
-const SpecificationVersion = 99
+const SpecificationVersion = Version16
 
 // Header defines the header of a SPIR-V Module.
 type Header struct {
@@ -33,8 +51,10 @@ type Header struct {
 	// byte stream back to a word stream.
 	Magic uint32
 
-	// Version number -- The first public version will be 100.
-	// Uses 99 for pre-release.
+	// Version number of the specification the module targets.
+	// For released versions, this holds the major version number in bits
+	// 16-23 and the minor version number in bits 8-15. Modules which target
+	// the provisional specification use 99.
 	Version uint32
 
 	// Generator’s magic number. It is associated with the tool that generated
@@ -58,9 +78,26 @@ func (h *Header) Verify() error {
 		return ErrInvalidMagicValue
 	}
 
-	if h.Version != SpecificationVersion {
+	if h.Version == VersionPreRelease {
+		return nil
+	}
+
+	// The low and high bytes are reserved and must be 0.
+	if h.Version&0xff0000ff != 0 || h.Version < Version10 || h.Version > SpecificationVersion {
 		return ErrInvalidVersion
 	}
 
 	return nil
 }
+
+// VersionMajor returns the major version number of the specification
+// targeted by the module. This is 0 for pre-release modules.
+func (h *Header) VersionMajor() int {
+	return int(h.Version>>16) & 0xff
+}
+
+// VersionMinor returns the minor version number of the specification
+// targeted by the module.
+func (h *Header) VersionMinor() int {
+	return int(h.Version>>8) & 0xff
+}
@@ -3,12 +3,315 @@
 
 package spirv
 
+import "reflect"
+
 // Id defines the a result id or result type for any instruction.
 type Id uint32
 
 // Verify returns an error if this is not a valid Id.
 //
-// TODO implement Id range checking.
+// Ids can only be range checked against the bound of the module they
+// belong to. This is done by Module.Verify.
 func (i Id) Verify() error {
 	return nil
 }
+
+// IdFunc is called for each Id operand found in a module.
+// The address is the index of the instruction holding the Id, field is
+// the name of the struct field holding it. The Id may be modified.
+type IdFunc func(addr int, field string, id *Id)
+
+var idType = reflect.TypeOf(Id(0))
+
+// forEachId calls fn for each Id operand of the given instruction, found
+// through its struct fields. Absent optional operands are skipped.
+func forEachId(i interface{}, fn func(field string, id *Id)) {
+	if v, ok := i.(*OpSpecConstantOp); ok {
+		fn("ResultType", &v.ResultType)
+		fn("ResultId", &v.ResultId)
+
+		for j := 0; j < specConstantOpIds(v); j++ {
+			fn("Operands", &v.Operands[j])
+		}
+		return
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(i))
+	if rv.Kind() != reflect.Struct {
+		return
+	}
+
+	rt := rv.Type()
+	for j := 0; j < rv.NumField(); j++ {
+		field := rt.Field(j)
+		optional := hasFieldOption(field.Tag.Get("spirv"), "optional")
+		forEachIdValue(rv.Field(j), field.Name, optional, fn)
+	}
+}
+
+// specConstantOpIds returns the number of leading Operands of the given
+// instruction which are Ids. The operations which take literal indices
+// follow their Ids with them.
+func specConstantOpIds(v *OpSpecConstantOp) int {
+	n := len(v.Operands)
+
+	switch v.Operation {
+	case opcodeCompositeExtract:
+		n = 1
+	case opcodeCompositeInsert, opcodeVectorShuffle:
+		n = 2
+	}
+
+	if n > len(v.Operands) {
+		return len(v.Operands)
+	}
+
+	return n
+}
+
+func forEachIdValue(rv reflect.Value, field string, optional bool, fn func(string, *Id)) {
+	switch {
+	case rv.Type() == idType:
+		if optional && rv.Uint() == 0 {
+			return // Absent optional operand.
+		}
+		fn(field, rv.Addr().Interface().(*Id))
+
+	case rv.Kind() == reflect.Ptr:
+		if !rv.IsNil() {
+			forEachIdValue(rv.Elem(), field, optional, fn)
+		}
+
+	case rv.Kind() == reflect.Slice && rv.Type().Elem() == idType:
+		for i := 0; i < rv.Len(); i++ {
+			fn(field, rv.Index(i).Addr().Interface().(*Id))
+		}
+
+	case rv.Kind() == reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			forEachIdValue(rv.Field(i), field, optional, fn)
+		}
+	}
+}
+
+// ForEachId calls fn for each Id operand in the module, as found through
+// the struct fields of its instructions. This includes Ids which are mixed
+// with literals in a single field, like the labels of OpSwitch. The literal
+// indices held by the Operands of OpSpecConstantOp are skipped.
+//
+// The operands of OpExtInst instructions are only visited if the extended
+// instruction set they belong to is registered, as they may hold literals.
+// Any changes fn makes to them are written back into the OpExtInst.
+func (m *Module) ForEachId(fn IdFunc) {
+	sets := m.extInstSets()
+
+	// Literal widths must be known before fn makes any changes.
+	literalWords := func(Id) int { return 1 }
+	if m.Code.Index(opcodeSwitch) > -1 {
+		literalWords = m.literalWords()
+	}
+
+	for addr, instr := range m.Code {
+		forEachInstructionId(addr, instr, sets, literalWords, fn)
+	}
+}
+
+// forEachInstructionId calls fn for each Id operand of the instruction at
+// the given address. See Module.ForEachId.
+func forEachInstructionId(addr int, instr Instruction, sets map[Id]ExtInstSet, literalWords func(Id) int, fn IdFunc) {
+	ei, ok := instr.(*OpExtInst)
+	if !ok {
+		// Ids in words come first, as literalWords expects
+		// unmodified Ids.
+		forEachWordId(instr, literalWords, func(field string, word *uint32) {
+			id := Id(*word)
+			fn(addr, field, &id)
+
+			if uint32(id) != *word {
+				*word = uint32(id)
+			}
+		})
+
+		forEachId(instr, func(field string, id *Id) {
+			fn(addr, field, id)
+		})
+		return
+	}
+
+	fn(addr, "ResultType", &ei.ResultType)
+	fn(addr, "ResultId", &ei.ResultId)
+
+	set, ok := sets[ei.Set]
+	fn(addr, "Set", &ei.Set)
+
+	if !ok {
+		return
+	}
+
+	ext, err := decodeExtInstOperands(set, ei)
+	if err != nil {
+		return // Reported by Module.Verify.
+	}
+
+	var changed bool
+
+	forEachId(ext, func(field string, id *Id) {
+		old := *id
+		fn(addr, field, id)
+		changed = changed || *id != old
+	})
+
+	if changed {
+		argv := make([]uint32, ext.OperandLen())
+		ext.EncodeOperands(argv)
+		ei.Operands = decodeIds(argv)
+	}
+}
+
+// forEachWordId calls fn for each Id operand of the given instruction,
+// which is stored in a slice of words along with literal operands.
+// literalWords returns the number of words used by literals compared
+// against the given Id.
+func forEachWordId(i Instruction, literalWords func(Id) int, fn func(string, *uint32)) {
+	switch v := i.(type) {
+	case *OpGroupMemberDecorate:
+		// (structure type <id>, member) pairs.
+		for j := 0; j < len(v.Targets); j += 2 {
+			fn("Targets", &v.Targets[j])
+		}
+
+	case *OpSwitch:
+		// (literal, label <id>) pairs.
+		n := literalWords(v.Selector)
+		for j := n; j < len(v.Target); j += n + 1 {
+			fn("Target", &v.Target[j])
+		}
+
+	case *OpLoad:
+		forEachMemoryAccessId(v.MemoryAccess, v.Argv, fn)
+
+	case *OpStore:
+		forEachMemoryAccessId(v.MemoryAccess, v.Argv, fn)
+
+	case *OpCopyMemory:
+		n := forEachMemoryAccessId(v.MemoryAccess, v.Argv, fn)
+		forEachSourceMemoryAccessId(v.Argv[n:], fn)
+
+	case *OpCopyMemorySized:
+		n := forEachMemoryAccessId(v.MemoryAccess, v.Argv, fn)
+		forEachSourceMemoryAccessId(v.Argv[n:], fn)
+	}
+}
+
+// ForEachMemoryAccessOperand calls fn for each extra operand required by
+// the given memory access mask, in operand order. It is passed the index
+// of the operand and the bit which requires it. The operand of Aligned is
+// a literal; the others are scope <id>s, for which id is true.
+//
+// Returns the number of extra operands.
+func ForEachMemoryAccessOperand(mask MemoryAccess, fn func(i int, bit MemoryAccess, id bool)) int {
+	var n int
+
+	for _, bit := range []MemoryAccess{
+		MemoryAccessAligned,
+		MemoryAccessMakePointerAvailable,
+		MemoryAccessMakePointerVisible,
+	} {
+		if mask&bit != 0 {
+			fn(n, bit, bit != MemoryAccessAligned)
+			n++
+		}
+	}
+
+	return n
+}
+
+// forEachMemoryAccessId calls fn for each Id among the extra operands of
+// the given memory access mask. Returns the number of extra operands
+// present in argv.
+func forEachMemoryAccessId(mask *MemoryAccess, argv []uint32, fn func(string, *uint32)) int {
+	if mask == nil {
+		return 0
+	}
+
+	n := ForEachMemoryAccessOperand(*mask, func(i int, _ MemoryAccess, id bool) {
+		if id && i < len(argv) {
+			fn("Argv", &argv[i])
+		}
+	})
+
+	if n > len(argv) {
+		n = len(argv)
+	}
+
+	return n
+}
+
+// forEachSourceMemoryAccessId calls fn for each Id among the operands of the
+// second memory access mask of the OpCopyMemory instructions, which
+// applies to their Source.
+func forEachSourceMemoryAccessId(argv []uint32, fn func(string, *uint32)) {
+	if len(argv) == 0 {
+		return
+	}
+
+	mask := MemoryAccess(argv[0])
+	forEachMemoryAccessId(&mask, argv[1:], fn)
+}
+
+// literalWords returns a function which yields the number of words used by
+// a literal of the same type as the given Id. These are 2 for 64-bit types
+// and 1 for all others.
+func (m *Module) literalWords() func(Id) int {
+	types := make(map[Id]Id)
+	wide := make(map[Id]bool)
+
+	for _, instr := range m.Code {
+		switch v := instr.(type) {
+		case *OpTypeInt:
+			wide[v.ResultId] = v.Width > 32
+		case *OpTypeFloat:
+			wide[v.ResultId] = v.Width > 32
+		default:
+			rid, ok := instructionResultId(instr)
+			typ, tok := instructionResultType(instr)
+			if ok && tok {
+				types[rid] = typ
+			}
+		}
+	}
+
+	return func(id Id) int {
+		if wide[types[id]] {
+			return 2
+		}
+
+		return 1
+	}
+}
+
+// ComputeBound returns the Id bound of the module, as defined by the
+// highest Id in use. This is the value Header.Bound should hold.
+func (m *Module) ComputeBound() uint32 {
+	var max Id
+
+	m.ForEachId(func(_ int, _ string, id *Id) {
+		if *id > max {
+			max = *id
+		}
+	})
+
+	return uint32(max) + 1
+}
+
+// verifyIds ensures all Id operands satisfy 0 < id < Bound.
+func (m *Module) verifyIds(v *verifier) {
+	stop := false
+
+	m.ForEachId(func(addr int, field string, id *Id) {
+		if !stop && (*id == 0 || uint32(*id) >= m.Header.Bound) {
+			stop = !v.report(NewLayoutError(addr, "%s: %s(%d) is out of range; Bound is %d",
+				instructionName(m.Code[addr]), field, *id, m.Header.Bound))
+		}
+	})
+}
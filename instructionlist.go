@@ -29,19 +29,27 @@ func (set InstructionList) Functions() []InstructionList {
 // Blocks returns all function blocks. This assumes the given set
 // is itself just one function. Otherwise it will return blocks for
 // multiple- or all functions.
+//
+// A block starts with an OpLabel and ends with one of the block
+// terminator instructions. Labels which are not followed by a
+// terminator before the next label, are skipped. Module.CFGs offers
+// a complete control-flow graph instead.
 func (set InstructionList) Blocks() []InstructionList {
 	var out []InstructionList
 
-	start := set.FilterIndex(opcodeLabel, 0)
-	end := set.FilterIndex(opcodeBranch, 0)
+	start := -1
 
-	if len(start) != len(end) {
-		return nil
-	}
+	for i, v := range set {
+		opcode := v.Opcode()
 
-	for i, s := range start {
-		e := end[i]
-		out = append(out, set[s:e+1])
+		switch {
+		case opcode == opcodeLabel:
+			start = i
+
+		case start > -1 && isTerminator(opcode):
+			out = append(out, set[start:i+1])
+			start = -1
+		}
 	}
 
 	return out
@@ -135,5 +143,35 @@ func (set InstructionList) localVariables() []int {
 // globalVariables returns all global variables defined in the set
 func (set InstructionList) globalVariables() []int {
 	funcIndex := set.Index(opcodeFunction)
+	if funcIndex == -1 {
+		funcIndex = len(set)
+	}
+
 	return set[:funcIndex].FilterIndex(opcodeVariable, 0)
 }
+
+// terminatorIndex returns the indices of all block terminator
+// instructions in the set, with offset added to each of them.
+func (set InstructionList) terminatorIndex(offset int) []int {
+	out := make([]int, 0, len(set))
+
+	for i, v := range set {
+		if isTerminator(v.Opcode()) {
+			out = append(out, i+offset)
+		}
+	}
+
+	return out
+}
+
+// isTerminator returns true if the given opcode ends a block.
+func isTerminator(opcode uint32) bool {
+	switch opcode {
+	case opcodeBranch, opcodeBranchConditional, opcodeSwitch,
+		opcodeKill, opcodeReturn, opcodeReturnValue,
+		opcodeUnreachable, opcodeTerminateInvocation:
+		return true
+	}
+
+	return false
+}
@@ -1,172 +1,119 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
-
-import "fmt"
-
-// OpDecorationGroup represents a collector of decorations from OpDecorate
-// instructions.
-//
-// All such instructions must precede this instruction. Subsequent OpGroupDecorate
-// and OpGroupMemberDecorate instructions can consume the Result <id> to apply
-// multiple decorations to multiple target <id>s. Those are the only
-// instructions allowed to consume the Result <id>.
-type OpDecorationGroup struct {
-	ResultId Id
-}
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
 
-func (c *OpDecorationGroup) Opcode() uint32 { return opcodeDecorationGroup }
-func (c *OpDecorationGroup) Optional() bool { return false }
-func (c *OpDecorationGroup) Verify() error  { return nil }
+package spirv
 
-// OpDecorate represents the OpDecorate instruction.
-// It adds a decoration to another <id>.
+// OpDecorate adds a decoration to another <id>.
 type OpDecorate struct {
-	// Target is the <id> to decorate. It can potentially be any <id> that
-	// is a forward reference. A set of decorations can be grouped together
-	// by having multiple OpDecorate instructions target the same
-	// OpDecorationGroup instruction.
-	Target Id
-
-	// The decoration type to apply.
+	Target     Id
 	Decoration Decoration
 
-	// Optional list of decoration arguments.
-	//
-	// Refer to the Decoration constant documentation for more details
-	// on which values require which arguments.
+	// Argv holds the extra operands required by the decoration.
 	Argv []uint32
 }
 
 func (c *OpDecorate) Opcode() uint32 { return opcodeDecorate }
 func (c *OpDecorate) Optional() bool { return false }
-func (c *OpDecorate) Verify() error {
-	argc := len(c.Argv)
-
-	switch c.Decoration {
-	case DecorationStream, DecorationLocation, DecorationComponent,
-		DecorationIndex, DecorationBinding, DecorationOffset,
-		DecorationAlignment, DecorationXfbBuffer, DecorationStride,
-		DecorationBuiltIn, DecorationFuncParamAttr, DecorationFPRoundingMode,
-		DecorationFPFastMathMode, DecorationSpecId:
-		if argc != 1 {
-			return fmt.Errorf("OpDecorate: Decoration(%d) must have 1 argument", c.Decoration)
-		}
-
-		return nil
-
-	case DecorationLinkageType:
-		if argc != 1 {
-			return fmt.Errorf("OpDecorate: Decoration(%d) must have 1 argument", c.Decoration)
-		}
-
-		err := LinkageType(c.Argv[0]).Verify()
-		if err != nil {
-			return fmt.Errorf("OpDecorate: %v", err)
-		}
-
-		return nil
-	}
-
-	if argc > 0 {
-		return fmt.Errorf("OpDecorate: extraneous arguments for Decoration(%d)",
-			c.Decoration)
-	}
-
-	return nil
-}
 
-// OpMemberDecorate represents the OpMemberDecorate instruction.
-// It adds a decoration to a member of a structure type.
+// OpMemberDecorate adds a decoration to a member of a structure type.
 type OpMemberDecorate struct {
-	// The <id> of a type from OpTypeStruct.
-	StructType Id
-
-	// Member is the number of the member to decorate in the structure.
-	// The first member is member 0, the next is member 1, . . .
-	Member uint32
+	StructureType Id
+	Member        uint32
+	Decoration    Decoration
 
-	// The decoration type to apply.
-	Decoration Decoration
-
-	// Optional list of decoration arguments.
-	//
-	// Refer to the Decoration constant documentation for more details
-	// on which values require which arguments.
+	// Argv holds the extra operands required by the decoration.
 	Argv []uint32
 }
 
 func (c *OpMemberDecorate) Opcode() uint32 { return opcodeMemberDecorate }
 func (c *OpMemberDecorate) Optional() bool { return false }
-func (c *OpMemberDecorate) Verify() error {
-	argc := len(c.Argv)
-
-	switch c.Decoration {
-	case DecorationStream, DecorationLocation, DecorationComponent,
-		DecorationIndex, DecorationBinding, DecorationOffset,
-		DecorationAlignment, DecorationXfbBuffer, DecorationStride,
-		DecorationBuiltIn, DecorationFuncParamAttr, DecorationFPRoundingMode,
-		DecorationFPFastMathMode, DecorationSpecId:
-		if argc != 1 {
-			return fmt.Errorf("OpMemberDecorate: Decoration(%d) must have 1 argument", c.Decoration)
-		}
-
-		return nil
-
-	case DecorationLinkageType:
-		if argc != 1 {
-			return fmt.Errorf("OpMemberDecorate: Decoration(%d) must have 1 argument", c.Decoration)
-		}
-
-		err := LinkageType(c.Argv[0]).Verify()
-		if err != nil {
-			return fmt.Errorf("OpMemberDecorate: %v", err)
-		}
-
-		return nil
-	}
-
-	if argc > 0 {
-		return fmt.Errorf("OpMemberDecorate: extraneous arguments for Decoration(%d)",
-			c.Decoration)
-	}
-
-	return nil
+
+// OpDecorationGroup is a collector for decorations from OpDecorate and
+// OpMemberDecorate instructions.
+type OpDecorationGroup struct {
+	ResultId Id
 }
 
-// OpGroupDecorate represents the OpGroupDecorate instruction.
-// It adds a group of decorations to another <id>.
-type OpGroupDecorate struct {
-	// Decoration group is the <id> of an OpDecorationGroup instruction.
-	Group Id
+func (c *OpDecorationGroup) Opcode() uint32 { return opcodeDecorationGroup }
+func (c *OpDecorationGroup) Optional() bool { return false }
+func (c *OpDecorationGroup) Verify() error  { return nil }
 
-	// Targets are the target <id>s to decorate with the groups of decorations.
-	Targets []Id
+// OpGroupDecorate adds a group of decorations to another <id>.
+type OpGroupDecorate struct {
+	DecorationGroup Id
+	Targets         []Id
 }
 
 func (c *OpGroupDecorate) Opcode() uint32 { return opcodeGroupDecorate }
 func (c *OpGroupDecorate) Optional() bool { return false }
 func (c *OpGroupDecorate) Verify() error  { return nil }
 
-// OpGroupMemberDecorate represents the OpGroupMemberDecorate instruction.
-// It adds a decoration to a member of a structure type
+// OpGroupMemberDecorate adds a decoration group to members of structure
+// types.
 type OpGroupMemberDecorate struct {
-	// The <id> of a OpDecorationGroup instruction.
-	Group Id
+	DecorationGroup Id
 
-	// Targets are the target <id>s to decorate with the groups of decorations.
-	Targets []Id
+	// Targets holds (structure type <id>, member) pairs.
+	Targets []uint32
 }
 
 func (c *OpGroupMemberDecorate) Opcode() uint32 { return opcodeGroupMemberDecorate }
 func (c *OpGroupMemberDecorate) Optional() bool { return false }
-func (c *OpGroupMemberDecorate) Verify() error  { return nil }
+
+// OpDecorateId adds a decoration to another <id>, using <id>s as the
+// decoration's extra operands.
+//
+// This instruction requires SPIR-V 1.2 or later.
+type OpDecorateId struct {
+	Target     Id
+	Decoration Decoration
+
+	// Argv holds the extra <id> operands required by the decoration.
+	Argv []Id
+}
+
+func (c *OpDecorateId) Opcode() uint32 { return opcodeDecorateId }
+func (c *OpDecorateId) Optional() bool { return false }
+
+// OpDecorateString adds a string decoration to another <id>.
+//
+// This instruction requires SPIR-V 1.4 or later.
+type OpDecorateString struct {
+	Target     Id
+	Decoration Decoration
+
+	// Value holds the string operand required by the decoration.
+	Value String
+}
+
+func (c *OpDecorateString) Opcode() uint32 { return opcodeDecorateString }
+func (c *OpDecorateString) Optional() bool { return false }
+
+// OpMemberDecorateString adds a string decoration to a member of a structure
+// type.
+//
+// This instruction requires SPIR-V 1.4 or later.
+type OpMemberDecorateString struct {
+	StructType Id
+	Member     uint32
+	Decoration Decoration
+
+	// Value holds the string operand required by the decoration.
+	Value String
+}
+
+func (c *OpMemberDecorateString) Opcode() uint32 { return opcodeMemberDecorateString }
+func (c *OpMemberDecorateString) Optional() bool { return false }
 
 func init() {
-	bind(func() Instruction { return &OpDecorationGroup{} })
 	bind(func() Instruction { return &OpDecorate{} })
 	bind(func() Instruction { return &OpMemberDecorate{} })
+	bind(func() Instruction { return &OpDecorationGroup{} })
 	bind(func() Instruction { return &OpGroupDecorate{} })
 	bind(func() Instruction { return &OpGroupMemberDecorate{} })
+	bind(func() Instruction { return &OpDecorateId{} })
+	bind(func() Instruction { return &OpDecorateString{} })
+	bind(func() Instruction { return &OpMemberDecorateString{} })
 }
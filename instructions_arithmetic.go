@@ -1,9 +1,11 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
 package spirv
 
-// OpSNegate performs signed-integer subtract of Operand from zero.
+// OpSNegate is signed-integer subtract of Operand from zero.
 type OpSNegate struct {
 	ResultType Id
 	ResultId   Id
@@ -14,7 +16,7 @@ func (c *OpSNegate) Opcode() uint32 { return opcodeSNegate }
 func (c *OpSNegate) Optional() bool { return false }
 func (c *OpSNegate) Verify() error  { return nil }
 
-// OpFNegate performs Floating-point subtract of Operand from zero.
+// OpFNegate inverts the sign bit of Operand.
 type OpFNegate struct {
 	ResultType Id
 	ResultId   Id
@@ -25,18 +27,7 @@ func (c *OpFNegate) Opcode() uint32 { return opcodeFNegate }
 func (c *OpFNegate) Optional() bool { return false }
 func (c *OpFNegate) Verify() error  { return nil }
 
-// OpNot complements the bits of Operand.
-type OpNot struct {
-	ResultType Id
-	ResultId   Id
-	Operand    Id
-}
-
-func (c *OpNot) Opcode() uint32 { return opcodeNot }
-func (c *OpNot) Optional() bool { return false }
-func (c *OpNot) Verify() error  { return nil }
-
-// OpIAdd performs Integer addition of Operand 1 and Operand 2.
+// OpIAdd is integer addition of Operand 1 and Operand 2.
 type OpIAdd struct {
 	ResultType Id
 	ResultId   Id
@@ -48,7 +39,7 @@ func (c *OpIAdd) Opcode() uint32 { return opcodeIAdd }
 func (c *OpIAdd) Optional() bool { return false }
 func (c *OpIAdd) Verify() error  { return nil }
 
-// OpFAdd performs Floating-point addition of Operand 1 and Operand 2.
+// OpFAdd is floating-point addition of Operand 1 and Operand 2.
 type OpFAdd struct {
 	ResultType Id
 	ResultId   Id
@@ -60,7 +51,7 @@ func (c *OpFAdd) Opcode() uint32 { return opcodeFAdd }
 func (c *OpFAdd) Optional() bool { return false }
 func (c *OpFAdd) Verify() error  { return nil }
 
-// OpISub performs Integer subtraction of Operand 2 from Operand 1.
+// OpISub is integer subtraction of Operand 2 from Operand 1.
 type OpISub struct {
 	ResultType Id
 	ResultId   Id
@@ -72,7 +63,7 @@ func (c *OpISub) Opcode() uint32 { return opcodeISub }
 func (c *OpISub) Optional() bool { return false }
 func (c *OpISub) Verify() error  { return nil }
 
-// OpFSub performs Floating-point subtraction of Operand 2 from Operand 1.
+// OpFSub is floating-point subtraction of Operand 2 from Operand 1.
 type OpFSub struct {
 	ResultType Id
 	ResultId   Id
@@ -84,7 +75,7 @@ func (c *OpFSub) Opcode() uint32 { return opcodeFSub }
 func (c *OpFSub) Optional() bool { return false }
 func (c *OpFSub) Verify() error  { return nil }
 
-// OpIMul performs Integer multiplication of Operand 1 and Operand 2.
+// OpIMul is integer multiplication of Operand 1 and Operand 2.
 type OpIMul struct {
 	ResultType Id
 	ResultId   Id
@@ -96,7 +87,7 @@ func (c *OpIMul) Opcode() uint32 { return opcodeIMul }
 func (c *OpIMul) Optional() bool { return false }
 func (c *OpIMul) Verify() error  { return nil }
 
-// OpFMul performs Floating-point multiplication of Operand 1 and Operand 2.
+// OpFMul is floating-point multiplication of Operand 1 and Operand 2.
 type OpFMul struct {
 	ResultType Id
 	ResultId   Id
@@ -108,7 +99,7 @@ func (c *OpFMul) Opcode() uint32 { return opcodeFMul }
 func (c *OpFMul) Optional() bool { return false }
 func (c *OpFMul) Verify() error  { return nil }
 
-// OpUDiv performs Unsigned-integer division of Operand 1 divided by Operand 2.
+// OpUDiv is unsigned-integer division of Operand 1 divided by Operand 2.
 type OpUDiv struct {
 	ResultType Id
 	ResultId   Id
@@ -120,7 +111,7 @@ func (c *OpUDiv) Opcode() uint32 { return opcodeUDiv }
 func (c *OpUDiv) Optional() bool { return false }
 func (c *OpUDiv) Verify() error  { return nil }
 
-// OpSDiv performs Signed-integer division of Operand 1 divided by Operand 2.
+// OpSDiv is signed-integer division of Operand 1 divided by Operand 2.
 type OpSDiv struct {
 	ResultType Id
 	ResultId   Id
@@ -132,7 +123,7 @@ func (c *OpSDiv) Opcode() uint32 { return opcodeSDiv }
 func (c *OpSDiv) Optional() bool { return false }
 func (c *OpSDiv) Verify() error  { return nil }
 
-// OpFDiv performs Floating-point division of Operand 1 divided by Operand 2.
+// OpFDiv is floating-point division of Operand 1 divided by Operand 2.
 type OpFDiv struct {
 	ResultType Id
 	ResultId   Id
@@ -144,7 +135,7 @@ func (c *OpFDiv) Opcode() uint32 { return opcodeFDiv }
 func (c *OpFDiv) Optional() bool { return false }
 func (c *OpFDiv) Verify() error  { return nil }
 
-// OpUMod performs Unsigned modulo operation of Operand 1 modulo Operand 2.
+// OpUMod is the unsigned modulo operation of Operand 1 modulo Operand 2.
 type OpUMod struct {
 	ResultType Id
 	ResultId   Id
@@ -156,7 +147,8 @@ func (c *OpUMod) Opcode() uint32 { return opcodeUMod }
 func (c *OpUMod) Optional() bool { return false }
 func (c *OpUMod) Verify() error  { return nil }
 
-// OpSRem performs Signed remainder operation of Operand 1 divided by Operand 2.
+// OpSRem is the signed remainder operation of Operand 1 divided by Operand 2,
+// whose sign matches that of Operand 1.
 type OpSRem struct {
 	ResultType Id
 	ResultId   Id
@@ -168,7 +160,8 @@ func (c *OpSRem) Opcode() uint32 { return opcodeSRem }
 func (c *OpSRem) Optional() bool { return false }
 func (c *OpSRem) Verify() error  { return nil }
 
-// OpSMod performs Signed modulo operation of Operand 1 modulo Operand 2.
+// OpSMod is the signed modulo operation of Operand 1 modulo Operand 2, whose
+// sign matches that of Operand 2.
 type OpSMod struct {
 	ResultType Id
 	ResultId   Id
@@ -180,7 +173,8 @@ func (c *OpSMod) Opcode() uint32 { return opcodeSMod }
 func (c *OpSMod) Optional() bool { return false }
 func (c *OpSMod) Verify() error  { return nil }
 
-// OpFRem performs Floating-point remainder operation of Operand 1 divided by Operand 2.
+// OpFRem is the floating-point remainder operation of Operand 1 divided by
+// Operand 2, whose sign matches that of Operand 1.
 type OpFRem struct {
 	ResultType Id
 	ResultId   Id
@@ -192,7 +186,8 @@ func (c *OpFRem) Opcode() uint32 { return opcodeFRem }
 func (c *OpFRem) Optional() bool { return false }
 func (c *OpFRem) Verify() error  { return nil }
 
-// OpFMod performs Floating-point modulo operation of Operand 1 modulo Operand 2.
+// OpFMod is the floating-point remainder operation of Operand 1 divided by
+// Operand 2, whose sign matches that of Operand 2.
 type OpFMod struct {
 	ResultType Id
 	ResultId   Id
@@ -220,7 +215,7 @@ func (c *OpVectorTimesScalar) Verify() error  { return nil }
 type OpMatrixTimesScalar struct {
 	ResultType Id
 	ResultId   Id
-	Vector     Id
+	Matrix     Id
 	Scalar     Id
 }
 
@@ -228,7 +223,7 @@ func (c *OpMatrixTimesScalar) Opcode() uint32 { return opcodeMatrixTimesScalar }
 func (c *OpMatrixTimesScalar) Optional() bool { return false }
 func (c *OpMatrixTimesScalar) Verify() error  { return nil }
 
-// OpVectorTimesMatrix performs Linear-algebraic Vector X Matrix.
+// OpVectorTimesMatrix is linear-algebraic Vector X Matrix.
 type OpVectorTimesMatrix struct {
 	ResultType Id
 	ResultId   Id
@@ -240,7 +235,7 @@ func (c *OpVectorTimesMatrix) Opcode() uint32 { return opcodeVectorTimesMatrix }
 func (c *OpVectorTimesMatrix) Optional() bool { return false }
 func (c *OpVectorTimesMatrix) Verify() error  { return nil }
 
-// OpMatrixTimesVector performs Linear-algebraic Vector X Matrix.
+// OpMatrixTimesVector is linear-algebraic Matrix X Vector.
 type OpMatrixTimesVector struct {
 	ResultType Id
 	ResultId   Id
@@ -252,19 +247,20 @@ func (c *OpMatrixTimesVector) Opcode() uint32 { return opcodeMatrixTimesVector }
 func (c *OpMatrixTimesVector) Optional() bool { return false }
 func (c *OpMatrixTimesVector) Verify() error  { return nil }
 
-// OpMatrixTimesMatrix performs Linear-algebraic multiply of Left X Right.
+// OpMatrixTimesMatrix is linear-algebraic multiply of LeftMatrix X
+// RightMatrix.
 type OpMatrixTimesMatrix struct {
-	ResultType Id
-	ResultId   Id
-	Left       Id
-	Right      Id
+	ResultType  Id
+	ResultId    Id
+	LeftMatrix  Id
+	RightMatrix Id
 }
 
 func (c *OpMatrixTimesMatrix) Opcode() uint32 { return opcodeMatrixTimesMatrix }
 func (c *OpMatrixTimesMatrix) Optional() bool { return false }
 func (c *OpMatrixTimesMatrix) Verify() error  { return nil }
 
-// OpOuterProduct performs Linear-algebraic outer product of Vector 1 and Vector 2.
+// OpOuterProduct is linear-algebraic outer product of Vector 1 and Vector 2.
 type OpOuterProduct struct {
 	ResultType Id
 	ResultId   Id
@@ -276,7 +272,7 @@ func (c *OpOuterProduct) Opcode() uint32 { return opcodeOuterProduct }
 func (c *OpOuterProduct) Optional() bool { return false }
 func (c *OpOuterProduct) Verify() error  { return nil }
 
-// OpDot performs Dot product of Vector 1 and Vector 2
+// OpDot is the dot product of Vector 1 and Vector 2.
 type OpDot struct {
 	ResultType Id
 	ResultId   Id
@@ -288,88 +284,158 @@ func (c *OpDot) Opcode() uint32 { return opcodeDot }
 func (c *OpDot) Optional() bool { return false }
 func (c *OpDot) Verify() error  { return nil }
 
-// OpShiftRightLogical shifts the bits in Operand 1 right by the number
-// of bits specified in Operand 2
-type OpShiftRightLogical struct {
+// OpIAddCarry computes the integer addition of Operand 1 and Operand 2,
+// including its carry.
+type OpIAddCarry struct {
 	ResultType Id
 	ResultId   Id
 	Operand1   Id
 	Operand2   Id
 }
 
-func (c *OpShiftRightLogical) Opcode() uint32 { return opcodeShiftRightLogical }
-func (c *OpShiftRightLogical) Optional() bool { return false }
-func (c *OpShiftRightLogical) Verify() error  { return nil }
+func (c *OpIAddCarry) Opcode() uint32 { return opcodeIAddCarry }
+func (c *OpIAddCarry) Optional() bool { return false }
+func (c *OpIAddCarry) Verify() error  { return nil }
 
-// OpShiftRightArithmetic shifts the bits in Operand 1 right by the number of
-// bits specified in Operand 2
-type OpShiftRightArithmetic struct {
+// OpISubBorrow computes the integer subtraction of Operand 2 from Operand 1,
+// including what it needed to borrow.
+type OpISubBorrow struct {
 	ResultType Id
 	ResultId   Id
 	Operand1   Id
 	Operand2   Id
 }
 
-func (c *OpShiftRightArithmetic) Opcode() uint32 { return opcodeShiftRightArithmetic }
-func (c *OpShiftRightArithmetic) Optional() bool { return false }
-func (c *OpShiftRightArithmetic) Verify() error  { return nil }
+func (c *OpISubBorrow) Opcode() uint32 { return opcodeISubBorrow }
+func (c *OpISubBorrow) Optional() bool { return false }
+func (c *OpISubBorrow) Verify() error  { return nil }
 
-// OpShiftLeftLogical shifts the bits in Operand 1 left by the number
-// of bits specified in Operand 2
-type OpShiftLeftLogical struct {
+// OpUMulExtended computes the full-precision unsigned integer multiplication
+// of Operand 1 and Operand 2.
+type OpUMulExtended struct {
 	ResultType Id
 	ResultId   Id
 	Operand1   Id
 	Operand2   Id
 }
 
-func (c *OpShiftLeftLogical) Opcode() uint32 { return opcodeShiftLeftLogical }
-func (c *OpShiftLeftLogical) Optional() bool { return false }
-func (c *OpShiftLeftLogical) Verify() error  { return nil }
+func (c *OpUMulExtended) Opcode() uint32 { return opcodeUMulExtended }
+func (c *OpUMulExtended) Optional() bool { return false }
+func (c *OpUMulExtended) Verify() error  { return nil }
 
-// OpBitwiseOr result is 1 if either Operand 1 or Operand 2 is 1.
-// Result is 0 if both Operand 1 and Operand 2 are 0.
-type OpBitwiseOr struct {
+// OpSMulExtended computes the full-precision signed integer multiplication of
+// Operand 1 and Operand 2.
+type OpSMulExtended struct {
 	ResultType Id
 	ResultId   Id
 	Operand1   Id
 	Operand2   Id
 }
 
-func (c *OpBitwiseOr) Opcode() uint32 { return opcodeBitwiseOr }
-func (c *OpBitwiseOr) Optional() bool { return false }
-func (c *OpBitwiseOr) Verify() error  { return nil }
+func (c *OpSMulExtended) Opcode() uint32 { return opcodeSMulExtended }
+func (c *OpSMulExtended) Optional() bool { return false }
+func (c *OpSMulExtended) Verify() error  { return nil }
+
+// OpSDot is the signed integer dot product of Vector 1 and Vector 2.
+//
+// This instruction requires SPIR-V 1.6 or later.
+type OpSDot struct {
+	ResultType         Id
+	ResultId           Id
+	Vector1            Id
+	Vector2            Id
+	PackedVectorFormat *PackedVectorFormat `spirv:"optional"`
+}
 
-// OpBitwiseXor result is 1 if exactly one of Operand 1 or Operand 2 is 1.
-// Result is 0 if Operand 1 and Operand 2 have the same value
-type OpBitwiseXor struct {
-	ResultType Id
-	ResultId   Id
-	Operand1   Id
-	Operand2   Id
+func (c *OpSDot) Opcode() uint32 { return opcodeSDot }
+func (c *OpSDot) Optional() bool { return false }
+func (c *OpSDot) Verify() error  { return nil }
+
+// OpUDot is the unsigned integer dot product of Vector 1 and Vector 2.
+//
+// This instruction requires SPIR-V 1.6 or later.
+type OpUDot struct {
+	ResultType         Id
+	ResultId           Id
+	Vector1            Id
+	Vector2            Id
+	PackedVectorFormat *PackedVectorFormat `spirv:"optional"`
 }
 
-func (c *OpBitwiseXor) Opcode() uint32 { return opcodeBitwiseXor }
-func (c *OpBitwiseXor) Optional() bool { return false }
-func (c *OpBitwiseXor) Verify() error  { return nil }
+func (c *OpUDot) Opcode() uint32 { return opcodeUDot }
+func (c *OpUDot) Optional() bool { return false }
+func (c *OpUDot) Verify() error  { return nil }
+
+// OpSUDot is the mixed-signedness integer dot product of Vector 1 and Vector
+// 2.
+//
+// This instruction requires SPIR-V 1.6 or later.
+type OpSUDot struct {
+	ResultType         Id
+	ResultId           Id
+	Vector1            Id
+	Vector2            Id
+	PackedVectorFormat *PackedVectorFormat `spirv:"optional"`
+}
 
-// OpBitwiseAnd result is 1 if both Operand 1 and Operand 2 are 1.
-// Result is 0 if either Operand 1 or Operand 2 are 0
-type OpBitwiseAnd struct {
-	ResultType Id
-	ResultId   Id
-	Operand1   Id
-	Operand2   Id
+func (c *OpSUDot) Opcode() uint32 { return opcodeSUDot }
+func (c *OpSUDot) Optional() bool { return false }
+func (c *OpSUDot) Verify() error  { return nil }
+
+// OpSDotAccSat is the signed integer dot product of Vector 1 and Vector 2,
+// added to Accumulator with saturation.
+//
+// This instruction requires SPIR-V 1.6 or later.
+type OpSDotAccSat struct {
+	ResultType         Id
+	ResultId           Id
+	Vector1            Id
+	Vector2            Id
+	Accumulator        Id
+	PackedVectorFormat *PackedVectorFormat `spirv:"optional"`
+}
+
+func (c *OpSDotAccSat) Opcode() uint32 { return opcodeSDotAccSat }
+func (c *OpSDotAccSat) Optional() bool { return false }
+func (c *OpSDotAccSat) Verify() error  { return nil }
+
+// OpUDotAccSat is the unsigned integer dot product of Vector 1 and Vector 2,
+// added to Accumulator with saturation.
+//
+// This instruction requires SPIR-V 1.6 or later.
+type OpUDotAccSat struct {
+	ResultType         Id
+	ResultId           Id
+	Vector1            Id
+	Vector2            Id
+	Accumulator        Id
+	PackedVectorFormat *PackedVectorFormat `spirv:"optional"`
+}
+
+func (c *OpUDotAccSat) Opcode() uint32 { return opcodeUDotAccSat }
+func (c *OpUDotAccSat) Optional() bool { return false }
+func (c *OpUDotAccSat) Verify() error  { return nil }
+
+// OpSUDotAccSat is the mixed-signedness integer dot product of Vector 1 and
+// Vector 2, added to Accumulator with saturation.
+//
+// This instruction requires SPIR-V 1.6 or later.
+type OpSUDotAccSat struct {
+	ResultType         Id
+	ResultId           Id
+	Vector1            Id
+	Vector2            Id
+	Accumulator        Id
+	PackedVectorFormat *PackedVectorFormat `spirv:"optional"`
 }
 
-func (c *OpBitwiseAnd) Opcode() uint32 { return opcodeBitwiseAnd }
-func (c *OpBitwiseAnd) Optional() bool { return false }
-func (c *OpBitwiseAnd) Verify() error  { return nil }
+func (c *OpSUDotAccSat) Opcode() uint32 { return opcodeSUDotAccSat }
+func (c *OpSUDotAccSat) Optional() bool { return false }
+func (c *OpSUDotAccSat) Verify() error  { return nil }
 
 func init() {
 	bind(func() Instruction { return &OpSNegate{} })
 	bind(func() Instruction { return &OpFNegate{} })
-	bind(func() Instruction { return &OpNot{} })
 	bind(func() Instruction { return &OpIAdd{} })
 	bind(func() Instruction { return &OpFAdd{} })
 	bind(func() Instruction { return &OpISub{} })
@@ -391,10 +457,14 @@ func init() {
 	bind(func() Instruction { return &OpMatrixTimesMatrix{} })
 	bind(func() Instruction { return &OpOuterProduct{} })
 	bind(func() Instruction { return &OpDot{} })
-	bind(func() Instruction { return &OpShiftRightLogical{} })
-	bind(func() Instruction { return &OpShiftRightArithmetic{} })
-	bind(func() Instruction { return &OpShiftLeftLogical{} })
-	bind(func() Instruction { return &OpBitwiseOr{} })
-	bind(func() Instruction { return &OpBitwiseXor{} })
-	bind(func() Instruction { return &OpBitwiseAnd{} })
+	bind(func() Instruction { return &OpIAddCarry{} })
+	bind(func() Instruction { return &OpISubBorrow{} })
+	bind(func() Instruction { return &OpUMulExtended{} })
+	bind(func() Instruction { return &OpSMulExtended{} })
+	bind(func() Instruction { return &OpSDot{} })
+	bind(func() Instruction { return &OpUDot{} })
+	bind(func() Instruction { return &OpSUDot{} })
+	bind(func() Instruction { return &OpSDotAccSat{} })
+	bind(func() Instruction { return &OpUDotAccSat{} })
+	bind(func() Instruction { return &OpSUDotAccSat{} })
 }
@@ -1,299 +1,270 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
-
-// OpAtomicInit initializes atomic memory to Value.
-// This is not done atomically with respect to anything.
-type OpAtomicInit struct {
-	Pointer Id
-	Value   Id
-}
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
 
-func (c *OpAtomicInit) Opcode() uint32 { return opcodeAtomicInit }
-func (c *OpAtomicInit) Optional() bool { return false }
-func (c *OpAtomicInit) Verify() error  { return nil }
+package spirv
 
-// OpAtomicLoad atomically loads through Pointer using the given Semantics.
-//
-// All subparts of the value that is loaded will be read atomically with
-// respect to all other atomic accesses to it within Scope.
+// OpAtomicLoad atomically loads through Pointer.
 type OpAtomicLoad struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
 }
 
 func (c *OpAtomicLoad) Opcode() uint32 { return opcodeAtomicLoad }
 func (c *OpAtomicLoad) Optional() bool { return false }
 func (c *OpAtomicLoad) Verify() error  { return nil }
 
-// OpAtomicStore atomically stores through Pointer using the given Semantics.
-//
-// All subparts of Value will be written atomically with respect to all
-// other atomic accesses to it within Scope.
+// OpAtomicStore atomically stores through Pointer.
 type OpAtomicStore struct {
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	Pointer   Id
+	Memory    Id
+	Semantics Id
+	Value     Id
 }
 
 func (c *OpAtomicStore) Opcode() uint32 { return opcodeAtomicStore }
 func (c *OpAtomicStore) Optional() bool { return false }
 func (c *OpAtomicStore) Verify() error  { return nil }
 
-// OpAtomicExchange performs the following steps atomically with respect to any
-// other atomic accesses within Scope to the same location:
-//
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value from copying Value, and
-//   3) store the New Value back through Pointer.
-//
-// The instruction’s result is the Original Value.
+// OpAtomicExchange atomically replaces the value pointed to by Pointer,
+// returning the original value.
 type OpAtomicExchange struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicExchange) Opcode() uint32 { return opcodeAtomicExchange }
 func (c *OpAtomicExchange) Optional() bool { return false }
 func (c *OpAtomicExchange) Verify() error  { return nil }
 
-// OpAtomicCompareExchange performs the following steps atomically with respect
-// to any other atomic accesses within Scope to the same location:
-//
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by selecting Value if Original Value equals Comparator
-//      or selecting Original Value otherwise, and
-//   3) store the New Value back through Pointer.
-//
-// The instruction’s result is the Original Value.
+// OpAtomicCompareExchange atomically replaces the value pointed to by Pointer
+// if it equals Comparator.
 type OpAtomicCompareExchange struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
-	Comparator     Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Equal      Id
+	Unequal    Id
+	Value      Id
+	Comparator Id
 }
 
 func (c *OpAtomicCompareExchange) Opcode() uint32 { return opcodeAtomicCompareExchange }
 func (c *OpAtomicCompareExchange) Optional() bool { return false }
 func (c *OpAtomicCompareExchange) Verify() error  { return nil }
 
-// OpAtomicCompareExchangeWeak performs the following steps atomically
-// with respect to any other atomic accesses within Scope to the same location:
-//
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by selecting Value if Original Value equals Comparator
-//      or selecting Original Value otherwise, and
-//   3) store the New Value back through Pointer.
-//
+// OpAtomicCompareExchangeWeak has the same semantics as
+// OpAtomicCompareExchange.
 type OpAtomicCompareExchangeWeak struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
-	Comparator     Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Equal      Id
+	Unequal    Id
+	Value      Id
+	Comparator Id
 }
 
 func (c *OpAtomicCompareExchangeWeak) Opcode() uint32 { return opcodeAtomicCompareExchangeWeak }
 func (c *OpAtomicCompareExchangeWeak) Optional() bool { return false }
 func (c *OpAtomicCompareExchangeWeak) Verify() error  { return nil }
 
-// OpAtomicIIncrement performs the following steps atomically with respect
-// to any other atomic accesses within Scope to the same location
-//
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value through integer addition of 1 to Original Value, and
-//   3) store the New Value back through Pointer.
-//
+// OpAtomicIIncrement atomically increments the integer pointed to by Pointer.
 type OpAtomicIIncrement struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
 }
 
 func (c *OpAtomicIIncrement) Opcode() uint32 { return opcodeAtomicIIncrement }
 func (c *OpAtomicIIncrement) Optional() bool { return false }
 func (c *OpAtomicIIncrement) Verify() error  { return nil }
 
-// OpAtomicIDecrement performs the following steps atomically with respect
-// to any other atomic accesses within Scope to the same location
-//
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value through integer subtraction of 1 from Original Value, and
-//   3) store the New Value back through Pointer.
-//
+// OpAtomicIDecrement atomically decrements the integer pointed to by Pointer.
 type OpAtomicIDecrement struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
 }
 
 func (c *OpAtomicIDecrement) Opcode() uint32 { return opcodeAtomicIDecrement }
 func (c *OpAtomicIDecrement) Optional() bool { return false }
 func (c *OpAtomicIDecrement) Verify() error  { return nil }
 
-// OpAtomicIAdd performs the following steps atomically with respect to
-// any other atomic accesses within Scope to the same location:
-//
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by integer addition of Original Value and Value, and
-//   3) store the New Value back through Pointer.
-//
+// OpAtomicIAdd atomically adds Value to the value pointed to by Pointer.
 type OpAtomicIAdd struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicIAdd) Opcode() uint32 { return opcodeAtomicIAdd }
 func (c *OpAtomicIAdd) Optional() bool { return false }
 func (c *OpAtomicIAdd) Verify() error  { return nil }
 
-// OpAtomicISub performs the following steps atomically with respect to
-// any other atomic accesses within Scope to the same location:
-//
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by integer subtraction of Value from Original Value, and
-//   3) store the New Value back through Pointer.
-//
+// OpAtomicISub atomically subtracts Value from the value pointed to by
+// Pointer.
 type OpAtomicISub struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicISub) Opcode() uint32 { return opcodeAtomicISub }
 func (c *OpAtomicISub) Optional() bool { return false }
 func (c *OpAtomicISub) Verify() error  { return nil }
 
-// OpAtomicUMin performs the following steps atomically with respect to
-// any other atomic accesses within Scope to the same location:
-//
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by finding the smallest unsigned integer of
-//      Original Value and Value, and
-//   3) store the New Value back through Pointer.
-//
+// OpAtomicSMin atomically stores the signed minimum of Value and the value
+// pointed to by Pointer.
+type OpAtomicSMin struct {
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
+	Value      Id
+}
+
+func (c *OpAtomicSMin) Opcode() uint32 { return opcodeAtomicSMin }
+func (c *OpAtomicSMin) Optional() bool { return false }
+func (c *OpAtomicSMin) Verify() error  { return nil }
+
+// OpAtomicUMin atomically stores the unsigned minimum of Value and the value
+// pointed to by Pointer.
 type OpAtomicUMin struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicUMin) Opcode() uint32 { return opcodeAtomicUMin }
 func (c *OpAtomicUMin) Optional() bool { return false }
 func (c *OpAtomicUMin) Verify() error  { return nil }
 
-// OpAtomicUMax performs the following steps atomically with respect to
-// any other atomic accesses within Scope to the same location:
-//
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by finding the largest unsigned integer of Original
-//      Value and Value, and
-//   3) store the New Value back through Pointer.
-//
+// OpAtomicSMax atomically stores the signed maximum of Value and the value
+// pointed to by Pointer.
+type OpAtomicSMax struct {
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
+	Value      Id
+}
+
+func (c *OpAtomicSMax) Opcode() uint32 { return opcodeAtomicSMax }
+func (c *OpAtomicSMax) Optional() bool { return false }
+func (c *OpAtomicSMax) Verify() error  { return nil }
+
+// OpAtomicUMax atomically stores the unsigned maximum of Value and the value
+// pointed to by Pointer.
 type OpAtomicUMax struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicUMax) Opcode() uint32 { return opcodeAtomicUMax }
 func (c *OpAtomicUMax) Optional() bool { return false }
 func (c *OpAtomicUMax) Verify() error  { return nil }
 
-// OpAtomicAnd performs the following steps atomically with respect to
-// any other atomic accesses within Scope to the same location:
-//
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by the bitwise AND of Original Value and Value, and
-//   3) store the New Value back through Pointer.
-//
+// OpAtomicAnd atomically stores the bitwise AND of Value and the value
+// pointed to by Pointer.
 type OpAtomicAnd struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicAnd) Opcode() uint32 { return opcodeAtomicAnd }
 func (c *OpAtomicAnd) Optional() bool { return false }
 func (c *OpAtomicAnd) Verify() error  { return nil }
 
-// OpAtomicOr performs the following steps atomically with respect to
-// any other atomic accesses within Scope to the same location:
-//
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by the bitwise OR of Original Value and Value, and
-//   3) store the New Value back through Pointer.
-//
+// OpAtomicOr atomically stores the bitwise OR of Value and the value pointed
+// to by Pointer.
 type OpAtomicOr struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicOr) Opcode() uint32 { return opcodeAtomicOr }
 func (c *OpAtomicOr) Optional() bool { return false }
 func (c *OpAtomicOr) Verify() error  { return nil }
 
-// OpAtomicXor performs the following steps atomically with respect to
-// any other atomic accesses within Scope to the same location:
-//
-//   1) load through Pointer to get an Original Value,
-//   2) get a New Value by the bitwise exclusive OR of Original
-//      Value and Value, and
-//   3) store the New Value back through Pointer.
-//
+// OpAtomicXor atomically stores the bitwise exclusive OR of Value and the
+// value pointed to by Pointer.
 type OpAtomicXor struct {
-	ResultType     Id
-	ResultId       Id
-	Pointer        Id
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
-	Value          Id
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
+	Value      Id
 }
 
 func (c *OpAtomicXor) Opcode() uint32 { return opcodeAtomicXor }
 func (c *OpAtomicXor) Optional() bool { return false }
 func (c *OpAtomicXor) Verify() error  { return nil }
 
+// OpAtomicFlagTestAndSet atomically sets the flag pointed to by Pointer,
+// returning its previous state.
+type OpAtomicFlagTestAndSet struct {
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+	Memory     Id
+	Semantics  Id
+}
+
+func (c *OpAtomicFlagTestAndSet) Opcode() uint32 { return opcodeAtomicFlagTestAndSet }
+func (c *OpAtomicFlagTestAndSet) Optional() bool { return false }
+func (c *OpAtomicFlagTestAndSet) Verify() error  { return nil }
+
+// OpAtomicFlagClear atomically clears the flag pointed to by Pointer.
+type OpAtomicFlagClear struct {
+	Pointer   Id
+	Memory    Id
+	Semantics Id
+}
+
+func (c *OpAtomicFlagClear) Opcode() uint32 { return opcodeAtomicFlagClear }
+func (c *OpAtomicFlagClear) Optional() bool { return false }
+func (c *OpAtomicFlagClear) Verify() error  { return nil }
+
 func init() {
-	bind(func() Instruction { return &OpAtomicInit{} })
 	bind(func() Instruction { return &OpAtomicLoad{} })
 	bind(func() Instruction { return &OpAtomicStore{} })
 	bind(func() Instruction { return &OpAtomicExchange{} })
@@ -303,9 +274,13 @@ func init() {
 	bind(func() Instruction { return &OpAtomicIDecrement{} })
 	bind(func() Instruction { return &OpAtomicIAdd{} })
 	bind(func() Instruction { return &OpAtomicISub{} })
+	bind(func() Instruction { return &OpAtomicSMin{} })
 	bind(func() Instruction { return &OpAtomicUMin{} })
+	bind(func() Instruction { return &OpAtomicSMax{} })
 	bind(func() Instruction { return &OpAtomicUMax{} })
 	bind(func() Instruction { return &OpAtomicAnd{} })
 	bind(func() Instruction { return &OpAtomicOr{} })
 	bind(func() Instruction { return &OpAtomicXor{} })
+	bind(func() Instruction { return &OpAtomicFlagTestAndSet{} })
+	bind(func() Instruction { return &OpAtomicFlagClear{} })
 }
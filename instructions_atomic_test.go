@@ -8,230 +8,90 @@ import "testing"
 func TestAtomic(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x00300bf, 1, 2},
-			want: &OpAtomicInit{
-				Pointer: 1,
-				Value:   2,
-			},
-		},
-		{
-			in: []uint32{
-				0x00600c0, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-			},
+			in: []uint32{0x000600e3, 1, 2, 3, 4, 5},
 			want: &OpAtomicLoad{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
+				ResultType: 1,
+				ResultId:   2,
+				Pointer:    3,
+				Memory:     4,
+				Semantics:  5,
 			},
 		},
 		{
-			in: []uint32{
-				0x00500c1, 1,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-				2,
-			},
+			in: []uint32{0x000500e4, 1, 2, 3, 4},
 			want: &OpAtomicStore{
-				Pointer:        1,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
-				Value:          2,
+				Pointer:   1,
+				Memory:    2,
+				Semantics: 3,
+				Value:     4,
 			},
 		},
 		{
-			in: []uint32{
-				0x00700c2, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-				4,
-			},
+			in: []uint32{0x000700e5, 1, 2, 3, 4, 5, 6},
 			want: &OpAtomicExchange{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
-				Value:          4,
+				ResultType: 1,
+				ResultId:   2,
+				Pointer:    3,
+				Memory:     4,
+				Semantics:  5,
+				Value:      6,
 			},
 		},
 		{
-			in: []uint32{
-				0x00800c3, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-				4,
-				5,
-			},
+			in: []uint32{0x000900e6, 1, 2, 3, 4, 5, 6, 7, 8},
 			want: &OpAtomicCompareExchange{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
-				Value:          4,
-				Comparator:     5,
+				ResultType: 1,
+				ResultId:   2,
+				Pointer:    3,
+				Memory:     4,
+				Equal:      5,
+				Unequal:    6,
+				Value:      7,
+				Comparator: 8,
 			},
 		},
 		{
-			in: []uint32{
-				0x00800c4, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-				4,
-				5,
-			},
+			in: []uint32{0x000900e7, 1, 2, 3, 4, 5, 6, 7, 8},
 			want: &OpAtomicCompareExchangeWeak{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
-				Value:          4,
-				Comparator:     5,
+				ResultType: 1,
+				ResultId:   2,
+				Pointer:    3,
+				Memory:     4,
+				Equal:      5,
+				Unequal:    6,
+				Value:      7,
+				Comparator: 8,
 			},
 		},
 		{
-			in: []uint32{
-				0x00600c5, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-			},
+			in: []uint32{0x000600e8, 1, 2, 3, 4, 5},
 			want: &OpAtomicIIncrement{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
+				ResultType: 1,
+				ResultId:   2,
+				Pointer:    3,
+				Memory:     4,
+				Semantics:  5,
 			},
 		},
 		{
-			in: []uint32{
-				0x00600c6, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-			},
+			in: []uint32{0x000600e9, 1, 2, 3, 4, 5},
 			want: &OpAtomicIDecrement{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
+				ResultType: 1,
+				ResultId:   2,
+				Pointer:    3,
+				Memory:     4,
+				Semantics:  5,
 			},
 		},
 		{
-			in: []uint32{
-				0x00700c7, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-				4,
-			},
+			in: []uint32{0x000700ea, 1, 2, 3, 4, 5, 6},
 			want: &OpAtomicIAdd{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
-				Value:          4,
-			},
-		},
-		{
-			in: []uint32{
-				0x00700c8, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-				4,
-			},
-			want: &OpAtomicISub{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
-				Value:          4,
-			},
-		},
-		{
-			in: []uint32{
-				0x00700c9, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-				4,
-			},
-			want: &OpAtomicUMin{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
-				Value:          4,
-			},
-		},
-		{
-			in: []uint32{
-				0x00700ca, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-				4,
-			},
-			want: &OpAtomicUMax{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
-				Value:          4,
-			},
-		},
-		{
-			in: []uint32{
-				0x00700cb, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-				4,
-			},
-			want: &OpAtomicAnd{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
-				Value:          4,
-			},
-		},
-		{
-			in: []uint32{
-				0x00700cc, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-				4,
-			},
-			want: &OpAtomicOr{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
-				Value:          4,
-			},
-		},
-		{
-			in: []uint32{
-				0x00700cd, 1, 2, 3,
-				uint32(ExecutionScopeCrossDevice),
-				uint32(MemorySemanticAtomicCounterMemory),
-				4,
-			},
-			want: &OpAtomicXor{
-				ResultType:     1,
-				ResultId:       2,
-				Pointer:        3,
-				ExecutionScope: ExecutionScopeCrossDevice,
-				MemorySemantic: MemorySemanticAtomicCounterMemory,
-				Value:          4,
+				ResultType: 1,
+				ResultId:   2,
+				Pointer:    3,
+				Memory:     4,
+				Semantics:  5,
+				Value:      6,
 			},
 		},
 	} {
@@ -1,18 +1,16 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
 package spirv
 
-// OpControlBarrier waits for other invocations of this module to reach this
-// same point of execution. All invocations of this module within Scope must
-// reach this point of execution before any will proceed beyond it.
-//
-// This instruction is only guaranteed to work correctly if placed strictly
-// within dynamically uniform control flow within Scope. This ensures that if
-// any invocation executes it, all invocations will execute it. If placed
-// elsewhere, an invocation may stall indefinitely.
+// OpControlBarrier waits for other invocations of this module to reach the
+// current point of execution.
 type OpControlBarrier struct {
-	ExecutionScope ExecutionScope
+	Execution Id
+	Memory    Id
+	Semantics Id
 }
 
 func (c *OpControlBarrier) Opcode() uint32 { return opcodeControlBarrier }
@@ -20,21 +18,45 @@ func (c *OpControlBarrier) Optional() bool { return false }
 func (c *OpControlBarrier) Verify() error  { return nil }
 
 // OpMemoryBarrier controls the order that memory accesses are observed.
-//
-// Ensures that memory accesses issued before this instruction will be observed
-// before memory accesses issued after this instruction. This control is
-// ensured only for memory accesses issued by this invocation and observed by
-// another invocation executing within Scope.
 type OpMemoryBarrier struct {
-	ExecutionScope ExecutionScope
-	MemorySemantic MemorySemantic
+	Memory    Id
+	Semantics Id
 }
 
 func (c *OpMemoryBarrier) Opcode() uint32 { return opcodeMemoryBarrier }
 func (c *OpMemoryBarrier) Optional() bool { return false }
 func (c *OpMemoryBarrier) Verify() error  { return nil }
 
+// OpNamedBarrierInitialize declares a new named-barrier object.
+//
+// This instruction requires SPIR-V 1.1 or later.
+type OpNamedBarrierInitialize struct {
+	ResultType    Id
+	ResultId      Id
+	SubgroupCount Id
+}
+
+func (c *OpNamedBarrierInitialize) Opcode() uint32 { return opcodeNamedBarrierInitialize }
+func (c *OpNamedBarrierInitialize) Optional() bool { return false }
+func (c *OpNamedBarrierInitialize) Verify() error  { return nil }
+
+// OpMemoryNamedBarrier waits for other invocations of this module to reach
+// the current point of execution.
+//
+// This instruction requires SPIR-V 1.1 or later.
+type OpMemoryNamedBarrier struct {
+	NamedBarrier Id
+	Memory       Id
+	Semantics    Id
+}
+
+func (c *OpMemoryNamedBarrier) Opcode() uint32 { return opcodeMemoryNamedBarrier }
+func (c *OpMemoryNamedBarrier) Optional() bool { return false }
+func (c *OpMemoryNamedBarrier) Verify() error  { return nil }
+
 func init() {
 	bind(func() Instruction { return &OpControlBarrier{} })
 	bind(func() Instruction { return &OpMemoryBarrier{} })
+	bind(func() Instruction { return &OpNamedBarrierInitialize{} })
+	bind(func() Instruction { return &OpMemoryNamedBarrier{} })
 }
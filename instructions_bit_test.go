@@ -0,0 +1,86 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "testing"
+
+func TestBit(t *testing.T) {
+	for _, st := range []InstructionTest{
+		{
+			in: []uint32{0x000500c2, 1, 2, 3, 4},
+			want: &OpShiftRightLogical{
+				ResultType: 1,
+				ResultId:   2,
+				Base:       3,
+				Shift:      4,
+			},
+		},
+		{
+			in: []uint32{0x000500c3, 1, 2, 3, 4},
+			want: &OpShiftRightArithmetic{
+				ResultType: 1,
+				ResultId:   2,
+				Base:       3,
+				Shift:      4,
+			},
+		},
+		{
+			in: []uint32{0x000500c4, 1, 2, 3, 4},
+			want: &OpShiftLeftLogical{
+				ResultType: 1,
+				ResultId:   2,
+				Base:       3,
+				Shift:      4,
+			},
+		},
+		{
+			in: []uint32{0x000500c5, 1, 2, 3, 4},
+			want: &OpBitwiseOr{
+				ResultType: 1,
+				ResultId:   2,
+				Operand1:   3,
+				Operand2:   4,
+			},
+		},
+		{
+			in: []uint32{0x000500c6, 1, 2, 3, 4},
+			want: &OpBitwiseXor{
+				ResultType: 1,
+				ResultId:   2,
+				Operand1:   3,
+				Operand2:   4,
+			},
+		},
+		{
+			in: []uint32{0x000500c7, 1, 2, 3, 4},
+			want: &OpBitwiseAnd{
+				ResultType: 1,
+				ResultId:   2,
+				Operand1:   3,
+				Operand2:   4,
+			},
+		},
+		{
+			in: []uint32{0x000400c8, 1, 2, 3},
+			want: &OpNot{
+				ResultType: 1,
+				ResultId:   2,
+				Operand:    3,
+			},
+		},
+		{
+			in: []uint32{0x000700c9, 1, 2, 3, 4, 5, 6},
+			want: &OpBitFieldInsert{
+				ResultType: 1,
+				ResultId:   2,
+				Base:       3,
+				Insert:     4,
+				Offset:     5,
+				Count:      6,
+			},
+		},
+	} {
+		testInstruction(t, st)
+	}
+}
@@ -0,0 +1,8926 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
+package spirv
+
+func (c *OpNop) OperandLen() int { return 0 }
+
+func (c *OpNop) EncodeOperands(out []uint32) int { return 0 }
+
+func (c *OpNop) DecodeOperands(argv []uint32) error { return nil }
+
+func (c *OpUndef) OperandLen() int { return 2 }
+
+func (c *OpUndef) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	return 2
+}
+
+func (c *OpUndef) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	return nil
+}
+
+func (c *OpSizeOf) OperandLen() int { return 3 }
+
+func (c *OpSizeOf) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	return 3
+}
+
+func (c *OpSizeOf) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	return nil
+}
+
+func (c *OpSourceContinued) OperandLen() int {
+	var n int
+	n += int(c.ContinuedSource.EncodedLen())
+	return n
+}
+
+func (c *OpSourceContinued) EncodeOperands(out []uint32) int {
+	var n int
+	n += c.ContinuedSource.Encode(out[n:])
+	return n
+}
+
+func (c *OpSourceContinued) DecodeOperands(argv []uint32) error {
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+	c.ContinuedSource, _ = decodeStringOperand(argv)
+	return nil
+}
+
+func (c *OpSource) OperandLen() int {
+	n := 2
+	if c.File != 0 {
+		n++
+	}
+	if len(c.Source) > 0 {
+		n += int(c.Source.EncodedLen())
+	}
+	return n
+}
+
+func (c *OpSource) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.SourceLanguage)
+	out[1] = c.Version
+	n := 2
+	if c.File != 0 {
+		out[n] = uint32(c.File)
+		n++
+	}
+	if len(c.Source) > 0 {
+		n += c.Source.Encode(out[n:])
+	}
+	return n
+}
+
+func (c *OpSource) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.SourceLanguage = SourceLanguage(argv[0])
+	c.Version = argv[1]
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		c.File = Id(argv[0])
+		argv = argv[1:]
+	}
+
+	if len(argv) > 0 {
+		c.Source, _ = decodeStringOperand(argv)
+	}
+	return nil
+}
+
+func (c *OpSourceExtension) OperandLen() int {
+	var n int
+	n += int(c.Extension.EncodedLen())
+	return n
+}
+
+func (c *OpSourceExtension) EncodeOperands(out []uint32) int {
+	var n int
+	n += c.Extension.Encode(out[n:])
+	return n
+}
+
+func (c *OpSourceExtension) DecodeOperands(argv []uint32) error {
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+	c.Extension, _ = decodeStringOperand(argv)
+	return nil
+}
+
+func (c *OpName) OperandLen() int {
+	n := 1
+	n += int(c.Name.EncodedLen())
+	return n
+}
+
+func (c *OpName) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Target)
+	n := 1
+	n += c.Name.Encode(out[n:])
+	return n
+}
+
+func (c *OpName) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Target = Id(argv[0])
+	argv = argv[1:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+	c.Name, _ = decodeStringOperand(argv)
+	return nil
+}
+
+func (c *OpMemberName) OperandLen() int {
+	n := 2
+	n += int(c.Name.EncodedLen())
+	return n
+}
+
+func (c *OpMemberName) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Type)
+	out[1] = c.Member
+	n := 2
+	n += c.Name.Encode(out[n:])
+	return n
+}
+
+func (c *OpMemberName) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Type = Id(argv[0])
+	c.Member = argv[1]
+	argv = argv[2:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+	c.Name, _ = decodeStringOperand(argv)
+	return nil
+}
+
+func (c *OpString) OperandLen() int {
+	n := 1
+	n += int(c.String.EncodedLen())
+	return n
+}
+
+func (c *OpString) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	n := 1
+	n += c.String.Encode(out[n:])
+	return n
+}
+
+func (c *OpString) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	argv = argv[1:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+	c.String, _ = decodeStringOperand(argv)
+	return nil
+}
+
+func (c *OpLine) OperandLen() int { return 3 }
+
+func (c *OpLine) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.File)
+	out[1] = c.Line
+	out[2] = c.Column
+	return 3
+}
+
+func (c *OpLine) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.File = Id(argv[0])
+	c.Line = argv[1]
+	c.Column = argv[2]
+	return nil
+}
+
+func (c *OpNoLine) OperandLen() int { return 0 }
+
+func (c *OpNoLine) EncodeOperands(out []uint32) int { return 0 }
+
+func (c *OpNoLine) DecodeOperands(argv []uint32) error { return nil }
+
+func (c *OpModuleProcessed) OperandLen() int {
+	var n int
+	n += int(c.Process.EncodedLen())
+	return n
+}
+
+func (c *OpModuleProcessed) EncodeOperands(out []uint32) int {
+	var n int
+	n += c.Process.Encode(out[n:])
+	return n
+}
+
+func (c *OpModuleProcessed) DecodeOperands(argv []uint32) error {
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+	c.Process, _ = decodeStringOperand(argv)
+	return nil
+}
+
+func (c *OpDecorate) OperandLen() int {
+	n := 2
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpDecorate) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Target)
+	out[1] = uint32(c.Decoration)
+	n := 2
+	n += copy(out[n:], c.Argv)
+	return n
+}
+
+func (c *OpDecorate) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Target = Id(argv[0])
+	c.Decoration = Decoration(argv[1])
+	argv = argv[2:]
+
+	c.Argv = Copy(argv)
+	return nil
+}
+
+func (c *OpMemberDecorate) OperandLen() int {
+	n := 3
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpMemberDecorate) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.StructureType)
+	out[1] = c.Member
+	out[2] = uint32(c.Decoration)
+	n := 3
+	n += copy(out[n:], c.Argv)
+	return n
+}
+
+func (c *OpMemberDecorate) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.StructureType = Id(argv[0])
+	c.Member = argv[1]
+	c.Decoration = Decoration(argv[2])
+	argv = argv[3:]
+
+	c.Argv = Copy(argv)
+	return nil
+}
+
+func (c *OpDecorationGroup) OperandLen() int { return 1 }
+
+func (c *OpDecorationGroup) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	return 1
+}
+
+func (c *OpDecorationGroup) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	return nil
+}
+
+func (c *OpGroupDecorate) OperandLen() int {
+	n := 1
+	n += len(c.Targets)
+	return n
+}
+
+func (c *OpGroupDecorate) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.DecorationGroup)
+	n := 1
+	for _, v := range c.Targets {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupDecorate) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.DecorationGroup = Id(argv[0])
+	argv = argv[1:]
+
+	c.Targets = decodeIds(argv)
+	return nil
+}
+
+func (c *OpGroupMemberDecorate) OperandLen() int {
+	n := 1
+	n += len(c.Targets)
+	return n
+}
+
+func (c *OpGroupMemberDecorate) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.DecorationGroup)
+	n := 1
+	n += copy(out[n:], c.Targets)
+	return n
+}
+
+func (c *OpGroupMemberDecorate) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.DecorationGroup = Id(argv[0])
+	argv = argv[1:]
+
+	c.Targets = Copy(argv)
+	return nil
+}
+
+func (c *OpDecorateId) OperandLen() int {
+	n := 2
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpDecorateId) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Target)
+	out[1] = uint32(c.Decoration)
+	n := 2
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpDecorateId) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Target = Id(argv[0])
+	c.Decoration = Decoration(argv[1])
+	argv = argv[2:]
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpDecorateString) OperandLen() int {
+	n := 2
+	n += int(c.Value.EncodedLen())
+	return n
+}
+
+func (c *OpDecorateString) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Target)
+	out[1] = uint32(c.Decoration)
+	n := 2
+	n += c.Value.Encode(out[n:])
+	return n
+}
+
+func (c *OpDecorateString) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Target = Id(argv[0])
+	c.Decoration = Decoration(argv[1])
+	argv = argv[2:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+	c.Value, _ = decodeStringOperand(argv)
+	return nil
+}
+
+func (c *OpMemberDecorateString) OperandLen() int {
+	n := 3
+	n += int(c.Value.EncodedLen())
+	return n
+}
+
+func (c *OpMemberDecorateString) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.StructType)
+	out[1] = c.Member
+	out[2] = uint32(c.Decoration)
+	n := 3
+	n += c.Value.Encode(out[n:])
+	return n
+}
+
+func (c *OpMemberDecorateString) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.StructType = Id(argv[0])
+	c.Member = argv[1]
+	c.Decoration = Decoration(argv[2])
+	argv = argv[3:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+	c.Value, _ = decodeStringOperand(argv)
+	return nil
+}
+
+func (c *OpExtension) OperandLen() int {
+	var n int
+	n += int(c.Name.EncodedLen())
+	return n
+}
+
+func (c *OpExtension) EncodeOperands(out []uint32) int {
+	var n int
+	n += c.Name.Encode(out[n:])
+	return n
+}
+
+func (c *OpExtension) DecodeOperands(argv []uint32) error {
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+	c.Name, _ = decodeStringOperand(argv)
+	return nil
+}
+
+func (c *OpExtInstImport) OperandLen() int {
+	n := 1
+	n += int(c.Name.EncodedLen())
+	return n
+}
+
+func (c *OpExtInstImport) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	n := 1
+	n += c.Name.Encode(out[n:])
+	return n
+}
+
+func (c *OpExtInstImport) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	argv = argv[1:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+	c.Name, _ = decodeStringOperand(argv)
+	return nil
+}
+
+func (c *OpExtInst) OperandLen() int {
+	n := 4
+	n += len(c.Operands)
+	return n
+}
+
+func (c *OpExtInst) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Set)
+	out[3] = c.Instruction
+	n := 4
+	for _, v := range c.Operands {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpExtInst) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Set = Id(argv[2])
+	c.Instruction = argv[3]
+	argv = argv[4:]
+
+	c.Operands = decodeIds(argv)
+	return nil
+}
+
+func (c *OpMemoryModel) OperandLen() int { return 2 }
+
+func (c *OpMemoryModel) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.AddressingModel)
+	out[1] = uint32(c.MemoryModel)
+	return 2
+}
+
+func (c *OpMemoryModel) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.AddressingModel = AddressingModel(argv[0])
+	c.MemoryModel = MemoryModel(argv[1])
+	return nil
+}
+
+func (c *OpEntryPoint) OperandLen() int {
+	n := 2
+	n += int(c.Name.EncodedLen())
+	n += len(c.Interface)
+	return n
+}
+
+func (c *OpEntryPoint) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ExecutionModel)
+	out[1] = uint32(c.EntryPoint)
+	n := 2
+	n += c.Name.Encode(out[n:])
+	for _, v := range c.Interface {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpEntryPoint) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ExecutionModel = ExecutionModel(argv[0])
+	c.EntryPoint = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+	c.Name, argv = decodeStringOperand(argv)
+
+	c.Interface = decodeIds(argv)
+	return nil
+}
+
+func (c *OpExecutionMode) OperandLen() int {
+	n := 2
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpExecutionMode) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.EntryPoint)
+	out[1] = uint32(c.Mode)
+	n := 2
+	n += copy(out[n:], c.Argv)
+	return n
+}
+
+func (c *OpExecutionMode) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.EntryPoint = Id(argv[0])
+	c.Mode = ExecutionMode(argv[1])
+	argv = argv[2:]
+
+	c.Argv = Copy(argv)
+	return nil
+}
+
+func (c *OpCapability) OperandLen() int { return 1 }
+
+func (c *OpCapability) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Capability)
+	return 1
+}
+
+func (c *OpCapability) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Capability = Capability(argv[0])
+	return nil
+}
+
+func (c *OpExecutionModeId) OperandLen() int {
+	n := 2
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpExecutionModeId) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.EntryPoint)
+	out[1] = uint32(c.Mode)
+	n := 2
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpExecutionModeId) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.EntryPoint = Id(argv[0])
+	c.Mode = ExecutionMode(argv[1])
+	argv = argv[2:]
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpTypeVoid) OperandLen() int { return 1 }
+
+func (c *OpTypeVoid) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	return 1
+}
+
+func (c *OpTypeVoid) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	return nil
+}
+
+func (c *OpTypeBool) OperandLen() int { return 1 }
+
+func (c *OpTypeBool) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	return 1
+}
+
+func (c *OpTypeBool) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	return nil
+}
+
+func (c *OpTypeInt) OperandLen() int { return 3 }
+
+func (c *OpTypeInt) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	out[1] = c.Width
+	out[2] = c.Signedness
+	return 3
+}
+
+func (c *OpTypeInt) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.Width = argv[1]
+	c.Signedness = argv[2]
+	return nil
+}
+
+func (c *OpTypeFloat) OperandLen() int { return 2 }
+
+func (c *OpTypeFloat) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	out[1] = c.Width
+	return 2
+}
+
+func (c *OpTypeFloat) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.Width = argv[1]
+	return nil
+}
+
+func (c *OpTypeVector) OperandLen() int { return 3 }
+
+func (c *OpTypeVector) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	out[1] = uint32(c.ComponentType)
+	out[2] = c.ComponentCount
+	return 3
+}
+
+func (c *OpTypeVector) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.ComponentType = Id(argv[1])
+	c.ComponentCount = argv[2]
+	return nil
+}
+
+func (c *OpTypeMatrix) OperandLen() int { return 3 }
+
+func (c *OpTypeMatrix) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	out[1] = uint32(c.ColumnType)
+	out[2] = c.ColumnCount
+	return 3
+}
+
+func (c *OpTypeMatrix) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.ColumnType = Id(argv[1])
+	c.ColumnCount = argv[2]
+	return nil
+}
+
+func (c *OpTypeImage) OperandLen() int {
+	n := 8
+	if c.AccessQualifier != nil {
+		n++
+	}
+	return n
+}
+
+func (c *OpTypeImage) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	out[1] = uint32(c.SampledType)
+	out[2] = uint32(c.Dim)
+	out[3] = c.Depth
+	out[4] = c.Arrayed
+	out[5] = c.MS
+	out[6] = c.Sampled
+	out[7] = uint32(c.ImageFormat)
+	n := 8
+	if c.AccessQualifier != nil {
+		out[n] = uint32(*c.AccessQualifier)
+		n++
+	}
+	return n
+}
+
+func (c *OpTypeImage) DecodeOperands(argv []uint32) error {
+	if len(argv) < 8 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.SampledType = Id(argv[1])
+	c.Dim = Dim(argv[2])
+	c.Depth = argv[3]
+	c.Arrayed = argv[4]
+	c.MS = argv[5]
+	c.Sampled = argv[6]
+	c.ImageFormat = ImageFormat(argv[7])
+	argv = argv[8:]
+
+	if len(argv) > 0 {
+		v := AccessQualifier(argv[0])
+		c.AccessQualifier = &v
+	}
+	return nil
+}
+
+func (c *OpTypeSampler) OperandLen() int { return 1 }
+
+func (c *OpTypeSampler) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	return 1
+}
+
+func (c *OpTypeSampler) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	return nil
+}
+
+func (c *OpTypeSampledImage) OperandLen() int { return 2 }
+
+func (c *OpTypeSampledImage) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	out[1] = uint32(c.ImageType)
+	return 2
+}
+
+func (c *OpTypeSampledImage) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.ImageType = Id(argv[1])
+	return nil
+}
+
+func (c *OpTypeArray) OperandLen() int { return 3 }
+
+func (c *OpTypeArray) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	out[1] = uint32(c.ElementType)
+	out[2] = uint32(c.Length)
+	return 3
+}
+
+func (c *OpTypeArray) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.ElementType = Id(argv[1])
+	c.Length = Id(argv[2])
+	return nil
+}
+
+func (c *OpTypeRuntimeArray) OperandLen() int { return 2 }
+
+func (c *OpTypeRuntimeArray) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	out[1] = uint32(c.ElementType)
+	return 2
+}
+
+func (c *OpTypeRuntimeArray) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.ElementType = Id(argv[1])
+	return nil
+}
+
+func (c *OpTypeStruct) OperandLen() int {
+	n := 1
+	n += len(c.Members)
+	return n
+}
+
+func (c *OpTypeStruct) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	n := 1
+	for _, v := range c.Members {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpTypeStruct) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	argv = argv[1:]
+
+	c.Members = decodeIds(argv)
+	return nil
+}
+
+func (c *OpTypeOpaque) OperandLen() int {
+	n := 1
+	n += int(c.Name.EncodedLen())
+	return n
+}
+
+func (c *OpTypeOpaque) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	n := 1
+	n += c.Name.Encode(out[n:])
+	return n
+}
+
+func (c *OpTypeOpaque) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	argv = argv[1:]
+
+	if len(argv) == 0 {
+		return ErrMissingInstructionArgs
+	}
+	c.Name, _ = decodeStringOperand(argv)
+	return nil
+}
+
+func (c *OpTypePointer) OperandLen() int { return 3 }
+
+func (c *OpTypePointer) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	out[1] = uint32(c.StorageClass)
+	out[2] = uint32(c.Type)
+	return 3
+}
+
+func (c *OpTypePointer) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.StorageClass = StorageClass(argv[1])
+	c.Type = Id(argv[2])
+	return nil
+}
+
+func (c *OpTypeFunction) OperandLen() int {
+	n := 2
+	n += len(c.Parameters)
+	return n
+}
+
+func (c *OpTypeFunction) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	out[1] = uint32(c.ReturnType)
+	n := 2
+	for _, v := range c.Parameters {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpTypeFunction) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.ReturnType = Id(argv[1])
+	argv = argv[2:]
+
+	c.Parameters = decodeIds(argv)
+	return nil
+}
+
+func (c *OpTypeEvent) OperandLen() int { return 1 }
+
+func (c *OpTypeEvent) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	return 1
+}
+
+func (c *OpTypeEvent) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	return nil
+}
+
+func (c *OpTypeDeviceEvent) OperandLen() int { return 1 }
+
+func (c *OpTypeDeviceEvent) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	return 1
+}
+
+func (c *OpTypeDeviceEvent) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	return nil
+}
+
+func (c *OpTypeReserveId) OperandLen() int { return 1 }
+
+func (c *OpTypeReserveId) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	return 1
+}
+
+func (c *OpTypeReserveId) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	return nil
+}
+
+func (c *OpTypeQueue) OperandLen() int { return 1 }
+
+func (c *OpTypeQueue) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	return 1
+}
+
+func (c *OpTypeQueue) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	return nil
+}
+
+func (c *OpTypePipe) OperandLen() int { return 2 }
+
+func (c *OpTypePipe) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	out[1] = uint32(c.Qualifier)
+	return 2
+}
+
+func (c *OpTypePipe) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	c.Qualifier = AccessQualifier(argv[1])
+	return nil
+}
+
+func (c *OpTypeForwardPointer) OperandLen() int { return 2 }
+
+func (c *OpTypeForwardPointer) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.PointerType)
+	out[1] = uint32(c.StorageClass)
+	return 2
+}
+
+func (c *OpTypeForwardPointer) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.PointerType = Id(argv[0])
+	c.StorageClass = StorageClass(argv[1])
+	return nil
+}
+
+func (c *OpTypePipeStorage) OperandLen() int { return 1 }
+
+func (c *OpTypePipeStorage) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	return 1
+}
+
+func (c *OpTypePipeStorage) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	return nil
+}
+
+func (c *OpTypeNamedBarrier) OperandLen() int { return 1 }
+
+func (c *OpTypeNamedBarrier) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	return 1
+}
+
+func (c *OpTypeNamedBarrier) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	return nil
+}
+
+func (c *OpConstantTrue) OperandLen() int { return 2 }
+
+func (c *OpConstantTrue) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	return 2
+}
+
+func (c *OpConstantTrue) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	return nil
+}
+
+func (c *OpConstantFalse) OperandLen() int { return 2 }
+
+func (c *OpConstantFalse) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	return 2
+}
+
+func (c *OpConstantFalse) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	return nil
+}
+
+func (c *OpConstant) OperandLen() int {
+	n := 2
+	n += len(c.Value)
+	return n
+}
+
+func (c *OpConstant) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	n := 2
+	n += copy(out[n:], c.Value)
+	return n
+}
+
+func (c *OpConstant) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	argv = argv[2:]
+
+	c.Value = Copy(argv)
+	return nil
+}
+
+func (c *OpConstantComposite) OperandLen() int {
+	n := 2
+	n += len(c.Constituents)
+	return n
+}
+
+func (c *OpConstantComposite) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	n := 2
+	for _, v := range c.Constituents {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpConstantComposite) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	argv = argv[2:]
+
+	c.Constituents = decodeIds(argv)
+	return nil
+}
+
+func (c *OpConstantSampler) OperandLen() int { return 5 }
+
+func (c *OpConstantSampler) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SamplerAddressingMode)
+	out[3] = c.Param
+	out[4] = uint32(c.SamplerFilterMode)
+	return 5
+}
+
+func (c *OpConstantSampler) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SamplerAddressingMode = SamplerAddressingMode(argv[2])
+	c.Param = argv[3]
+	c.SamplerFilterMode = SamplerFilterMode(argv[4])
+	return nil
+}
+
+func (c *OpConstantNull) OperandLen() int { return 2 }
+
+func (c *OpConstantNull) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	return 2
+}
+
+func (c *OpConstantNull) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	return nil
+}
+
+func (c *OpSpecConstantTrue) OperandLen() int { return 2 }
+
+func (c *OpSpecConstantTrue) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	return 2
+}
+
+func (c *OpSpecConstantTrue) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	return nil
+}
+
+func (c *OpSpecConstantFalse) OperandLen() int { return 2 }
+
+func (c *OpSpecConstantFalse) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	return 2
+}
+
+func (c *OpSpecConstantFalse) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	return nil
+}
+
+func (c *OpSpecConstant) OperandLen() int {
+	n := 2
+	n += len(c.Value)
+	return n
+}
+
+func (c *OpSpecConstant) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	n := 2
+	n += copy(out[n:], c.Value)
+	return n
+}
+
+func (c *OpSpecConstant) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	argv = argv[2:]
+
+	c.Value = Copy(argv)
+	return nil
+}
+
+func (c *OpSpecConstantComposite) OperandLen() int {
+	n := 2
+	n += len(c.Constituents)
+	return n
+}
+
+func (c *OpSpecConstantComposite) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	n := 2
+	for _, v := range c.Constituents {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpSpecConstantComposite) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	argv = argv[2:]
+
+	c.Constituents = decodeIds(argv)
+	return nil
+}
+
+func (c *OpSpecConstantOp) OperandLen() int {
+	n := 3
+	n += len(c.Operands)
+	return n
+}
+
+func (c *OpSpecConstantOp) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = c.Operation
+	n := 3
+	for _, v := range c.Operands {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpSpecConstantOp) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operation = argv[2]
+	argv = argv[3:]
+
+	c.Operands = decodeIds(argv)
+	return nil
+}
+
+func (c *OpVariable) OperandLen() int {
+	n := 3
+	if c.Initializer != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpVariable) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.StorageClass)
+	n := 3
+	if c.Initializer != 0 {
+		out[n] = uint32(c.Initializer)
+		n++
+	}
+	return n
+}
+
+func (c *OpVariable) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.StorageClass = StorageClass(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		c.Initializer = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpImageTexelPointer) OperandLen() int { return 5 }
+
+func (c *OpImageTexelPointer) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Image)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Sample)
+	return 5
+}
+
+func (c *OpImageTexelPointer) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Sample = Id(argv[4])
+	return nil
+}
+
+func (c *OpLoad) OperandLen() int {
+	n := 3
+	if c.MemoryAccess != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpLoad) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	n := 3
+	if c.MemoryAccess != nil {
+		out[n] = uint32(*c.MemoryAccess)
+		n++
+	}
+	n += copy(out[n:], c.Argv)
+	return n
+}
+
+func (c *OpLoad) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		v := MemoryAccess(argv[0])
+		c.MemoryAccess = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = Copy(argv)
+	return nil
+}
+
+func (c *OpStore) OperandLen() int {
+	n := 2
+	if c.MemoryAccess != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpStore) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Pointer)
+	out[1] = uint32(c.Object)
+	n := 2
+	if c.MemoryAccess != nil {
+		out[n] = uint32(*c.MemoryAccess)
+		n++
+	}
+	n += copy(out[n:], c.Argv)
+	return n
+}
+
+func (c *OpStore) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pointer = Id(argv[0])
+	c.Object = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		v := MemoryAccess(argv[0])
+		c.MemoryAccess = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = Copy(argv)
+	return nil
+}
+
+func (c *OpCopyMemory) OperandLen() int {
+	n := 2
+	if c.MemoryAccess != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpCopyMemory) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Target)
+	out[1] = uint32(c.Source)
+	n := 2
+	if c.MemoryAccess != nil {
+		out[n] = uint32(*c.MemoryAccess)
+		n++
+	}
+	n += copy(out[n:], c.Argv)
+	return n
+}
+
+func (c *OpCopyMemory) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Target = Id(argv[0])
+	c.Source = Id(argv[1])
+	argv = argv[2:]
+
+	if len(argv) > 0 {
+		v := MemoryAccess(argv[0])
+		c.MemoryAccess = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = Copy(argv)
+	return nil
+}
+
+func (c *OpCopyMemorySized) OperandLen() int {
+	n := 3
+	if c.MemoryAccess != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpCopyMemorySized) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Target)
+	out[1] = uint32(c.Source)
+	out[2] = uint32(c.Size)
+	n := 3
+	if c.MemoryAccess != nil {
+		out[n] = uint32(*c.MemoryAccess)
+		n++
+	}
+	n += copy(out[n:], c.Argv)
+	return n
+}
+
+func (c *OpCopyMemorySized) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Target = Id(argv[0])
+	c.Source = Id(argv[1])
+	c.Size = Id(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		v := MemoryAccess(argv[0])
+		c.MemoryAccess = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = Copy(argv)
+	return nil
+}
+
+func (c *OpAccessChain) OperandLen() int {
+	n := 3
+	n += len(c.Indices)
+	return n
+}
+
+func (c *OpAccessChain) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Base)
+	n := 3
+	for _, v := range c.Indices {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpAccessChain) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	argv = argv[3:]
+
+	c.Indices = decodeIds(argv)
+	return nil
+}
+
+func (c *OpInBoundsAccessChain) OperandLen() int {
+	n := 3
+	n += len(c.Indices)
+	return n
+}
+
+func (c *OpInBoundsAccessChain) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Base)
+	n := 3
+	for _, v := range c.Indices {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpInBoundsAccessChain) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	argv = argv[3:]
+
+	c.Indices = decodeIds(argv)
+	return nil
+}
+
+func (c *OpPtrAccessChain) OperandLen() int {
+	n := 4
+	n += len(c.Indices)
+	return n
+}
+
+func (c *OpPtrAccessChain) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Base)
+	out[3] = uint32(c.Element)
+	n := 4
+	for _, v := range c.Indices {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpPtrAccessChain) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Element = Id(argv[3])
+	argv = argv[4:]
+
+	c.Indices = decodeIds(argv)
+	return nil
+}
+
+func (c *OpArrayLength) OperandLen() int { return 4 }
+
+func (c *OpArrayLength) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Structure)
+	out[3] = c.ArrayMember
+	return 4
+}
+
+func (c *OpArrayLength) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Structure = Id(argv[2])
+	c.ArrayMember = argv[3]
+	return nil
+}
+
+func (c *OpGenericPtrMemSemantics) OperandLen() int { return 3 }
+
+func (c *OpGenericPtrMemSemantics) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	return 3
+}
+
+func (c *OpGenericPtrMemSemantics) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	return nil
+}
+
+func (c *OpInBoundsPtrAccessChain) OperandLen() int {
+	n := 4
+	n += len(c.Indices)
+	return n
+}
+
+func (c *OpInBoundsPtrAccessChain) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Base)
+	out[3] = uint32(c.Element)
+	n := 4
+	for _, v := range c.Indices {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpInBoundsPtrAccessChain) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Element = Id(argv[3])
+	argv = argv[4:]
+
+	c.Indices = decodeIds(argv)
+	return nil
+}
+
+func (c *OpPtrEqual) OperandLen() int { return 4 }
+
+func (c *OpPtrEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpPtrEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpPtrNotEqual) OperandLen() int { return 4 }
+
+func (c *OpPtrNotEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpPtrNotEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpPtrDiff) OperandLen() int { return 4 }
+
+func (c *OpPtrDiff) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpPtrDiff) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFunction) OperandLen() int { return 4 }
+
+func (c *OpFunction) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.FunctionControl)
+	out[3] = uint32(c.FunctionType)
+	return 4
+}
+
+func (c *OpFunction) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.FunctionControl = FunctionControl(argv[2])
+	c.FunctionType = Id(argv[3])
+	return nil
+}
+
+func (c *OpFunctionParameter) OperandLen() int { return 2 }
+
+func (c *OpFunctionParameter) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	return 2
+}
+
+func (c *OpFunctionParameter) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	return nil
+}
+
+func (c *OpFunctionEnd) OperandLen() int { return 0 }
+
+func (c *OpFunctionEnd) EncodeOperands(out []uint32) int { return 0 }
+
+func (c *OpFunctionEnd) DecodeOperands(argv []uint32) error { return nil }
+
+func (c *OpFunctionCall) OperandLen() int {
+	n := 3
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpFunctionCall) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Function)
+	n := 3
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpFunctionCall) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Function = Id(argv[2])
+	argv = argv[3:]
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpSampledImage) OperandLen() int { return 4 }
+
+func (c *OpSampledImage) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Image)
+	out[3] = uint32(c.Sampler)
+	return 4
+}
+
+func (c *OpSampledImage) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.Sampler = Id(argv[3])
+	return nil
+}
+
+func (c *OpImageSampleImplicitLod) OperandLen() int {
+	n := 4
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSampleImplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	n := 4
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSampleImplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSampleExplicitLod) OperandLen() int {
+	n := 5
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSampleExplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.ImageOperands)
+	n := 5
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSampleExplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.ImageOperands = ImageOperands(argv[4])
+	argv = argv[5:]
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSampleDrefImplicitLod) OperandLen() int {
+	n := 5
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSampleDrefImplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Dref)
+	n := 5
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSampleDrefImplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSampleDrefExplicitLod) OperandLen() int {
+	n := 6
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSampleDrefExplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Dref)
+	out[5] = uint32(c.ImageOperands)
+	n := 6
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSampleDrefExplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	c.ImageOperands = ImageOperands(argv[5])
+	argv = argv[6:]
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSampleProjImplicitLod) OperandLen() int {
+	n := 4
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSampleProjImplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	n := 4
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSampleProjImplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSampleProjExplicitLod) OperandLen() int {
+	n := 5
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSampleProjExplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.ImageOperands)
+	n := 5
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSampleProjExplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.ImageOperands = ImageOperands(argv[4])
+	argv = argv[5:]
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSampleProjDrefImplicitLod) OperandLen() int {
+	n := 5
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSampleProjDrefImplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Dref)
+	n := 5
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSampleProjDrefImplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSampleProjDrefExplicitLod) OperandLen() int {
+	n := 6
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSampleProjDrefExplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Dref)
+	out[5] = uint32(c.ImageOperands)
+	n := 6
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSampleProjDrefExplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	c.ImageOperands = ImageOperands(argv[5])
+	argv = argv[6:]
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageFetch) OperandLen() int {
+	n := 4
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageFetch) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Image)
+	out[3] = uint32(c.Coordinate)
+	n := 4
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageFetch) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageGather) OperandLen() int {
+	n := 5
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageGather) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Component)
+	n := 5
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageGather) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Component = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageDrefGather) OperandLen() int {
+	n := 5
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageDrefGather) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Dref)
+	n := 5
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageDrefGather) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageRead) OperandLen() int {
+	n := 4
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageRead) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Image)
+	out[3] = uint32(c.Coordinate)
+	n := 4
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageRead) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageWrite) OperandLen() int {
+	n := 3
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageWrite) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Image)
+	out[1] = uint32(c.Coordinate)
+	out[2] = uint32(c.Texel)
+	n := 3
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageWrite) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Image = Id(argv[0])
+	c.Coordinate = Id(argv[1])
+	c.Texel = Id(argv[2])
+	argv = argv[3:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImage) OperandLen() int { return 3 }
+
+func (c *OpImage) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	return 3
+}
+
+func (c *OpImage) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	return nil
+}
+
+func (c *OpImageQueryFormat) OperandLen() int { return 3 }
+
+func (c *OpImageQueryFormat) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Image)
+	return 3
+}
+
+func (c *OpImageQueryFormat) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	return nil
+}
+
+func (c *OpImageQueryOrder) OperandLen() int { return 3 }
+
+func (c *OpImageQueryOrder) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Image)
+	return 3
+}
+
+func (c *OpImageQueryOrder) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	return nil
+}
+
+func (c *OpImageQuerySizeLod) OperandLen() int { return 4 }
+
+func (c *OpImageQuerySizeLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Image)
+	out[3] = uint32(c.LevelOfDetail)
+	return 4
+}
+
+func (c *OpImageQuerySizeLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.LevelOfDetail = Id(argv[3])
+	return nil
+}
+
+func (c *OpImageQuerySize) OperandLen() int { return 3 }
+
+func (c *OpImageQuerySize) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Image)
+	return 3
+}
+
+func (c *OpImageQuerySize) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	return nil
+}
+
+func (c *OpImageQueryLod) OperandLen() int { return 4 }
+
+func (c *OpImageQueryLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	return 4
+}
+
+func (c *OpImageQueryLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	return nil
+}
+
+func (c *OpImageQueryLevels) OperandLen() int { return 3 }
+
+func (c *OpImageQueryLevels) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Image)
+	return 3
+}
+
+func (c *OpImageQueryLevels) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	return nil
+}
+
+func (c *OpImageQuerySamples) OperandLen() int { return 3 }
+
+func (c *OpImageQuerySamples) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Image)
+	return 3
+}
+
+func (c *OpImageQuerySamples) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	return nil
+}
+
+func (c *OpImageSparseSampleImplicitLod) OperandLen() int {
+	n := 4
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSparseSampleImplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	n := 4
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseSampleImplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSparseSampleExplicitLod) OperandLen() int {
+	n := 5
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSparseSampleExplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.ImageOperands)
+	n := 5
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseSampleExplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.ImageOperands = ImageOperands(argv[4])
+	argv = argv[5:]
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSparseSampleDrefImplicitLod) OperandLen() int {
+	n := 5
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSparseSampleDrefImplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Dref)
+	n := 5
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseSampleDrefImplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSparseSampleDrefExplicitLod) OperandLen() int {
+	n := 6
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSparseSampleDrefExplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Dref)
+	out[5] = uint32(c.ImageOperands)
+	n := 6
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseSampleDrefExplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	c.ImageOperands = ImageOperands(argv[5])
+	argv = argv[6:]
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSparseSampleProjImplicitLod) OperandLen() int {
+	n := 4
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSparseSampleProjImplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	n := 4
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseSampleProjImplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSparseSampleProjExplicitLod) OperandLen() int {
+	n := 5
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSparseSampleProjExplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.ImageOperands)
+	n := 5
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseSampleProjExplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.ImageOperands = ImageOperands(argv[4])
+	argv = argv[5:]
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSparseSampleProjDrefImplicitLod) OperandLen() int {
+	n := 5
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSparseSampleProjDrefImplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Dref)
+	n := 5
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseSampleProjDrefImplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSparseSampleProjDrefExplicitLod) OperandLen() int {
+	n := 6
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSparseSampleProjDrefExplicitLod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Dref)
+	out[5] = uint32(c.ImageOperands)
+	n := 6
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseSampleProjDrefExplicitLod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	c.ImageOperands = ImageOperands(argv[5])
+	argv = argv[6:]
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSparseFetch) OperandLen() int {
+	n := 4
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSparseFetch) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Image)
+	out[3] = uint32(c.Coordinate)
+	n := 4
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseFetch) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSparseGather) OperandLen() int {
+	n := 5
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSparseGather) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Component)
+	n := 5
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseGather) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Component = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSparseDrefGather) OperandLen() int {
+	n := 5
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSparseDrefGather) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SampledImage)
+	out[3] = uint32(c.Coordinate)
+	out[4] = uint32(c.Dref)
+	n := 5
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseDrefGather) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SampledImage = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	c.Dref = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpImageSparseTexelsResident) OperandLen() int { return 3 }
+
+func (c *OpImageSparseTexelsResident) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.ResidentCode)
+	return 3
+}
+
+func (c *OpImageSparseTexelsResident) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.ResidentCode = Id(argv[2])
+	return nil
+}
+
+func (c *OpImageSparseRead) OperandLen() int {
+	n := 4
+	if c.ImageOperands != nil {
+		n++
+	}
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpImageSparseRead) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Image)
+	out[3] = uint32(c.Coordinate)
+	n := 4
+	if c.ImageOperands != nil {
+		out[n] = uint32(*c.ImageOperands)
+		n++
+	}
+	for _, v := range c.Argv {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpImageSparseRead) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Image = Id(argv[2])
+	c.Coordinate = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		v := ImageOperands(argv[0])
+		c.ImageOperands = &v
+		argv = argv[1:]
+	}
+
+	c.Argv = decodeIds(argv)
+	return nil
+}
+
+func (c *OpConvertFToU) OperandLen() int { return 3 }
+
+func (c *OpConvertFToU) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.FloatValue)
+	return 3
+}
+
+func (c *OpConvertFToU) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.FloatValue = Id(argv[2])
+	return nil
+}
+
+func (c *OpConvertFToS) OperandLen() int { return 3 }
+
+func (c *OpConvertFToS) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.FloatValue)
+	return 3
+}
+
+func (c *OpConvertFToS) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.FloatValue = Id(argv[2])
+	return nil
+}
+
+func (c *OpConvertSToF) OperandLen() int { return 3 }
+
+func (c *OpConvertSToF) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SignedValue)
+	return 3
+}
+
+func (c *OpConvertSToF) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SignedValue = Id(argv[2])
+	return nil
+}
+
+func (c *OpConvertUToF) OperandLen() int { return 3 }
+
+func (c *OpConvertUToF) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.UnsignedValue)
+	return 3
+}
+
+func (c *OpConvertUToF) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.UnsignedValue = Id(argv[2])
+	return nil
+}
+
+func (c *OpUConvert) OperandLen() int { return 3 }
+
+func (c *OpUConvert) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.UnsignedValue)
+	return 3
+}
+
+func (c *OpUConvert) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.UnsignedValue = Id(argv[2])
+	return nil
+}
+
+func (c *OpSConvert) OperandLen() int { return 3 }
+
+func (c *OpSConvert) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SignedValue)
+	return 3
+}
+
+func (c *OpSConvert) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SignedValue = Id(argv[2])
+	return nil
+}
+
+func (c *OpFConvert) OperandLen() int { return 3 }
+
+func (c *OpFConvert) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.FloatValue)
+	return 3
+}
+
+func (c *OpFConvert) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.FloatValue = Id(argv[2])
+	return nil
+}
+
+func (c *OpQuantizeToF16) OperandLen() int { return 3 }
+
+func (c *OpQuantizeToF16) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Value)
+	return 3
+}
+
+func (c *OpQuantizeToF16) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Value = Id(argv[2])
+	return nil
+}
+
+func (c *OpConvertPtrToU) OperandLen() int { return 3 }
+
+func (c *OpConvertPtrToU) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	return 3
+}
+
+func (c *OpConvertPtrToU) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	return nil
+}
+
+func (c *OpSatConvertSToU) OperandLen() int { return 3 }
+
+func (c *OpSatConvertSToU) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SignedValue)
+	return 3
+}
+
+func (c *OpSatConvertSToU) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SignedValue = Id(argv[2])
+	return nil
+}
+
+func (c *OpSatConvertUToS) OperandLen() int { return 3 }
+
+func (c *OpSatConvertUToS) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.UnsignedValue)
+	return 3
+}
+
+func (c *OpSatConvertUToS) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.UnsignedValue = Id(argv[2])
+	return nil
+}
+
+func (c *OpConvertUToPtr) OperandLen() int { return 3 }
+
+func (c *OpConvertUToPtr) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.IntegerValue)
+	return 3
+}
+
+func (c *OpConvertUToPtr) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.IntegerValue = Id(argv[2])
+	return nil
+}
+
+func (c *OpPtrCastToGeneric) OperandLen() int { return 3 }
+
+func (c *OpPtrCastToGeneric) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	return 3
+}
+
+func (c *OpPtrCastToGeneric) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	return nil
+}
+
+func (c *OpGenericCastToPtr) OperandLen() int { return 3 }
+
+func (c *OpGenericCastToPtr) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	return 3
+}
+
+func (c *OpGenericCastToPtr) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	return nil
+}
+
+func (c *OpGenericCastToPtrExplicit) OperandLen() int { return 4 }
+
+func (c *OpGenericCastToPtrExplicit) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Storage)
+	return 4
+}
+
+func (c *OpGenericCastToPtrExplicit) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Storage = StorageClass(argv[3])
+	return nil
+}
+
+func (c *OpBitcast) OperandLen() int { return 3 }
+
+func (c *OpBitcast) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand)
+	return 3
+}
+
+func (c *OpBitcast) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+	return nil
+}
+
+func (c *OpVectorExtractDynamic) OperandLen() int { return 4 }
+
+func (c *OpVectorExtractDynamic) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector)
+	out[3] = uint32(c.Index)
+	return 4
+}
+
+func (c *OpVectorExtractDynamic) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector = Id(argv[2])
+	c.Index = Id(argv[3])
+	return nil
+}
+
+func (c *OpVectorInsertDynamic) OperandLen() int { return 5 }
+
+func (c *OpVectorInsertDynamic) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector)
+	out[3] = uint32(c.Component)
+	out[4] = uint32(c.Index)
+	return 5
+}
+
+func (c *OpVectorInsertDynamic) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector = Id(argv[2])
+	c.Component = Id(argv[3])
+	c.Index = Id(argv[4])
+	return nil
+}
+
+func (c *OpVectorShuffle) OperandLen() int {
+	n := 4
+	n += len(c.Components)
+	return n
+}
+
+func (c *OpVectorShuffle) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector1)
+	out[3] = uint32(c.Vector2)
+	n := 4
+	n += copy(out[n:], c.Components)
+	return n
+}
+
+func (c *OpVectorShuffle) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector1 = Id(argv[2])
+	c.Vector2 = Id(argv[3])
+	argv = argv[4:]
+
+	c.Components = Copy(argv)
+	return nil
+}
+
+func (c *OpCompositeConstruct) OperandLen() int {
+	n := 2
+	n += len(c.Constituents)
+	return n
+}
+
+func (c *OpCompositeConstruct) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	n := 2
+	for _, v := range c.Constituents {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpCompositeConstruct) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	argv = argv[2:]
+
+	c.Constituents = decodeIds(argv)
+	return nil
+}
+
+func (c *OpCompositeExtract) OperandLen() int {
+	n := 3
+	n += len(c.Indices)
+	return n
+}
+
+func (c *OpCompositeExtract) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Composite)
+	n := 3
+	n += copy(out[n:], c.Indices)
+	return n
+}
+
+func (c *OpCompositeExtract) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Composite = Id(argv[2])
+	argv = argv[3:]
+
+	c.Indices = Copy(argv)
+	return nil
+}
+
+func (c *OpCompositeInsert) OperandLen() int {
+	n := 4
+	n += len(c.Indices)
+	return n
+}
+
+func (c *OpCompositeInsert) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Object)
+	out[3] = uint32(c.Composite)
+	n := 4
+	n += copy(out[n:], c.Indices)
+	return n
+}
+
+func (c *OpCompositeInsert) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Object = Id(argv[2])
+	c.Composite = Id(argv[3])
+	argv = argv[4:]
+
+	c.Indices = Copy(argv)
+	return nil
+}
+
+func (c *OpCopyObject) OperandLen() int { return 3 }
+
+func (c *OpCopyObject) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand)
+	return 3
+}
+
+func (c *OpCopyObject) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+	return nil
+}
+
+func (c *OpTranspose) OperandLen() int { return 3 }
+
+func (c *OpTranspose) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Matrix)
+	return 3
+}
+
+func (c *OpTranspose) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Matrix = Id(argv[2])
+	return nil
+}
+
+func (c *OpCopyLogical) OperandLen() int { return 3 }
+
+func (c *OpCopyLogical) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand)
+	return 3
+}
+
+func (c *OpCopyLogical) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+	return nil
+}
+
+func (c *OpSNegate) OperandLen() int { return 3 }
+
+func (c *OpSNegate) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand)
+	return 3
+}
+
+func (c *OpSNegate) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+	return nil
+}
+
+func (c *OpFNegate) OperandLen() int { return 3 }
+
+func (c *OpFNegate) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand)
+	return 3
+}
+
+func (c *OpFNegate) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+	return nil
+}
+
+func (c *OpIAdd) OperandLen() int { return 4 }
+
+func (c *OpIAdd) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpIAdd) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFAdd) OperandLen() int { return 4 }
+
+func (c *OpFAdd) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFAdd) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpISub) OperandLen() int { return 4 }
+
+func (c *OpISub) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpISub) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFSub) OperandLen() int { return 4 }
+
+func (c *OpFSub) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFSub) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpIMul) OperandLen() int { return 4 }
+
+func (c *OpIMul) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpIMul) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFMul) OperandLen() int { return 4 }
+
+func (c *OpFMul) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFMul) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpUDiv) OperandLen() int { return 4 }
+
+func (c *OpUDiv) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpUDiv) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpSDiv) OperandLen() int { return 4 }
+
+func (c *OpSDiv) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpSDiv) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFDiv) OperandLen() int { return 4 }
+
+func (c *OpFDiv) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFDiv) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpUMod) OperandLen() int { return 4 }
+
+func (c *OpUMod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpUMod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpSRem) OperandLen() int { return 4 }
+
+func (c *OpSRem) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpSRem) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpSMod) OperandLen() int { return 4 }
+
+func (c *OpSMod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpSMod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFRem) OperandLen() int { return 4 }
+
+func (c *OpFRem) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFRem) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFMod) OperandLen() int { return 4 }
+
+func (c *OpFMod) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFMod) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpVectorTimesScalar) OperandLen() int { return 4 }
+
+func (c *OpVectorTimesScalar) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector)
+	out[3] = uint32(c.Scalar)
+	return 4
+}
+
+func (c *OpVectorTimesScalar) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector = Id(argv[2])
+	c.Scalar = Id(argv[3])
+	return nil
+}
+
+func (c *OpMatrixTimesScalar) OperandLen() int { return 4 }
+
+func (c *OpMatrixTimesScalar) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Matrix)
+	out[3] = uint32(c.Scalar)
+	return 4
+}
+
+func (c *OpMatrixTimesScalar) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Matrix = Id(argv[2])
+	c.Scalar = Id(argv[3])
+	return nil
+}
+
+func (c *OpVectorTimesMatrix) OperandLen() int { return 4 }
+
+func (c *OpVectorTimesMatrix) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector)
+	out[3] = uint32(c.Matrix)
+	return 4
+}
+
+func (c *OpVectorTimesMatrix) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector = Id(argv[2])
+	c.Matrix = Id(argv[3])
+	return nil
+}
+
+func (c *OpMatrixTimesVector) OperandLen() int { return 4 }
+
+func (c *OpMatrixTimesVector) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Matrix)
+	out[3] = uint32(c.Vector)
+	return 4
+}
+
+func (c *OpMatrixTimesVector) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Matrix = Id(argv[2])
+	c.Vector = Id(argv[3])
+	return nil
+}
+
+func (c *OpMatrixTimesMatrix) OperandLen() int { return 4 }
+
+func (c *OpMatrixTimesMatrix) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.LeftMatrix)
+	out[3] = uint32(c.RightMatrix)
+	return 4
+}
+
+func (c *OpMatrixTimesMatrix) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.LeftMatrix = Id(argv[2])
+	c.RightMatrix = Id(argv[3])
+	return nil
+}
+
+func (c *OpOuterProduct) OperandLen() int { return 4 }
+
+func (c *OpOuterProduct) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector1)
+	out[3] = uint32(c.Vector2)
+	return 4
+}
+
+func (c *OpOuterProduct) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector1 = Id(argv[2])
+	c.Vector2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpDot) OperandLen() int { return 4 }
+
+func (c *OpDot) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector1)
+	out[3] = uint32(c.Vector2)
+	return 4
+}
+
+func (c *OpDot) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector1 = Id(argv[2])
+	c.Vector2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpIAddCarry) OperandLen() int { return 4 }
+
+func (c *OpIAddCarry) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpIAddCarry) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpISubBorrow) OperandLen() int { return 4 }
+
+func (c *OpISubBorrow) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpISubBorrow) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpUMulExtended) OperandLen() int { return 4 }
+
+func (c *OpUMulExtended) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpUMulExtended) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpSMulExtended) OperandLen() int { return 4 }
+
+func (c *OpSMulExtended) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpSMulExtended) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpSDot) OperandLen() int {
+	n := 4
+	if c.PackedVectorFormat != nil {
+		n++
+	}
+	return n
+}
+
+func (c *OpSDot) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector1)
+	out[3] = uint32(c.Vector2)
+	n := 4
+	if c.PackedVectorFormat != nil {
+		out[n] = uint32(*c.PackedVectorFormat)
+		n++
+	}
+	return n
+}
+
+func (c *OpSDot) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector1 = Id(argv[2])
+	c.Vector2 = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		v := PackedVectorFormat(argv[0])
+		c.PackedVectorFormat = &v
+	}
+	return nil
+}
+
+func (c *OpUDot) OperandLen() int {
+	n := 4
+	if c.PackedVectorFormat != nil {
+		n++
+	}
+	return n
+}
+
+func (c *OpUDot) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector1)
+	out[3] = uint32(c.Vector2)
+	n := 4
+	if c.PackedVectorFormat != nil {
+		out[n] = uint32(*c.PackedVectorFormat)
+		n++
+	}
+	return n
+}
+
+func (c *OpUDot) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector1 = Id(argv[2])
+	c.Vector2 = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		v := PackedVectorFormat(argv[0])
+		c.PackedVectorFormat = &v
+	}
+	return nil
+}
+
+func (c *OpSUDot) OperandLen() int {
+	n := 4
+	if c.PackedVectorFormat != nil {
+		n++
+	}
+	return n
+}
+
+func (c *OpSUDot) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector1)
+	out[3] = uint32(c.Vector2)
+	n := 4
+	if c.PackedVectorFormat != nil {
+		out[n] = uint32(*c.PackedVectorFormat)
+		n++
+	}
+	return n
+}
+
+func (c *OpSUDot) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector1 = Id(argv[2])
+	c.Vector2 = Id(argv[3])
+	argv = argv[4:]
+
+	if len(argv) > 0 {
+		v := PackedVectorFormat(argv[0])
+		c.PackedVectorFormat = &v
+	}
+	return nil
+}
+
+func (c *OpSDotAccSat) OperandLen() int {
+	n := 5
+	if c.PackedVectorFormat != nil {
+		n++
+	}
+	return n
+}
+
+func (c *OpSDotAccSat) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector1)
+	out[3] = uint32(c.Vector2)
+	out[4] = uint32(c.Accumulator)
+	n := 5
+	if c.PackedVectorFormat != nil {
+		out[n] = uint32(*c.PackedVectorFormat)
+		n++
+	}
+	return n
+}
+
+func (c *OpSDotAccSat) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector1 = Id(argv[2])
+	c.Vector2 = Id(argv[3])
+	c.Accumulator = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		v := PackedVectorFormat(argv[0])
+		c.PackedVectorFormat = &v
+	}
+	return nil
+}
+
+func (c *OpUDotAccSat) OperandLen() int {
+	n := 5
+	if c.PackedVectorFormat != nil {
+		n++
+	}
+	return n
+}
+
+func (c *OpUDotAccSat) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector1)
+	out[3] = uint32(c.Vector2)
+	out[4] = uint32(c.Accumulator)
+	n := 5
+	if c.PackedVectorFormat != nil {
+		out[n] = uint32(*c.PackedVectorFormat)
+		n++
+	}
+	return n
+}
+
+func (c *OpUDotAccSat) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector1 = Id(argv[2])
+	c.Vector2 = Id(argv[3])
+	c.Accumulator = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		v := PackedVectorFormat(argv[0])
+		c.PackedVectorFormat = &v
+	}
+	return nil
+}
+
+func (c *OpSUDotAccSat) OperandLen() int {
+	n := 5
+	if c.PackedVectorFormat != nil {
+		n++
+	}
+	return n
+}
+
+func (c *OpSUDotAccSat) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector1)
+	out[3] = uint32(c.Vector2)
+	out[4] = uint32(c.Accumulator)
+	n := 5
+	if c.PackedVectorFormat != nil {
+		out[n] = uint32(*c.PackedVectorFormat)
+		n++
+	}
+	return n
+}
+
+func (c *OpSUDotAccSat) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector1 = Id(argv[2])
+	c.Vector2 = Id(argv[3])
+	c.Accumulator = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		v := PackedVectorFormat(argv[0])
+		c.PackedVectorFormat = &v
+	}
+	return nil
+}
+
+func (c *OpShiftRightLogical) OperandLen() int { return 4 }
+
+func (c *OpShiftRightLogical) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Base)
+	out[3] = uint32(c.Shift)
+	return 4
+}
+
+func (c *OpShiftRightLogical) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Shift = Id(argv[3])
+	return nil
+}
+
+func (c *OpShiftRightArithmetic) OperandLen() int { return 4 }
+
+func (c *OpShiftRightArithmetic) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Base)
+	out[3] = uint32(c.Shift)
+	return 4
+}
+
+func (c *OpShiftRightArithmetic) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Shift = Id(argv[3])
+	return nil
+}
+
+func (c *OpShiftLeftLogical) OperandLen() int { return 4 }
+
+func (c *OpShiftLeftLogical) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Base)
+	out[3] = uint32(c.Shift)
+	return 4
+}
+
+func (c *OpShiftLeftLogical) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Shift = Id(argv[3])
+	return nil
+}
+
+func (c *OpBitwiseOr) OperandLen() int { return 4 }
+
+func (c *OpBitwiseOr) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpBitwiseOr) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpBitwiseXor) OperandLen() int { return 4 }
+
+func (c *OpBitwiseXor) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpBitwiseXor) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpBitwiseAnd) OperandLen() int { return 4 }
+
+func (c *OpBitwiseAnd) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpBitwiseAnd) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpNot) OperandLen() int { return 3 }
+
+func (c *OpNot) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand)
+	return 3
+}
+
+func (c *OpNot) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+	return nil
+}
+
+func (c *OpBitFieldInsert) OperandLen() int { return 6 }
+
+func (c *OpBitFieldInsert) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Base)
+	out[3] = uint32(c.Insert)
+	out[4] = uint32(c.Offset)
+	out[5] = uint32(c.Count)
+	return 6
+}
+
+func (c *OpBitFieldInsert) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Insert = Id(argv[3])
+	c.Offset = Id(argv[4])
+	c.Count = Id(argv[5])
+	return nil
+}
+
+func (c *OpBitFieldSExtract) OperandLen() int { return 5 }
+
+func (c *OpBitFieldSExtract) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Base)
+	out[3] = uint32(c.Offset)
+	out[4] = uint32(c.Count)
+	return 5
+}
+
+func (c *OpBitFieldSExtract) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Offset = Id(argv[3])
+	c.Count = Id(argv[4])
+	return nil
+}
+
+func (c *OpBitFieldUExtract) OperandLen() int { return 5 }
+
+func (c *OpBitFieldUExtract) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Base)
+	out[3] = uint32(c.Offset)
+	out[4] = uint32(c.Count)
+	return 5
+}
+
+func (c *OpBitFieldUExtract) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	c.Offset = Id(argv[3])
+	c.Count = Id(argv[4])
+	return nil
+}
+
+func (c *OpBitReverse) OperandLen() int { return 3 }
+
+func (c *OpBitReverse) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Base)
+	return 3
+}
+
+func (c *OpBitReverse) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	return nil
+}
+
+func (c *OpBitCount) OperandLen() int { return 3 }
+
+func (c *OpBitCount) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Base)
+	return 3
+}
+
+func (c *OpBitCount) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Base = Id(argv[2])
+	return nil
+}
+
+func (c *OpAny) OperandLen() int { return 3 }
+
+func (c *OpAny) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector)
+	return 3
+}
+
+func (c *OpAny) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector = Id(argv[2])
+	return nil
+}
+
+func (c *OpAll) OperandLen() int { return 3 }
+
+func (c *OpAll) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Vector)
+	return 3
+}
+
+func (c *OpAll) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Vector = Id(argv[2])
+	return nil
+}
+
+func (c *OpIsNan) OperandLen() int { return 3 }
+
+func (c *OpIsNan) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.X)
+	return 3
+}
+
+func (c *OpIsNan) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+	return nil
+}
+
+func (c *OpIsInf) OperandLen() int { return 3 }
+
+func (c *OpIsInf) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.X)
+	return 3
+}
+
+func (c *OpIsInf) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+	return nil
+}
+
+func (c *OpIsFinite) OperandLen() int { return 3 }
+
+func (c *OpIsFinite) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.X)
+	return 3
+}
+
+func (c *OpIsFinite) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+	return nil
+}
+
+func (c *OpIsNormal) OperandLen() int { return 3 }
+
+func (c *OpIsNormal) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.X)
+	return 3
+}
+
+func (c *OpIsNormal) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+	return nil
+}
+
+func (c *OpSignBitSet) OperandLen() int { return 3 }
+
+func (c *OpSignBitSet) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.X)
+	return 3
+}
+
+func (c *OpSignBitSet) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+	return nil
+}
+
+func (c *OpLessOrGreater) OperandLen() int { return 4 }
+
+func (c *OpLessOrGreater) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.X)
+	out[3] = uint32(c.Y)
+	return 4
+}
+
+func (c *OpLessOrGreater) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+	c.Y = Id(argv[3])
+	return nil
+}
+
+func (c *OpOrdered) OperandLen() int { return 4 }
+
+func (c *OpOrdered) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.X)
+	out[3] = uint32(c.Y)
+	return 4
+}
+
+func (c *OpOrdered) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+	c.Y = Id(argv[3])
+	return nil
+}
+
+func (c *OpUnordered) OperandLen() int { return 4 }
+
+func (c *OpUnordered) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.X)
+	out[3] = uint32(c.Y)
+	return 4
+}
+
+func (c *OpUnordered) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.X = Id(argv[2])
+	c.Y = Id(argv[3])
+	return nil
+}
+
+func (c *OpLogicalEqual) OperandLen() int { return 4 }
+
+func (c *OpLogicalEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpLogicalEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpLogicalNotEqual) OperandLen() int { return 4 }
+
+func (c *OpLogicalNotEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpLogicalNotEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpLogicalOr) OperandLen() int { return 4 }
+
+func (c *OpLogicalOr) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpLogicalOr) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpLogicalAnd) OperandLen() int { return 4 }
+
+func (c *OpLogicalAnd) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpLogicalAnd) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpLogicalNot) OperandLen() int { return 3 }
+
+func (c *OpLogicalNot) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand)
+	return 3
+}
+
+func (c *OpLogicalNot) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand = Id(argv[2])
+	return nil
+}
+
+func (c *OpSelect) OperandLen() int { return 5 }
+
+func (c *OpSelect) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Condition)
+	out[3] = uint32(c.Object1)
+	out[4] = uint32(c.Object2)
+	return 5
+}
+
+func (c *OpSelect) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Condition = Id(argv[2])
+	c.Object1 = Id(argv[3])
+	c.Object2 = Id(argv[4])
+	return nil
+}
+
+func (c *OpIEqual) OperandLen() int { return 4 }
+
+func (c *OpIEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpIEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpINotEqual) OperandLen() int { return 4 }
+
+func (c *OpINotEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpINotEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpUGreaterThan) OperandLen() int { return 4 }
+
+func (c *OpUGreaterThan) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpUGreaterThan) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpSGreaterThan) OperandLen() int { return 4 }
+
+func (c *OpSGreaterThan) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpSGreaterThan) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpUGreaterThanEqual) OperandLen() int { return 4 }
+
+func (c *OpUGreaterThanEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpUGreaterThanEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpSGreaterThanEqual) OperandLen() int { return 4 }
+
+func (c *OpSGreaterThanEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpSGreaterThanEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpULessThan) OperandLen() int { return 4 }
+
+func (c *OpULessThan) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpULessThan) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpSLessThan) OperandLen() int { return 4 }
+
+func (c *OpSLessThan) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpSLessThan) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpULessThanEqual) OperandLen() int { return 4 }
+
+func (c *OpULessThanEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpULessThanEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpSLessThanEqual) OperandLen() int { return 4 }
+
+func (c *OpSLessThanEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpSLessThanEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFOrdEqual) OperandLen() int { return 4 }
+
+func (c *OpFOrdEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFOrdEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFUnordEqual) OperandLen() int { return 4 }
+
+func (c *OpFUnordEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFUnordEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFOrdNotEqual) OperandLen() int { return 4 }
+
+func (c *OpFOrdNotEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFOrdNotEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFUnordNotEqual) OperandLen() int { return 4 }
+
+func (c *OpFUnordNotEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFUnordNotEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFOrdLessThan) OperandLen() int { return 4 }
+
+func (c *OpFOrdLessThan) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFOrdLessThan) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFUnordLessThan) OperandLen() int { return 4 }
+
+func (c *OpFUnordLessThan) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFUnordLessThan) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFOrdGreaterThan) OperandLen() int { return 4 }
+
+func (c *OpFOrdGreaterThan) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFOrdGreaterThan) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFUnordGreaterThan) OperandLen() int { return 4 }
+
+func (c *OpFUnordGreaterThan) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFUnordGreaterThan) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFOrdLessThanEqual) OperandLen() int { return 4 }
+
+func (c *OpFOrdLessThanEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFOrdLessThanEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFUnordLessThanEqual) OperandLen() int { return 4 }
+
+func (c *OpFUnordLessThanEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFUnordLessThanEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFOrdGreaterThanEqual) OperandLen() int { return 4 }
+
+func (c *OpFOrdGreaterThanEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFOrdGreaterThanEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpFUnordGreaterThanEqual) OperandLen() int { return 4 }
+
+func (c *OpFUnordGreaterThanEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Operand1)
+	out[3] = uint32(c.Operand2)
+	return 4
+}
+
+func (c *OpFUnordGreaterThanEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Operand1 = Id(argv[2])
+	c.Operand2 = Id(argv[3])
+	return nil
+}
+
+func (c *OpDPdx) OperandLen() int { return 3 }
+
+func (c *OpDPdx) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.P)
+	return 3
+}
+
+func (c *OpDPdx) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+	return nil
+}
+
+func (c *OpDPdy) OperandLen() int { return 3 }
+
+func (c *OpDPdy) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.P)
+	return 3
+}
+
+func (c *OpDPdy) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+	return nil
+}
+
+func (c *OpFwidth) OperandLen() int { return 3 }
+
+func (c *OpFwidth) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.P)
+	return 3
+}
+
+func (c *OpFwidth) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+	return nil
+}
+
+func (c *OpDPdxFine) OperandLen() int { return 3 }
+
+func (c *OpDPdxFine) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.P)
+	return 3
+}
+
+func (c *OpDPdxFine) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+	return nil
+}
+
+func (c *OpDPdyFine) OperandLen() int { return 3 }
+
+func (c *OpDPdyFine) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.P)
+	return 3
+}
+
+func (c *OpDPdyFine) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+	return nil
+}
+
+func (c *OpFwidthFine) OperandLen() int { return 3 }
+
+func (c *OpFwidthFine) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.P)
+	return 3
+}
+
+func (c *OpFwidthFine) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+	return nil
+}
+
+func (c *OpDPdxCoarse) OperandLen() int { return 3 }
+
+func (c *OpDPdxCoarse) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.P)
+	return 3
+}
+
+func (c *OpDPdxCoarse) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+	return nil
+}
+
+func (c *OpDPdyCoarse) OperandLen() int { return 3 }
+
+func (c *OpDPdyCoarse) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.P)
+	return 3
+}
+
+func (c *OpDPdyCoarse) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+	return nil
+}
+
+func (c *OpFwidthCoarse) OperandLen() int { return 3 }
+
+func (c *OpFwidthCoarse) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.P)
+	return 3
+}
+
+func (c *OpFwidthCoarse) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.P = Id(argv[2])
+	return nil
+}
+
+func (c *OpPhi) OperandLen() int {
+	n := 2
+	n += len(c.Operands)
+	return n
+}
+
+func (c *OpPhi) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	n := 2
+	for _, v := range c.Operands {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpPhi) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	argv = argv[2:]
+
+	c.Operands = decodeIds(argv)
+	return nil
+}
+
+func (c *OpLoopMerge) OperandLen() int {
+	n := 3
+	n += len(c.Argv)
+	return n
+}
+
+func (c *OpLoopMerge) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.MergeBlock)
+	out[1] = uint32(c.ContinueTarget)
+	out[2] = uint32(c.LoopControl)
+	n := 3
+	n += copy(out[n:], c.Argv)
+	return n
+}
+
+func (c *OpLoopMerge) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.MergeBlock = Id(argv[0])
+	c.ContinueTarget = Id(argv[1])
+	c.LoopControl = LoopControl(argv[2])
+	argv = argv[3:]
+
+	c.Argv = Copy(argv)
+	return nil
+}
+
+func (c *OpSelectionMerge) OperandLen() int { return 2 }
+
+func (c *OpSelectionMerge) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.MergeBlock)
+	out[1] = uint32(c.SelectionControl)
+	return 2
+}
+
+func (c *OpSelectionMerge) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.MergeBlock = Id(argv[0])
+	c.SelectionControl = SelectionControl(argv[1])
+	return nil
+}
+
+func (c *OpLabel) OperandLen() int { return 1 }
+
+func (c *OpLabel) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultId)
+	return 1
+}
+
+func (c *OpLabel) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultId = Id(argv[0])
+	return nil
+}
+
+func (c *OpBranch) OperandLen() int { return 1 }
+
+func (c *OpBranch) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.TargetLabel)
+	return 1
+}
+
+func (c *OpBranch) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.TargetLabel = Id(argv[0])
+	return nil
+}
+
+func (c *OpBranchConditional) OperandLen() int {
+	n := 3
+	n += len(c.BranchWeights)
+	return n
+}
+
+func (c *OpBranchConditional) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Condition)
+	out[1] = uint32(c.TrueLabel)
+	out[2] = uint32(c.FalseLabel)
+	n := 3
+	n += copy(out[n:], c.BranchWeights)
+	return n
+}
+
+func (c *OpBranchConditional) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Condition = Id(argv[0])
+	c.TrueLabel = Id(argv[1])
+	c.FalseLabel = Id(argv[2])
+	argv = argv[3:]
+
+	c.BranchWeights = Copy(argv)
+	return nil
+}
+
+func (c *OpSwitch) OperandLen() int {
+	n := 2
+	n += len(c.Target)
+	return n
+}
+
+func (c *OpSwitch) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Selector)
+	out[1] = uint32(c.Default)
+	n := 2
+	n += copy(out[n:], c.Target)
+	return n
+}
+
+func (c *OpSwitch) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Selector = Id(argv[0])
+	c.Default = Id(argv[1])
+	argv = argv[2:]
+
+	c.Target = Copy(argv)
+	return nil
+}
+
+func (c *OpKill) OperandLen() int { return 0 }
+
+func (c *OpKill) EncodeOperands(out []uint32) int { return 0 }
+
+func (c *OpKill) DecodeOperands(argv []uint32) error { return nil }
+
+func (c *OpReturn) OperandLen() int { return 0 }
+
+func (c *OpReturn) EncodeOperands(out []uint32) int { return 0 }
+
+func (c *OpReturn) DecodeOperands(argv []uint32) error { return nil }
+
+func (c *OpReturnValue) OperandLen() int { return 1 }
+
+func (c *OpReturnValue) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Value)
+	return 1
+}
+
+func (c *OpReturnValue) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Value = Id(argv[0])
+	return nil
+}
+
+func (c *OpUnreachable) OperandLen() int { return 0 }
+
+func (c *OpUnreachable) EncodeOperands(out []uint32) int { return 0 }
+
+func (c *OpUnreachable) DecodeOperands(argv []uint32) error { return nil }
+
+func (c *OpLifetimeStart) OperandLen() int { return 2 }
+
+func (c *OpLifetimeStart) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Pointer)
+	out[1] = c.Size
+	return 2
+}
+
+func (c *OpLifetimeStart) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pointer = Id(argv[0])
+	c.Size = argv[1]
+	return nil
+}
+
+func (c *OpLifetimeStop) OperandLen() int { return 2 }
+
+func (c *OpLifetimeStop) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Pointer)
+	out[1] = c.Size
+	return 2
+}
+
+func (c *OpLifetimeStop) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pointer = Id(argv[0])
+	c.Size = argv[1]
+	return nil
+}
+
+func (c *OpTerminateInvocation) OperandLen() int { return 0 }
+
+func (c *OpTerminateInvocation) EncodeOperands(out []uint32) int { return 0 }
+
+func (c *OpTerminateInvocation) DecodeOperands(argv []uint32) error { return nil }
+
+func (c *OpDemoteToHelperInvocation) OperandLen() int { return 0 }
+
+func (c *OpDemoteToHelperInvocation) EncodeOperands(out []uint32) int { return 0 }
+
+func (c *OpDemoteToHelperInvocation) DecodeOperands(argv []uint32) error { return nil }
+
+func (c *OpAtomicLoad) OperandLen() int { return 5 }
+
+func (c *OpAtomicLoad) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	return 5
+}
+
+func (c *OpAtomicLoad) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	return nil
+}
+
+func (c *OpAtomicStore) OperandLen() int { return 4 }
+
+func (c *OpAtomicStore) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Pointer)
+	out[1] = uint32(c.Memory)
+	out[2] = uint32(c.Semantics)
+	out[3] = uint32(c.Value)
+	return 4
+}
+
+func (c *OpAtomicStore) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pointer = Id(argv[0])
+	c.Memory = Id(argv[1])
+	c.Semantics = Id(argv[2])
+	c.Value = Id(argv[3])
+	return nil
+}
+
+func (c *OpAtomicExchange) OperandLen() int { return 6 }
+
+func (c *OpAtomicExchange) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	out[5] = uint32(c.Value)
+	return 6
+}
+
+func (c *OpAtomicExchange) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+	return nil
+}
+
+func (c *OpAtomicCompareExchange) OperandLen() int { return 8 }
+
+func (c *OpAtomicCompareExchange) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Equal)
+	out[5] = uint32(c.Unequal)
+	out[6] = uint32(c.Value)
+	out[7] = uint32(c.Comparator)
+	return 8
+}
+
+func (c *OpAtomicCompareExchange) DecodeOperands(argv []uint32) error {
+	if len(argv) < 8 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Equal = Id(argv[4])
+	c.Unequal = Id(argv[5])
+	c.Value = Id(argv[6])
+	c.Comparator = Id(argv[7])
+	return nil
+}
+
+func (c *OpAtomicCompareExchangeWeak) OperandLen() int { return 8 }
+
+func (c *OpAtomicCompareExchangeWeak) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Equal)
+	out[5] = uint32(c.Unequal)
+	out[6] = uint32(c.Value)
+	out[7] = uint32(c.Comparator)
+	return 8
+}
+
+func (c *OpAtomicCompareExchangeWeak) DecodeOperands(argv []uint32) error {
+	if len(argv) < 8 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Equal = Id(argv[4])
+	c.Unequal = Id(argv[5])
+	c.Value = Id(argv[6])
+	c.Comparator = Id(argv[7])
+	return nil
+}
+
+func (c *OpAtomicIIncrement) OperandLen() int { return 5 }
+
+func (c *OpAtomicIIncrement) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	return 5
+}
+
+func (c *OpAtomicIIncrement) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	return nil
+}
+
+func (c *OpAtomicIDecrement) OperandLen() int { return 5 }
+
+func (c *OpAtomicIDecrement) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	return 5
+}
+
+func (c *OpAtomicIDecrement) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	return nil
+}
+
+func (c *OpAtomicIAdd) OperandLen() int { return 6 }
+
+func (c *OpAtomicIAdd) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	out[5] = uint32(c.Value)
+	return 6
+}
+
+func (c *OpAtomicIAdd) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+	return nil
+}
+
+func (c *OpAtomicISub) OperandLen() int { return 6 }
+
+func (c *OpAtomicISub) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	out[5] = uint32(c.Value)
+	return 6
+}
+
+func (c *OpAtomicISub) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+	return nil
+}
+
+func (c *OpAtomicSMin) OperandLen() int { return 6 }
+
+func (c *OpAtomicSMin) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	out[5] = uint32(c.Value)
+	return 6
+}
+
+func (c *OpAtomicSMin) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+	return nil
+}
+
+func (c *OpAtomicUMin) OperandLen() int { return 6 }
+
+func (c *OpAtomicUMin) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	out[5] = uint32(c.Value)
+	return 6
+}
+
+func (c *OpAtomicUMin) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+	return nil
+}
+
+func (c *OpAtomicSMax) OperandLen() int { return 6 }
+
+func (c *OpAtomicSMax) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	out[5] = uint32(c.Value)
+	return 6
+}
+
+func (c *OpAtomicSMax) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+	return nil
+}
+
+func (c *OpAtomicUMax) OperandLen() int { return 6 }
+
+func (c *OpAtomicUMax) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	out[5] = uint32(c.Value)
+	return 6
+}
+
+func (c *OpAtomicUMax) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+	return nil
+}
+
+func (c *OpAtomicAnd) OperandLen() int { return 6 }
+
+func (c *OpAtomicAnd) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	out[5] = uint32(c.Value)
+	return 6
+}
+
+func (c *OpAtomicAnd) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+	return nil
+}
+
+func (c *OpAtomicOr) OperandLen() int { return 6 }
+
+func (c *OpAtomicOr) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	out[5] = uint32(c.Value)
+	return 6
+}
+
+func (c *OpAtomicOr) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+	return nil
+}
+
+func (c *OpAtomicXor) OperandLen() int { return 6 }
+
+func (c *OpAtomicXor) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	out[5] = uint32(c.Value)
+	return 6
+}
+
+func (c *OpAtomicXor) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	c.Value = Id(argv[5])
+	return nil
+}
+
+func (c *OpAtomicFlagTestAndSet) OperandLen() int { return 5 }
+
+func (c *OpAtomicFlagTestAndSet) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pointer)
+	out[3] = uint32(c.Memory)
+	out[4] = uint32(c.Semantics)
+	return 5
+}
+
+func (c *OpAtomicFlagTestAndSet) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pointer = Id(argv[2])
+	c.Memory = Id(argv[3])
+	c.Semantics = Id(argv[4])
+	return nil
+}
+
+func (c *OpAtomicFlagClear) OperandLen() int { return 3 }
+
+func (c *OpAtomicFlagClear) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Pointer)
+	out[1] = uint32(c.Memory)
+	out[2] = uint32(c.Semantics)
+	return 3
+}
+
+func (c *OpAtomicFlagClear) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pointer = Id(argv[0])
+	c.Memory = Id(argv[1])
+	c.Semantics = Id(argv[2])
+	return nil
+}
+
+func (c *OpEmitVertex) OperandLen() int { return 0 }
+
+func (c *OpEmitVertex) EncodeOperands(out []uint32) int { return 0 }
+
+func (c *OpEmitVertex) DecodeOperands(argv []uint32) error { return nil }
+
+func (c *OpEndPrimitive) OperandLen() int { return 0 }
+
+func (c *OpEndPrimitive) EncodeOperands(out []uint32) int { return 0 }
+
+func (c *OpEndPrimitive) DecodeOperands(argv []uint32) error { return nil }
+
+func (c *OpEmitStreamVertex) OperandLen() int { return 1 }
+
+func (c *OpEmitStreamVertex) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Stream)
+	return 1
+}
+
+func (c *OpEmitStreamVertex) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Stream = Id(argv[0])
+	return nil
+}
+
+func (c *OpEndStreamPrimitive) OperandLen() int { return 1 }
+
+func (c *OpEndStreamPrimitive) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Stream)
+	return 1
+}
+
+func (c *OpEndStreamPrimitive) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Stream = Id(argv[0])
+	return nil
+}
+
+func (c *OpControlBarrier) OperandLen() int { return 3 }
+
+func (c *OpControlBarrier) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Execution)
+	out[1] = uint32(c.Memory)
+	out[2] = uint32(c.Semantics)
+	return 3
+}
+
+func (c *OpControlBarrier) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Execution = Id(argv[0])
+	c.Memory = Id(argv[1])
+	c.Semantics = Id(argv[2])
+	return nil
+}
+
+func (c *OpMemoryBarrier) OperandLen() int { return 2 }
+
+func (c *OpMemoryBarrier) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Memory)
+	out[1] = uint32(c.Semantics)
+	return 2
+}
+
+func (c *OpMemoryBarrier) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Memory = Id(argv[0])
+	c.Semantics = Id(argv[1])
+	return nil
+}
+
+func (c *OpNamedBarrierInitialize) OperandLen() int { return 3 }
+
+func (c *OpNamedBarrierInitialize) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SubgroupCount)
+	return 3
+}
+
+func (c *OpNamedBarrierInitialize) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SubgroupCount = Id(argv[2])
+	return nil
+}
+
+func (c *OpMemoryNamedBarrier) OperandLen() int { return 3 }
+
+func (c *OpMemoryNamedBarrier) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.NamedBarrier)
+	out[1] = uint32(c.Memory)
+	out[2] = uint32(c.Semantics)
+	return 3
+}
+
+func (c *OpMemoryNamedBarrier) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.NamedBarrier = Id(argv[0])
+	c.Memory = Id(argv[1])
+	c.Semantics = Id(argv[2])
+	return nil
+}
+
+func (c *OpGroupAsyncCopy) OperandLen() int { return 8 }
+
+func (c *OpGroupAsyncCopy) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Destination)
+	out[4] = uint32(c.Source)
+	out[5] = uint32(c.NumElements)
+	out[6] = uint32(c.Stride)
+	out[7] = uint32(c.Event)
+	return 8
+}
+
+func (c *OpGroupAsyncCopy) DecodeOperands(argv []uint32) error {
+	if len(argv) < 8 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Destination = Id(argv[3])
+	c.Source = Id(argv[4])
+	c.NumElements = Id(argv[5])
+	c.Stride = Id(argv[6])
+	c.Event = Id(argv[7])
+	return nil
+}
+
+func (c *OpGroupWaitEvents) OperandLen() int { return 3 }
+
+func (c *OpGroupWaitEvents) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Execution)
+	out[1] = uint32(c.NumEvents)
+	out[2] = uint32(c.EventsList)
+	return 3
+}
+
+func (c *OpGroupWaitEvents) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Execution = Id(argv[0])
+	c.NumEvents = Id(argv[1])
+	c.EventsList = Id(argv[2])
+	return nil
+}
+
+func (c *OpGroupAll) OperandLen() int { return 4 }
+
+func (c *OpGroupAll) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Predicate)
+	return 4
+}
+
+func (c *OpGroupAll) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Predicate = Id(argv[3])
+	return nil
+}
+
+func (c *OpGroupAny) OperandLen() int { return 4 }
+
+func (c *OpGroupAny) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Predicate)
+	return 4
+}
+
+func (c *OpGroupAny) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Predicate = Id(argv[3])
+	return nil
+}
+
+func (c *OpGroupBroadcast) OperandLen() int { return 5 }
+
+func (c *OpGroupBroadcast) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	out[4] = uint32(c.LocalId)
+	return 5
+}
+
+func (c *OpGroupBroadcast) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	c.LocalId = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupIAdd) OperandLen() int { return 5 }
+
+func (c *OpGroupIAdd) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.X)
+	return 5
+}
+
+func (c *OpGroupIAdd) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupFAdd) OperandLen() int { return 5 }
+
+func (c *OpGroupFAdd) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.X)
+	return 5
+}
+
+func (c *OpGroupFAdd) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupFMin) OperandLen() int { return 5 }
+
+func (c *OpGroupFMin) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.X)
+	return 5
+}
+
+func (c *OpGroupFMin) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupUMin) OperandLen() int { return 5 }
+
+func (c *OpGroupUMin) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.X)
+	return 5
+}
+
+func (c *OpGroupUMin) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupSMin) OperandLen() int { return 5 }
+
+func (c *OpGroupSMin) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.X)
+	return 5
+}
+
+func (c *OpGroupSMin) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupFMax) OperandLen() int { return 5 }
+
+func (c *OpGroupFMax) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.X)
+	return 5
+}
+
+func (c *OpGroupFMax) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupUMax) OperandLen() int { return 5 }
+
+func (c *OpGroupUMax) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.X)
+	return 5
+}
+
+func (c *OpGroupUMax) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupSMax) OperandLen() int { return 5 }
+
+func (c *OpGroupSMax) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.X)
+	return 5
+}
+
+func (c *OpGroupSMax) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.X = Id(argv[4])
+	return nil
+}
+
+func (c *OpReadPipe) OperandLen() int { return 6 }
+
+func (c *OpReadPipe) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pipe)
+	out[3] = uint32(c.Pointer)
+	out[4] = uint32(c.PacketSize)
+	out[5] = uint32(c.PacketAlignment)
+	return 6
+}
+
+func (c *OpReadPipe) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.Pointer = Id(argv[3])
+	c.PacketSize = Id(argv[4])
+	c.PacketAlignment = Id(argv[5])
+	return nil
+}
+
+func (c *OpWritePipe) OperandLen() int { return 6 }
+
+func (c *OpWritePipe) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pipe)
+	out[3] = uint32(c.Pointer)
+	out[4] = uint32(c.PacketSize)
+	out[5] = uint32(c.PacketAlignment)
+	return 6
+}
+
+func (c *OpWritePipe) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.Pointer = Id(argv[3])
+	c.PacketSize = Id(argv[4])
+	c.PacketAlignment = Id(argv[5])
+	return nil
+}
+
+func (c *OpReservedReadPipe) OperandLen() int { return 8 }
+
+func (c *OpReservedReadPipe) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pipe)
+	out[3] = uint32(c.ReserveId)
+	out[4] = uint32(c.Index)
+	out[5] = uint32(c.Pointer)
+	out[6] = uint32(c.PacketSize)
+	out[7] = uint32(c.PacketAlignment)
+	return 8
+}
+
+func (c *OpReservedReadPipe) DecodeOperands(argv []uint32) error {
+	if len(argv) < 8 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.ReserveId = Id(argv[3])
+	c.Index = Id(argv[4])
+	c.Pointer = Id(argv[5])
+	c.PacketSize = Id(argv[6])
+	c.PacketAlignment = Id(argv[7])
+	return nil
+}
+
+func (c *OpReservedWritePipe) OperandLen() int { return 8 }
+
+func (c *OpReservedWritePipe) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pipe)
+	out[3] = uint32(c.ReserveId)
+	out[4] = uint32(c.Index)
+	out[5] = uint32(c.Pointer)
+	out[6] = uint32(c.PacketSize)
+	out[7] = uint32(c.PacketAlignment)
+	return 8
+}
+
+func (c *OpReservedWritePipe) DecodeOperands(argv []uint32) error {
+	if len(argv) < 8 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.ReserveId = Id(argv[3])
+	c.Index = Id(argv[4])
+	c.Pointer = Id(argv[5])
+	c.PacketSize = Id(argv[6])
+	c.PacketAlignment = Id(argv[7])
+	return nil
+}
+
+func (c *OpReserveReadPipePackets) OperandLen() int { return 6 }
+
+func (c *OpReserveReadPipePackets) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pipe)
+	out[3] = uint32(c.NumPackets)
+	out[4] = uint32(c.PacketSize)
+	out[5] = uint32(c.PacketAlignment)
+	return 6
+}
+
+func (c *OpReserveReadPipePackets) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.NumPackets = Id(argv[3])
+	c.PacketSize = Id(argv[4])
+	c.PacketAlignment = Id(argv[5])
+	return nil
+}
+
+func (c *OpReserveWritePipePackets) OperandLen() int { return 6 }
+
+func (c *OpReserveWritePipePackets) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pipe)
+	out[3] = uint32(c.NumPackets)
+	out[4] = uint32(c.PacketSize)
+	out[5] = uint32(c.PacketAlignment)
+	return 6
+}
+
+func (c *OpReserveWritePipePackets) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.NumPackets = Id(argv[3])
+	c.PacketSize = Id(argv[4])
+	c.PacketAlignment = Id(argv[5])
+	return nil
+}
+
+func (c *OpCommitReadPipe) OperandLen() int { return 4 }
+
+func (c *OpCommitReadPipe) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Pipe)
+	out[1] = uint32(c.ReserveId)
+	out[2] = uint32(c.PacketSize)
+	out[3] = uint32(c.PacketAlignment)
+	return 4
+}
+
+func (c *OpCommitReadPipe) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pipe = Id(argv[0])
+	c.ReserveId = Id(argv[1])
+	c.PacketSize = Id(argv[2])
+	c.PacketAlignment = Id(argv[3])
+	return nil
+}
+
+func (c *OpCommitWritePipe) OperandLen() int { return 4 }
+
+func (c *OpCommitWritePipe) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Pipe)
+	out[1] = uint32(c.ReserveId)
+	out[2] = uint32(c.PacketSize)
+	out[3] = uint32(c.PacketAlignment)
+	return 4
+}
+
+func (c *OpCommitWritePipe) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Pipe = Id(argv[0])
+	c.ReserveId = Id(argv[1])
+	c.PacketSize = Id(argv[2])
+	c.PacketAlignment = Id(argv[3])
+	return nil
+}
+
+func (c *OpIsValidReserveId) OperandLen() int { return 3 }
+
+func (c *OpIsValidReserveId) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.ReserveId)
+	return 3
+}
+
+func (c *OpIsValidReserveId) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.ReserveId = Id(argv[2])
+	return nil
+}
+
+func (c *OpGetNumPipePackets) OperandLen() int { return 5 }
+
+func (c *OpGetNumPipePackets) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pipe)
+	out[3] = uint32(c.PacketSize)
+	out[4] = uint32(c.PacketAlignment)
+	return 5
+}
+
+func (c *OpGetNumPipePackets) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.PacketSize = Id(argv[3])
+	c.PacketAlignment = Id(argv[4])
+	return nil
+}
+
+func (c *OpGetMaxPipePackets) OperandLen() int { return 5 }
+
+func (c *OpGetMaxPipePackets) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Pipe)
+	out[3] = uint32(c.PacketSize)
+	out[4] = uint32(c.PacketAlignment)
+	return 5
+}
+
+func (c *OpGetMaxPipePackets) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Pipe = Id(argv[2])
+	c.PacketSize = Id(argv[3])
+	c.PacketAlignment = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupReserveReadPipePackets) OperandLen() int { return 7 }
+
+func (c *OpGroupReserveReadPipePackets) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Pipe)
+	out[4] = uint32(c.NumPackets)
+	out[5] = uint32(c.PacketSize)
+	out[6] = uint32(c.PacketAlignment)
+	return 7
+}
+
+func (c *OpGroupReserveReadPipePackets) DecodeOperands(argv []uint32) error {
+	if len(argv) < 7 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Pipe = Id(argv[3])
+	c.NumPackets = Id(argv[4])
+	c.PacketSize = Id(argv[5])
+	c.PacketAlignment = Id(argv[6])
+	return nil
+}
+
+func (c *OpGroupReserveWritePipePackets) OperandLen() int { return 7 }
+
+func (c *OpGroupReserveWritePipePackets) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Pipe)
+	out[4] = uint32(c.NumPackets)
+	out[5] = uint32(c.PacketSize)
+	out[6] = uint32(c.PacketAlignment)
+	return 7
+}
+
+func (c *OpGroupReserveWritePipePackets) DecodeOperands(argv []uint32) error {
+	if len(argv) < 7 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Pipe = Id(argv[3])
+	c.NumPackets = Id(argv[4])
+	c.PacketSize = Id(argv[5])
+	c.PacketAlignment = Id(argv[6])
+	return nil
+}
+
+func (c *OpGroupCommitReadPipe) OperandLen() int { return 5 }
+
+func (c *OpGroupCommitReadPipe) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Execution)
+	out[1] = uint32(c.Pipe)
+	out[2] = uint32(c.ReserveId)
+	out[3] = uint32(c.PacketSize)
+	out[4] = uint32(c.PacketAlignment)
+	return 5
+}
+
+func (c *OpGroupCommitReadPipe) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Execution = Id(argv[0])
+	c.Pipe = Id(argv[1])
+	c.ReserveId = Id(argv[2])
+	c.PacketSize = Id(argv[3])
+	c.PacketAlignment = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupCommitWritePipe) OperandLen() int { return 5 }
+
+func (c *OpGroupCommitWritePipe) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Execution)
+	out[1] = uint32(c.Pipe)
+	out[2] = uint32(c.ReserveId)
+	out[3] = uint32(c.PacketSize)
+	out[4] = uint32(c.PacketAlignment)
+	return 5
+}
+
+func (c *OpGroupCommitWritePipe) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Execution = Id(argv[0])
+	c.Pipe = Id(argv[1])
+	c.ReserveId = Id(argv[2])
+	c.PacketSize = Id(argv[3])
+	c.PacketAlignment = Id(argv[4])
+	return nil
+}
+
+func (c *OpConstantPipeStorage) OperandLen() int { return 5 }
+
+func (c *OpConstantPipeStorage) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = c.PacketSize
+	out[3] = c.PacketAlignment
+	out[4] = c.Capacity
+	return 5
+}
+
+func (c *OpConstantPipeStorage) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.PacketSize = argv[2]
+	c.PacketAlignment = argv[3]
+	c.Capacity = argv[4]
+	return nil
+}
+
+func (c *OpCreatePipeFromPipeStorage) OperandLen() int { return 3 }
+
+func (c *OpCreatePipeFromPipeStorage) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.PipeStorage)
+	return 3
+}
+
+func (c *OpCreatePipeFromPipeStorage) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.PipeStorage = Id(argv[2])
+	return nil
+}
+
+func (c *OpEnqueueMarker) OperandLen() int { return 6 }
+
+func (c *OpEnqueueMarker) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Queue)
+	out[3] = uint32(c.NumEvents)
+	out[4] = uint32(c.WaitEvents)
+	out[5] = uint32(c.RetEvent)
+	return 6
+}
+
+func (c *OpEnqueueMarker) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Queue = Id(argv[2])
+	c.NumEvents = Id(argv[3])
+	c.WaitEvents = Id(argv[4])
+	c.RetEvent = Id(argv[5])
+	return nil
+}
+
+func (c *OpEnqueueKernel) OperandLen() int {
+	n := 12
+	n += len(c.LocalSize)
+	return n
+}
+
+func (c *OpEnqueueKernel) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Queue)
+	out[3] = uint32(c.Flags)
+	out[4] = uint32(c.NDRange)
+	out[5] = uint32(c.NumEvents)
+	out[6] = uint32(c.WaitEvents)
+	out[7] = uint32(c.RetEvent)
+	out[8] = uint32(c.Invoke)
+	out[9] = uint32(c.Param)
+	out[10] = uint32(c.ParamSize)
+	out[11] = uint32(c.ParamAlign)
+	n := 12
+	for _, v := range c.LocalSize {
+		out[n] = uint32(v)
+		n++
+	}
+	return n
+}
+
+func (c *OpEnqueueKernel) DecodeOperands(argv []uint32) error {
+	if len(argv) < 12 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Queue = Id(argv[2])
+	c.Flags = Id(argv[3])
+	c.NDRange = Id(argv[4])
+	c.NumEvents = Id(argv[5])
+	c.WaitEvents = Id(argv[6])
+	c.RetEvent = Id(argv[7])
+	c.Invoke = Id(argv[8])
+	c.Param = Id(argv[9])
+	c.ParamSize = Id(argv[10])
+	c.ParamAlign = Id(argv[11])
+	argv = argv[12:]
+
+	c.LocalSize = decodeIds(argv)
+	return nil
+}
+
+func (c *OpGetKernelNDrangeSubGroupCount) OperandLen() int { return 7 }
+
+func (c *OpGetKernelNDrangeSubGroupCount) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.NDRange)
+	out[3] = uint32(c.Invoke)
+	out[4] = uint32(c.Param)
+	out[5] = uint32(c.ParamSize)
+	out[6] = uint32(c.ParamAlign)
+	return 7
+}
+
+func (c *OpGetKernelNDrangeSubGroupCount) DecodeOperands(argv []uint32) error {
+	if len(argv) < 7 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.NDRange = Id(argv[2])
+	c.Invoke = Id(argv[3])
+	c.Param = Id(argv[4])
+	c.ParamSize = Id(argv[5])
+	c.ParamAlign = Id(argv[6])
+	return nil
+}
+
+func (c *OpGetKernelNDrangeMaxSubGroupSize) OperandLen() int { return 7 }
+
+func (c *OpGetKernelNDrangeMaxSubGroupSize) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.NDRange)
+	out[3] = uint32(c.Invoke)
+	out[4] = uint32(c.Param)
+	out[5] = uint32(c.ParamSize)
+	out[6] = uint32(c.ParamAlign)
+	return 7
+}
+
+func (c *OpGetKernelNDrangeMaxSubGroupSize) DecodeOperands(argv []uint32) error {
+	if len(argv) < 7 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.NDRange = Id(argv[2])
+	c.Invoke = Id(argv[3])
+	c.Param = Id(argv[4])
+	c.ParamSize = Id(argv[5])
+	c.ParamAlign = Id(argv[6])
+	return nil
+}
+
+func (c *OpGetKernelWorkGroupSize) OperandLen() int { return 6 }
+
+func (c *OpGetKernelWorkGroupSize) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Invoke)
+	out[3] = uint32(c.Param)
+	out[4] = uint32(c.ParamSize)
+	out[5] = uint32(c.ParamAlign)
+	return 6
+}
+
+func (c *OpGetKernelWorkGroupSize) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Invoke = Id(argv[2])
+	c.Param = Id(argv[3])
+	c.ParamSize = Id(argv[4])
+	c.ParamAlign = Id(argv[5])
+	return nil
+}
+
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) OperandLen() int { return 6 }
+
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Invoke)
+	out[3] = uint32(c.Param)
+	out[4] = uint32(c.ParamSize)
+	out[5] = uint32(c.ParamAlign)
+	return 6
+}
+
+func (c *OpGetKernelPreferredWorkGroupSizeMultiple) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Invoke = Id(argv[2])
+	c.Param = Id(argv[3])
+	c.ParamSize = Id(argv[4])
+	c.ParamAlign = Id(argv[5])
+	return nil
+}
+
+func (c *OpRetainEvent) OperandLen() int { return 1 }
+
+func (c *OpRetainEvent) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Event)
+	return 1
+}
+
+func (c *OpRetainEvent) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Event = Id(argv[0])
+	return nil
+}
+
+func (c *OpReleaseEvent) OperandLen() int { return 1 }
+
+func (c *OpReleaseEvent) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Event)
+	return 1
+}
+
+func (c *OpReleaseEvent) DecodeOperands(argv []uint32) error {
+	if len(argv) < 1 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Event = Id(argv[0])
+	return nil
+}
+
+func (c *OpCreateUserEvent) OperandLen() int { return 2 }
+
+func (c *OpCreateUserEvent) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	return 2
+}
+
+func (c *OpCreateUserEvent) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	return nil
+}
+
+func (c *OpIsValidEvent) OperandLen() int { return 3 }
+
+func (c *OpIsValidEvent) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Event)
+	return 3
+}
+
+func (c *OpIsValidEvent) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Event = Id(argv[2])
+	return nil
+}
+
+func (c *OpSetUserEventStatus) OperandLen() int { return 2 }
+
+func (c *OpSetUserEventStatus) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Event)
+	out[1] = uint32(c.Status)
+	return 2
+}
+
+func (c *OpSetUserEventStatus) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Event = Id(argv[0])
+	c.Status = Id(argv[1])
+	return nil
+}
+
+func (c *OpCaptureEventProfilingInfo) OperandLen() int { return 3 }
+
+func (c *OpCaptureEventProfilingInfo) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.Event)
+	out[1] = uint32(c.ProfilingInfo)
+	out[2] = uint32(c.Value)
+	return 3
+}
+
+func (c *OpCaptureEventProfilingInfo) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.Event = Id(argv[0])
+	c.ProfilingInfo = Id(argv[1])
+	c.Value = Id(argv[2])
+	return nil
+}
+
+func (c *OpGetDefaultQueue) OperandLen() int { return 2 }
+
+func (c *OpGetDefaultQueue) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	return 2
+}
+
+func (c *OpGetDefaultQueue) DecodeOperands(argv []uint32) error {
+	if len(argv) < 2 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	return nil
+}
+
+func (c *OpBuildNDRange) OperandLen() int { return 5 }
+
+func (c *OpBuildNDRange) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.GlobalWorkSize)
+	out[3] = uint32(c.LocalWorkSize)
+	out[4] = uint32(c.GlobalWorkOffset)
+	return 5
+}
+
+func (c *OpBuildNDRange) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.GlobalWorkSize = Id(argv[2])
+	c.LocalWorkSize = Id(argv[3])
+	c.GlobalWorkOffset = Id(argv[4])
+	return nil
+}
+
+func (c *OpGetKernelLocalSizeForSubgroupCount) OperandLen() int { return 7 }
+
+func (c *OpGetKernelLocalSizeForSubgroupCount) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.SubgroupCount)
+	out[3] = uint32(c.Invoke)
+	out[4] = uint32(c.Param)
+	out[5] = uint32(c.ParamSize)
+	out[6] = uint32(c.ParamAlign)
+	return 7
+}
+
+func (c *OpGetKernelLocalSizeForSubgroupCount) DecodeOperands(argv []uint32) error {
+	if len(argv) < 7 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.SubgroupCount = Id(argv[2])
+	c.Invoke = Id(argv[3])
+	c.Param = Id(argv[4])
+	c.ParamSize = Id(argv[5])
+	c.ParamAlign = Id(argv[6])
+	return nil
+}
+
+func (c *OpGetKernelMaxNumSubgroups) OperandLen() int { return 6 }
+
+func (c *OpGetKernelMaxNumSubgroups) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Invoke)
+	out[3] = uint32(c.Param)
+	out[4] = uint32(c.ParamSize)
+	out[5] = uint32(c.ParamAlign)
+	return 6
+}
+
+func (c *OpGetKernelMaxNumSubgroups) DecodeOperands(argv []uint32) error {
+	if len(argv) < 6 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Invoke = Id(argv[2])
+	c.Param = Id(argv[3])
+	c.ParamSize = Id(argv[4])
+	c.ParamAlign = Id(argv[5])
+	return nil
+}
+
+func (c *OpGroupNonUniformElect) OperandLen() int { return 3 }
+
+func (c *OpGroupNonUniformElect) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	return 3
+}
+
+func (c *OpGroupNonUniformElect) DecodeOperands(argv []uint32) error {
+	if len(argv) < 3 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	return nil
+}
+
+func (c *OpGroupNonUniformAll) OperandLen() int { return 4 }
+
+func (c *OpGroupNonUniformAll) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Predicate)
+	return 4
+}
+
+func (c *OpGroupNonUniformAll) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Predicate = Id(argv[3])
+	return nil
+}
+
+func (c *OpGroupNonUniformAny) OperandLen() int { return 4 }
+
+func (c *OpGroupNonUniformAny) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Predicate)
+	return 4
+}
+
+func (c *OpGroupNonUniformAny) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Predicate = Id(argv[3])
+	return nil
+}
+
+func (c *OpGroupNonUniformAllEqual) OperandLen() int { return 4 }
+
+func (c *OpGroupNonUniformAllEqual) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	return 4
+}
+
+func (c *OpGroupNonUniformAllEqual) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	return nil
+}
+
+func (c *OpGroupNonUniformBroadcast) OperandLen() int { return 5 }
+
+func (c *OpGroupNonUniformBroadcast) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	out[4] = uint32(c.Id)
+	return 5
+}
+
+func (c *OpGroupNonUniformBroadcast) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	c.Id = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupNonUniformBroadcastFirst) OperandLen() int { return 4 }
+
+func (c *OpGroupNonUniformBroadcastFirst) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	return 4
+}
+
+func (c *OpGroupNonUniformBroadcastFirst) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	return nil
+}
+
+func (c *OpGroupNonUniformBallot) OperandLen() int { return 4 }
+
+func (c *OpGroupNonUniformBallot) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Predicate)
+	return 4
+}
+
+func (c *OpGroupNonUniformBallot) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Predicate = Id(argv[3])
+	return nil
+}
+
+func (c *OpGroupNonUniformInverseBallot) OperandLen() int { return 4 }
+
+func (c *OpGroupNonUniformInverseBallot) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	return 4
+}
+
+func (c *OpGroupNonUniformInverseBallot) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	return nil
+}
+
+func (c *OpGroupNonUniformBallotBitExtract) OperandLen() int { return 5 }
+
+func (c *OpGroupNonUniformBallotBitExtract) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	out[4] = uint32(c.Index)
+	return 5
+}
+
+func (c *OpGroupNonUniformBallotBitExtract) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	c.Index = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupNonUniformBallotBitCount) OperandLen() int { return 5 }
+
+func (c *OpGroupNonUniformBallotBitCount) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	return 5
+}
+
+func (c *OpGroupNonUniformBallotBitCount) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupNonUniformBallotFindLSB) OperandLen() int { return 4 }
+
+func (c *OpGroupNonUniformBallotFindLSB) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	return 4
+}
+
+func (c *OpGroupNonUniformBallotFindLSB) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	return nil
+}
+
+func (c *OpGroupNonUniformBallotFindMSB) OperandLen() int { return 4 }
+
+func (c *OpGroupNonUniformBallotFindMSB) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	return 4
+}
+
+func (c *OpGroupNonUniformBallotFindMSB) DecodeOperands(argv []uint32) error {
+	if len(argv) < 4 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	return nil
+}
+
+func (c *OpGroupNonUniformShuffle) OperandLen() int { return 5 }
+
+func (c *OpGroupNonUniformShuffle) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	out[4] = uint32(c.Id)
+	return 5
+}
+
+func (c *OpGroupNonUniformShuffle) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	c.Id = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupNonUniformShuffleXor) OperandLen() int { return 5 }
+
+func (c *OpGroupNonUniformShuffleXor) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	out[4] = uint32(c.Mask)
+	return 5
+}
+
+func (c *OpGroupNonUniformShuffleXor) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	c.Mask = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupNonUniformShuffleUp) OperandLen() int { return 5 }
+
+func (c *OpGroupNonUniformShuffleUp) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	out[4] = uint32(c.Delta)
+	return 5
+}
+
+func (c *OpGroupNonUniformShuffleUp) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	c.Delta = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupNonUniformShuffleDown) OperandLen() int { return 5 }
+
+func (c *OpGroupNonUniformShuffleDown) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	out[4] = uint32(c.Delta)
+	return 5
+}
+
+func (c *OpGroupNonUniformShuffleDown) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	c.Delta = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupNonUniformIAdd) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformIAdd) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformIAdd) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformFAdd) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformFAdd) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformFAdd) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformIMul) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformIMul) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformIMul) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformFMul) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformFMul) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformFMul) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformSMin) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformSMin) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformSMin) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformUMin) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformUMin) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformUMin) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformFMin) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformFMin) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformFMin) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformSMax) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformSMax) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformSMax) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformUMax) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformUMax) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformUMax) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformFMax) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformFMax) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformFMax) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformBitwiseAnd) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformBitwiseAnd) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformBitwiseAnd) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformBitwiseOr) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformBitwiseOr) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformBitwiseOr) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformBitwiseXor) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformBitwiseXor) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformBitwiseXor) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformLogicalAnd) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformLogicalAnd) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformLogicalAnd) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformLogicalOr) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformLogicalOr) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformLogicalOr) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformLogicalXor) OperandLen() int {
+	n := 5
+	if c.ClusterSize != 0 {
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformLogicalXor) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Operation)
+	out[4] = uint32(c.Value)
+	n := 5
+	if c.ClusterSize != 0 {
+		out[n] = uint32(c.ClusterSize)
+		n++
+	}
+	return n
+}
+
+func (c *OpGroupNonUniformLogicalXor) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Operation = GroupOperation(argv[3])
+	c.Value = Id(argv[4])
+	argv = argv[5:]
+
+	if len(argv) > 0 {
+		c.ClusterSize = Id(argv[0])
+	}
+	return nil
+}
+
+func (c *OpGroupNonUniformQuadBroadcast) OperandLen() int { return 5 }
+
+func (c *OpGroupNonUniformQuadBroadcast) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	out[4] = uint32(c.Index)
+	return 5
+}
+
+func (c *OpGroupNonUniformQuadBroadcast) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	c.Index = Id(argv[4])
+	return nil
+}
+
+func (c *OpGroupNonUniformQuadSwap) OperandLen() int { return 5 }
+
+func (c *OpGroupNonUniformQuadSwap) EncodeOperands(out []uint32) int {
+	out[0] = uint32(c.ResultType)
+	out[1] = uint32(c.ResultId)
+	out[2] = uint32(c.Execution)
+	out[3] = uint32(c.Value)
+	out[4] = uint32(c.Direction)
+	return 5
+}
+
+func (c *OpGroupNonUniformQuadSwap) DecodeOperands(argv []uint32) error {
+	if len(argv) < 5 {
+		return ErrMissingInstructionArgs
+	}
+
+	c.ResultType = Id(argv[0])
+	c.ResultId = Id(argv[1])
+	c.Execution = Id(argv[2])
+	c.Value = Id(argv[3])
+	c.Direction = Id(argv[4])
+	return nil
+}
@@ -1,10 +1,12 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
 package spirv
 
-// OpVectorExtractDynamic reads a single, dynamically selected, component of
-// a vector.
+// OpVectorExtractDynamic extracts a single, dynamically selected, component
+// of a vector.
 type OpVectorExtractDynamic struct {
 	ResultType Id
 	ResultId   Id
@@ -16,8 +18,8 @@ func (c *OpVectorExtractDynamic) Opcode() uint32 { return opcodeVectorExtractDyn
 func (c *OpVectorExtractDynamic) Optional() bool { return false }
 func (c *OpVectorExtractDynamic) Verify() error  { return nil }
 
-// OpVectorInsertDynamic writes a single, variably selected, component
-// into a vector.
+// OpVectorInsertDynamic makes a copy of a vector, with a single, variably
+// selected, component modified.
 type OpVectorInsertDynamic struct {
 	ResultType Id
 	ResultId   Id
@@ -30,8 +32,8 @@ func (c *OpVectorInsertDynamic) Opcode() uint32 { return opcodeVectorInsertDynam
 func (c *OpVectorInsertDynamic) Optional() bool { return false }
 func (c *OpVectorInsertDynamic) Verify() error  { return nil }
 
-// OpVectorShuffle selects arbitrary components from two vectors to make
-// a new vector.
+// OpVectorShuffle selects arbitrary components from two vectors to make a new
+// vector.
 type OpVectorShuffle struct {
 	ResultType Id
 	ResultId   Id
@@ -45,7 +47,7 @@ func (c *OpVectorShuffle) Optional() bool { return false }
 func (c *OpVectorShuffle) Verify() error  { return nil }
 
 // OpCompositeConstruct constructs a new composite object from a set of
-// constituent objects that will fully form it
+// constituent objects.
 type OpCompositeConstruct struct {
 	ResultType   Id
 	ResultId     Id
@@ -68,7 +70,8 @@ func (c *OpCompositeExtract) Opcode() uint32 { return opcodeCompositeExtract }
 func (c *OpCompositeExtract) Optional() bool { return false }
 func (c *OpCompositeExtract) Verify() error  { return nil }
 
-// OpCompositeInsert inserts into a composite object.
+// OpCompositeInsert makes a copy of a composite object, while modifying one
+// part of it.
 type OpCompositeInsert struct {
 	ResultType Id
 	ResultId   Id
@@ -82,7 +85,6 @@ func (c *OpCompositeInsert) Optional() bool { return false }
 func (c *OpCompositeInsert) Verify() error  { return nil }
 
 // OpCopyObject makes a copy of Operand.
-// There are no dereferences involved.
 type OpCopyObject struct {
 	ResultType Id
 	ResultId   Id
@@ -104,6 +106,20 @@ func (c *OpTranspose) Opcode() uint32 { return opcodeTranspose }
 func (c *OpTranspose) Optional() bool { return false }
 func (c *OpTranspose) Verify() error  { return nil }
 
+// OpCopyLogical makes a logical copy of Operand, with a type that need only
+// be logically matched.
+//
+// This instruction requires SPIR-V 1.4 or later.
+type OpCopyLogical struct {
+	ResultType Id
+	ResultId   Id
+	Operand    Id
+}
+
+func (c *OpCopyLogical) Opcode() uint32 { return opcodeCopyLogical }
+func (c *OpCopyLogical) Optional() bool { return false }
+func (c *OpCopyLogical) Verify() error  { return nil }
+
 func init() {
 	bind(func() Instruction { return &OpVectorExtractDynamic{} })
 	bind(func() Instruction { return &OpVectorInsertDynamic{} })
@@ -113,4 +129,5 @@ func init() {
 	bind(func() Instruction { return &OpCompositeInsert{} })
 	bind(func() Instruction { return &OpCopyObject{} })
 	bind(func() Instruction { return &OpTranspose{} })
+	bind(func() Instruction { return &OpCopyLogical{} })
 }
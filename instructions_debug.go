@@ -1,25 +1,38 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
 package spirv
 
-// OpSource defines the OpSource instruction.
-//
-// It documents what source language a module was translated from.
-// This has no semantic impact and can safely be removed from a module.
+// OpSourceContinued continues specifying the Source text from the previous
+// instruction.
+type OpSourceContinued struct {
+	ContinuedSource String
+}
+
+func (c *OpSourceContinued) Opcode() uint32 { return opcodeSourceContinued }
+func (c *OpSourceContinued) Optional() bool { return true }
+func (c *OpSourceContinued) Verify() error  { return nil }
+
+// OpSource documents what source language and text this module was translated
+// from.
 type OpSource struct {
 	SourceLanguage SourceLanguage
 	Version        uint32
+
+	// File is the <id> of an OpString holding the name of the source file.
+	File Id `spirv:"optional"`
+
+	// Source holds the text of the source, if it is embedded in the module.
+	Source String `spirv:"optional"`
 }
 
 func (c *OpSource) Opcode() uint32 { return opcodeSource }
 func (c *OpSource) Optional() bool { return true }
 func (c *OpSource) Verify() error  { return nil }
 
-// OpSourceExtension defines optional extensions used within the source language.
-//
-// It documents an extension to the source language. This has no semantic
-// impact and can safely be removed from a module.
+// OpSourceExtension documents an extension to the source language.
 type OpSourceExtension struct {
 	Extension String
 }
@@ -28,10 +41,7 @@ func (c *OpSourceExtension) Opcode() uint32 { return opcodeSourceExtension }
 func (c *OpSourceExtension) Optional() bool { return true }
 func (c *OpSourceExtension) Verify() error  { return nil }
 
-// OpName defines the OpName instruction.
-//
-// It names a result ID.
-// This has no semantic impact and can safely be removed from a module.
+// OpName assigns a name string to another instruction's Result <id>.
 type OpName struct {
 	Target Id
 	Name   String
@@ -41,10 +51,7 @@ func (c *OpName) Opcode() uint32 { return opcodeName }
 func (c *OpName) Optional() bool { return true }
 func (c *OpName) Verify() error  { return nil }
 
-// OpMemberName defines the OpMemberName instruction.
-//
-// It names a member of a structure type.
-// This has no semantic impact and can safely be removed from a module.
+// OpMemberName assigns a name string to a member of a structure type.
 type OpMemberName struct {
 	Type   Id
 	Member uint32
@@ -55,10 +62,8 @@ func (c *OpMemberName) Opcode() uint32 { return opcodeMemberName }
 func (c *OpMemberName) Optional() bool { return true }
 func (c *OpMemberName) Verify() error  { return nil }
 
-// OpString defines the OpString instruction.
-//
-// It names a string for use with other debug instructions.
-// This has no semantic impact and can safely be removed from a module.
+// OpString assigns a Result <id> to a string for use by other debug
+// instructions.
 type OpString struct {
 	ResultId Id
 	String   String
@@ -68,12 +73,8 @@ func (c *OpString) Opcode() uint32 { return opcodeString }
 func (c *OpString) Optional() bool { return true }
 func (c *OpString) Verify() error  { return nil }
 
-// OpLine defines the OpLine instruction.
-//
-// It adds source-level location information.
-// This has no semantic impact and can safely be removed from a module.
+// OpLine adds source-level location information.
 type OpLine struct {
-	Target Id
 	File   Id
 	Line   uint32
 	Column uint32
@@ -83,11 +84,33 @@ func (c *OpLine) Opcode() uint32 { return opcodeLine }
 func (c *OpLine) Optional() bool { return true }
 func (c *OpLine) Verify() error  { return nil }
 
+// OpNoLine discontinues any source-level location information that might be
+// active.
+type OpNoLine struct{}
+
+func (c *OpNoLine) Opcode() uint32 { return opcodeNoLine }
+func (c *OpNoLine) Optional() bool { return true }
+func (c *OpNoLine) Verify() error  { return nil }
+
+// OpModuleProcessed documents a process that was applied to a module.
+//
+// This instruction requires SPIR-V 1.1 or later.
+type OpModuleProcessed struct {
+	Process String
+}
+
+func (c *OpModuleProcessed) Opcode() uint32 { return opcodeModuleProcessed }
+func (c *OpModuleProcessed) Optional() bool { return true }
+func (c *OpModuleProcessed) Verify() error  { return nil }
+
 func init() {
+	bind(func() Instruction { return &OpSourceContinued{} })
 	bind(func() Instruction { return &OpSource{} })
 	bind(func() Instruction { return &OpSourceExtension{} })
 	bind(func() Instruction { return &OpName{} })
 	bind(func() Instruction { return &OpMemberName{} })
 	bind(func() Instruction { return &OpString{} })
 	bind(func() Instruction { return &OpLine{} })
+	bind(func() Instruction { return &OpNoLine{} })
+	bind(func() Instruction { return &OpModuleProcessed{} })
 }
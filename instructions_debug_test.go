@@ -8,55 +8,51 @@ import "testing"
 func TestDebug(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x0030001, SourceLanguageGLSL, 450},
-			want: &OpSource{
-				SourceLanguage: SourceLanguageGLSL,
-				Version:        450,
+			in: []uint32{0x00030002, 0x74736574, 0},
+			want: &OpSourceContinued{
+				ContinuedSource: "test",
 			},
 		},
 		{
-			in: []uint32{
-				0x00070002,
-				0x74736574,
-				0x756f7320,
-				0x20656372,
-				0x65747865,
-				0x6f69736e,
-				0x0000006e,
-			},
+			in: []uint32{0x00030004, 0x74736574, 0},
 			want: &OpSourceExtension{
-				Extension: "test source extension",
+				Extension: "test",
 			},
 		},
 		{
-			in: []uint32{0x00050036, 1, 0x74736574, 0x6d616e5f, 0x00000065},
+			in: []uint32{0x00040005, 1, 0x74736574, 0},
 			want: &OpName{
 				Target: 1,
-				Name:   "test_name",
+				Name:   "test",
 			},
 		},
 		{
-			in: []uint32{0x00060037, 1, 2, 0x74736574, 0x6d616e5f, 0x00000065},
+			in: []uint32{0x00050006, 1, 2, 0x74736574, 0},
 			want: &OpMemberName{
 				Type:   1,
 				Member: 2,
-				Name:   "test_name",
+				Name:   "test",
 			},
 		},
 		{
-			in: []uint32{0x00050038, 1, 0x74736574, 0x72747320, 0x676e69},
+			in: []uint32{0x00040007, 1, 0x74736574, 0},
 			want: &OpString{
 				ResultId: 1,
-				String:   "test string",
+				String:   "test",
 			},
 		},
 		{
-			in: []uint32{0x00050039, 1, 2, 3, 4},
+			in: []uint32{0x00040008, 1, 2, 3},
 			want: &OpLine{
-				Target: 1,
-				File:   2,
-				Line:   3,
-				Column: 4,
+				File:   1,
+				Line:   2,
+				Column: 3,
+			},
+		},
+		{
+			in: []uint32{0x0003014a, 0x74736574, 0},
+			want: &OpModuleProcessed{
+				Process: "test",
 			},
 		},
 	} {
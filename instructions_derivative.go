@@ -1,10 +1,11 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
 package spirv
 
-// OpDPdx is equivalent to either OpDPdxFine or OpDPdxCoarse on P.
-// Selection of which one is based on external factors.
+// OpDPdx is the same result as either OpDPdxFine or OpDPdxCoarse on P.
 type OpDPdx struct {
 	ResultType Id
 	ResultId   Id
@@ -15,8 +16,7 @@ func (c *OpDPdx) Opcode() uint32 { return opcodeDPdx }
 func (c *OpDPdx) Optional() bool { return false }
 func (c *OpDPdx) Verify() error  { return nil }
 
-// OpDPdy is equivalent to either OpDPdyFine or OpDPdyCoarse on P.
-// Selection of which one is based on external factors.
+// OpDPdy is the same result as either OpDPdyFine or OpDPdyCoarse on P.
 type OpDPdy struct {
 	ResultType Id
 	ResultId   Id
@@ -27,8 +27,8 @@ func (c *OpDPdy) Opcode() uint32 { return opcodeDPdy }
 func (c *OpDPdy) Optional() bool { return false }
 func (c *OpDPdy) Verify() error  { return nil }
 
-// OpFwidth is equivalent to computing the sum of the absolute values of
-// OpDPdx and OpDPdy on P.
+// OpFwidth is the same result as adding the absolute values of OpDPdx and
+// OpDPdy on P.
 type OpFwidth struct {
 	ResultType Id
 	ResultId   Id
@@ -39,8 +39,8 @@ func (c *OpFwidth) Opcode() uint32 { return opcodeFwidth }
 func (c *OpFwidth) Optional() bool { return false }
 func (c *OpFwidth) Verify() error  { return nil }
 
-// OpDPdxFine calculates the partial derivative of P with respect to the
-// window x coordinate.
+// OpDPdxFine is the partial derivative of P with respect to the window x
+// coordinate, using local differencing.
 type OpDPdxFine struct {
 	ResultType Id
 	ResultId   Id
@@ -51,8 +51,8 @@ func (c *OpDPdxFine) Opcode() uint32 { return opcodeDPdxFine }
 func (c *OpDPdxFine) Optional() bool { return false }
 func (c *OpDPdxFine) Verify() error  { return nil }
 
-// OpDPdyFine calculates the partial derivative of P with respect to the
-// window y coordinate.
+// OpDPdyFine is the partial derivative of P with respect to the window y
+// coordinate, using local differencing.
 type OpDPdyFine struct {
 	ResultType Id
 	ResultId   Id
@@ -63,8 +63,8 @@ func (c *OpDPdyFine) Opcode() uint32 { return opcodeDPdyFine }
 func (c *OpDPdyFine) Optional() bool { return false }
 func (c *OpDPdyFine) Verify() error  { return nil }
 
-// OpFwidthFine is equivalent to computing the sum of the absolute values
-// of OpDPdxFine and OpDPdyFine on P.
+// OpFwidthFine is the sum of the absolute values of OpDPdxFine and OpDPdyFine
+// on P.
 type OpFwidthFine struct {
 	ResultType Id
 	ResultId   Id
@@ -75,8 +75,8 @@ func (c *OpFwidthFine) Opcode() uint32 { return opcodeFwidthFine }
 func (c *OpFwidthFine) Optional() bool { return false }
 func (c *OpFwidthFine) Verify() error  { return nil }
 
-// OpDPdxCoarse calculates the partial derivative of P with respect to the
-// window x coordinate.
+// OpDPdxCoarse is the partial derivative of P with respect to the window x
+// coordinate, using local differencing over a coarser granularity.
 type OpDPdxCoarse struct {
 	ResultType Id
 	ResultId   Id
@@ -87,8 +87,8 @@ func (c *OpDPdxCoarse) Opcode() uint32 { return opcodeDPdxCoarse }
 func (c *OpDPdxCoarse) Optional() bool { return false }
 func (c *OpDPdxCoarse) Verify() error  { return nil }
 
-// OpDPdyCoarse calculates the partial derivative of P with respect to the
-// window y coordinate.
+// OpDPdyCoarse is the partial derivative of P with respect to the window y
+// coordinate, using local differencing over a coarser granularity.
 type OpDPdyCoarse struct {
 	ResultType Id
 	ResultId   Id
@@ -99,8 +99,8 @@ func (c *OpDPdyCoarse) Opcode() uint32 { return opcodeDPdyCoarse }
 func (c *OpDPdyCoarse) Optional() bool { return false }
 func (c *OpDPdyCoarse) Verify() error  { return nil }
 
-// OpFwidthCoarse is equivalent to computing the sum of the absolute values
-// of OpDPdxCoarse and OpDPdyCoarse on P.
+// OpFwidthCoarse is the sum of the absolute values of OpDPdxCoarse and
+// OpDPdyCoarse on P.
 type OpFwidthCoarse struct {
 	ResultType Id
 	ResultId   Id
@@ -1,13 +1,16 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
 package spirv
 
-// OpEnqueueMarker enqueues a marker command to to the queue object specified by Q.
+// OpEnqueueMarker enqueues a marker command to the queue object specified by
+// Queue.
 type OpEnqueueMarker struct {
 	ResultType Id
 	ResultId   Id
-	Q          Id
+	Queue      Id
 	NumEvents  Id
 	WaitEvents Id
 	RetEvent   Id
@@ -17,13 +20,13 @@ func (c *OpEnqueueMarker) Opcode() uint32 { return opcodeEnqueueMarker }
 func (c *OpEnqueueMarker) Optional() bool { return false }
 func (c *OpEnqueueMarker) Verify() error  { return nil }
 
-// OpEnqueueKernel enqueues the function specified by Invoke and NDRange
-// for execution to the queue object specified by Q.
+// OpEnqueueKernel enqueues the function specified by Invoke and the NDRange
+// specified by ND Range for execution to the queue object specified by Queue.
 type OpEnqueueKernel struct {
 	ResultType Id
 	ResultId   Id
-	Q          Id
-	Flags      KernelEnqueueFlag
+	Queue      Id
+	Flags      Id
 	NDRange    Id
 	NumEvents  Id
 	WaitEvents Id
@@ -32,7 +35,7 @@ type OpEnqueueKernel struct {
 	Param      Id
 	ParamSize  Id
 	ParamAlign Id
-	LocalSize  []Id `spirv:"optional"`
+	LocalSize  []Id
 }
 
 func (c *OpEnqueueKernel) Opcode() uint32 { return opcodeEnqueueKernel }
@@ -40,26 +43,31 @@ func (c *OpEnqueueKernel) Optional() bool { return false }
 func (c *OpEnqueueKernel) Verify() error  { return nil }
 
 // OpGetKernelNDrangeSubGroupCount returns the number of subgroups in each
-// work-group of the dispatch given the combination of NDRange and
-// Invoke.
+// workgroup of the dispatch.
 type OpGetKernelNDrangeSubGroupCount struct {
 	ResultType Id
 	ResultId   Id
 	NDRange    Id
 	Invoke     Id
+	Param      Id
+	ParamSize  Id
+	ParamAlign Id
 }
 
 func (c *OpGetKernelNDrangeSubGroupCount) Opcode() uint32 { return opcodeGetKernelNDrangeSubGroupCount }
 func (c *OpGetKernelNDrangeSubGroupCount) Optional() bool { return false }
 func (c *OpGetKernelNDrangeSubGroupCount) Verify() error  { return nil }
 
-// OpGetKernelNDrangeMaxSubGroupSize returns the maximum sub-group size for
-// Invoke and NDRange.
+// OpGetKernelNDrangeMaxSubGroupSize returns the maximum subgroup size for a
+// block.
 type OpGetKernelNDrangeMaxSubGroupSize struct {
 	ResultType Id
 	ResultId   Id
 	NDRange    Id
 	Invoke     Id
+	Param      Id
+	ParamSize  Id
+	ParamAlign Id
 }
 
 func (c *OpGetKernelNDrangeMaxSubGroupSize) Opcode() uint32 {
@@ -69,11 +77,14 @@ func (c *OpGetKernelNDrangeMaxSubGroupSize) Optional() bool { return false }
 func (c *OpGetKernelNDrangeMaxSubGroupSize) Verify() error  { return nil }
 
 // OpGetKernelWorkGroupSize returns the maximum work-group size that can be
-// used to execute Invoke on the device.
+// used to execute the function specified by Invoke on the device.
 type OpGetKernelWorkGroupSize struct {
 	ResultType Id
 	ResultId   Id
 	Invoke     Id
+	Param      Id
+	ParamSize  Id
+	ParamAlign Id
 }
 
 func (c *OpGetKernelWorkGroupSize) Opcode() uint32 { return opcodeGetKernelWorkGroupSize }
@@ -81,11 +92,14 @@ func (c *OpGetKernelWorkGroupSize) Optional() bool { return false }
 func (c *OpGetKernelWorkGroupSize) Verify() error  { return nil }
 
 // OpGetKernelPreferredWorkGroupSizeMultiple returns the preferred multiple of
-// work-group size for Invoke.
+// work-group size for the function specified by Invoke.
 type OpGetKernelPreferredWorkGroupSizeMultiple struct {
 	ResultType Id
 	ResultId   Id
 	Invoke     Id
+	Param      Id
+	ParamSize  Id
+	ParamAlign Id
 }
 
 func (c *OpGetKernelPreferredWorkGroupSizeMultiple) Opcode() uint32 {
@@ -94,7 +108,8 @@ func (c *OpGetKernelPreferredWorkGroupSizeMultiple) Opcode() uint32 {
 func (c *OpGetKernelPreferredWorkGroupSizeMultiple) Optional() bool { return false }
 func (c *OpGetKernelPreferredWorkGroupSizeMultiple) Verify() error  { return nil }
 
-// OpRetainEvent increments the reference count of Event.
+// OpRetainEvent increments the reference count of the event object specified
+// by Event.
 type OpRetainEvent struct {
 	Event Id
 }
@@ -103,12 +118,13 @@ func (c *OpRetainEvent) Opcode() uint32 { return opcodeRetainEvent }
 func (c *OpRetainEvent) Optional() bool { return false }
 func (c *OpRetainEvent) Verify() error  { return nil }
 
-// OpReleaseEvent decrements the reference count of Event.
+// OpReleaseEvent decrements the reference count of the event object specified
+// by Event.
 type OpReleaseEvent struct {
 	Event Id
 }
 
-func (c *OpReleaseEvent) Opcode() uint32 { return 256 }
+func (c *OpReleaseEvent) Opcode() uint32 { return opcodeReleaseEvent }
 func (c *OpReleaseEvent) Optional() bool { return false }
 func (c *OpReleaseEvent) Verify() error  { return nil }
 
@@ -122,8 +138,8 @@ func (c *OpCreateUserEvent) Opcode() uint32 { return opcodeCreateUserEvent }
 func (c *OpCreateUserEvent) Optional() bool { return false }
 func (c *OpCreateUserEvent) Verify() error  { return nil }
 
-// OpIsValidEvent returns true if Event is a valid event, otherwise returns
-// false.
+// OpIsValidEvent results in true if the event specified by Event is a valid
+// event.
 type OpIsValidEvent struct {
 	ResultType Id
 	ResultId   Id
@@ -134,7 +150,8 @@ func (c *OpIsValidEvent) Opcode() uint32 { return opcodeIsValidEvent }
 func (c *OpIsValidEvent) Optional() bool { return false }
 func (c *OpIsValidEvent) Verify() error  { return nil }
 
-// OpSetUserEventStatus sets the execution status of a user event.
+// OpSetUserEventStatus sets the execution status of a user event specified by
+// Event to Status.
 type OpSetUserEventStatus struct {
 	Event  Id
 	Status Id
@@ -144,13 +161,13 @@ func (c *OpSetUserEventStatus) Opcode() uint32 { return opcodeSetUserEventStatus
 func (c *OpSetUserEventStatus) Optional() bool { return false }
 func (c *OpSetUserEventStatus) Verify() error  { return nil }
 
-// OpCaptureEventProfilingInfo captures the profiling information specified
-// by info for the command associated with the event specified by event in
-// the memory pointed by value.
+// OpCaptureEventProfilingInfo captures the profiling information specified by
+// Profiling Info for the command associated with the event specified by
+// Event.
 type OpCaptureEventProfilingInfo struct {
-	Event Id
-	Info  KernelProfilingInfo
-	Value Id
+	Event         Id
+	ProfilingInfo Id
+	Value         Id
 }
 
 func (c *OpCaptureEventProfilingInfo) Opcode() uint32 { return opcodeCaptureEventProfilingInfo }
@@ -167,7 +184,8 @@ func (c *OpGetDefaultQueue) Opcode() uint32 { return opcodeGetDefaultQueue }
 func (c *OpGetDefaultQueue) Optional() bool { return false }
 func (c *OpGetDefaultQueue) Verify() error  { return nil }
 
-// OpBuildNDRange BLAHBLAH.
+// OpBuildNDRange given the global work size, local work size and global work
+// offset, returns an NDRange structure.
 type OpBuildNDRange struct {
 	ResultType       Id
 	ResultId         Id
@@ -180,10 +198,46 @@ func (c *OpBuildNDRange) Opcode() uint32 { return opcodeBuildNDRange }
 func (c *OpBuildNDRange) Optional() bool { return false }
 func (c *OpBuildNDRange) Verify() error  { return nil }
 
+// OpGetKernelLocalSizeForSubgroupCount returns the 1D local size to enqueue
+// Invoke with Subgroup Count subgroups per workgroup.
+//
+// This instruction requires SPIR-V 1.1 or later.
+type OpGetKernelLocalSizeForSubgroupCount struct {
+	ResultType    Id
+	ResultId      Id
+	SubgroupCount Id
+	Invoke        Id
+	Param         Id
+	ParamSize     Id
+	ParamAlign    Id
+}
+
+func (c *OpGetKernelLocalSizeForSubgroupCount) Opcode() uint32 {
+	return opcodeGetKernelLocalSizeForSubgroupCount
+}
+func (c *OpGetKernelLocalSizeForSubgroupCount) Optional() bool { return false }
+func (c *OpGetKernelLocalSizeForSubgroupCount) Verify() error  { return nil }
+
+// OpGetKernelMaxNumSubgroups returns the maximum number of subgroups that can
+// be used to execute Invoke on the device.
+//
+// This instruction requires SPIR-V 1.1 or later.
+type OpGetKernelMaxNumSubgroups struct {
+	ResultType Id
+	ResultId   Id
+	Invoke     Id
+	Param      Id
+	ParamSize  Id
+	ParamAlign Id
+}
+
+func (c *OpGetKernelMaxNumSubgroups) Opcode() uint32 { return opcodeGetKernelMaxNumSubgroups }
+func (c *OpGetKernelMaxNumSubgroups) Optional() bool { return false }
+func (c *OpGetKernelMaxNumSubgroups) Verify() error  { return nil }
+
 func init() {
-	bind(func() Instruction { return &OpIsValidEvent{} })
 	bind(func() Instruction { return &OpEnqueueMarker{} })
-	bind(func() Instruction { return &OpEnqueueKernel{LocalSize: []Id{}} })
+	bind(func() Instruction { return &OpEnqueueKernel{} })
 	bind(func() Instruction { return &OpGetKernelNDrangeSubGroupCount{} })
 	bind(func() Instruction { return &OpGetKernelNDrangeMaxSubGroupSize{} })
 	bind(func() Instruction { return &OpGetKernelWorkGroupSize{} })
@@ -191,8 +245,11 @@ func init() {
 	bind(func() Instruction { return &OpRetainEvent{} })
 	bind(func() Instruction { return &OpReleaseEvent{} })
 	bind(func() Instruction { return &OpCreateUserEvent{} })
+	bind(func() Instruction { return &OpIsValidEvent{} })
 	bind(func() Instruction { return &OpSetUserEventStatus{} })
 	bind(func() Instruction { return &OpCaptureEventProfilingInfo{} })
 	bind(func() Instruction { return &OpGetDefaultQueue{} })
 	bind(func() Instruction { return &OpBuildNDRange{} })
+	bind(func() Instruction { return &OpGetKernelLocalSizeForSubgroupCount{} })
+	bind(func() Instruction { return &OpGetKernelMaxNumSubgroups{} })
 }
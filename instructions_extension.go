@@ -1,12 +1,11 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
 package spirv
 
-// OpExtension defines the OpExtension instruction.
-//
-// It declares use of an extension to SPIR-V. This allows validation of
-// additional instructions, tokens, semantics, etc
+// OpExtension declares use of an extension to SPIR-V.
 type OpExtension struct {
 	Name String
 }
@@ -15,10 +14,7 @@ func (c *OpExtension) Opcode() uint32 { return opcodeExtension }
 func (c *OpExtension) Optional() bool { return false }
 func (c *OpExtension) Verify() error  { return nil }
 
-// OpExtInstImport defines the OpExtInstImport instruction.
-//
-// It defines an import of an extended set of instructions.
-// It can later be referenced by the Result <id>
+// OpExtInstImport imports an extended set of instructions.
 type OpExtInstImport struct {
 	ResultId Id
 	Name     String
@@ -28,13 +24,14 @@ func (c *OpExtInstImport) Opcode() uint32 { return opcodeExtInstImport }
 func (c *OpExtInstImport) Optional() bool { return false }
 func (c *OpExtInstImport) Verify() error  { return nil }
 
-// OpExtInst defines an instruction in an imported set of extended instructions.
+// OpExtInst executes an instruction in an imported set of extended
+// instructions.
 type OpExtInst struct {
 	ResultType  Id
 	ResultId    Id
-	Set         Id     // Result of an OpExtInstImport instruction.
-	Instruction uint32 // Enumerant of the instruction to execute within the extended instruction Set.
-	Operands    []Id   // Operands to the extended instruction.
+	Set         Id
+	Instruction uint32
+	Operands    []Id
 }
 
 func (c *OpExtInst) Opcode() uint32 { return opcodeExtInst }
@@ -8,43 +8,26 @@ import "testing"
 func TestExtensions(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{
-				0x00070003,
-				0x74736574,
-				0x756f7320,
-				0x20656372,
-				0x65747865,
-				0x6f69736e,
-				0x0000006e,
-			},
+			in: []uint32{0x0003000a, 0x74736574, 0},
 			want: &OpExtension{
-				Name: "test source extension",
+				Name: "test",
 			},
 		},
 		{
-			in: []uint32{
-				0x00080004,
-				0x00000023,
-				0x74736574,
-				0x756f7320,
-				0x20656372,
-				0x65747865,
-				0x6f69736e,
-				0x0000006e,
-			},
+			in: []uint32{0x0004000b, 1, 0x74736574, 0},
 			want: &OpExtInstImport{
-				ResultId: 0x23,
-				Name:     "test source extension",
+				ResultId: 1,
+				Name:     "test",
 			},
 		},
 		{
-			in: []uint32{0x0008002c, 1, 2, 3, 4, 5, 6, 7},
+			in: []uint32{0x0007000c, 1, 2, 3, 4, 5, 6},
 			want: &OpExtInst{
 				ResultType:  1,
 				ResultId:    2,
 				Set:         3,
 				Instruction: 4,
-				Operands:    []Id{5, 6, 7},
+				Operands:    []Id{5, 6},
 			},
 		},
 	} {
@@ -1,48 +1,39 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
-
-import "fmt"
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
 
-// TODO: Context-aware validation. Most instructions here require context
-// (i.e., module) to properly validate.
+package spirv
 
 // OpPhi is the SSA phi function.
 type OpPhi struct {
 	ResultType Id
 	ResultId   Id
-	Operands   []Id
+
+	// Operands holds (variable, parent block) pairs.
+	Operands []Id
 }
 
 func (c *OpPhi) Opcode() uint32 { return opcodePhi }
 func (c *OpPhi) Optional() bool { return false }
-func (c *OpPhi) Verify() error {
-	if len(c.Operands) == 0 {
-		return fmt.Errorf("OpPhi: expected operands")
-	}
-
-	if len(c.Operands)%2 != 0 {
-		return fmt.Errorf("OpPhi: expected array of (Variable, ParentBlock) operand pairs")
-	}
 
-	return nil
-}
-
-// OpLoopMerge declares and controls a structured control-flow loop construct.
+// OpLoopMerge declares a structured loop, naming its merge block and continue
+// target.
 type OpLoopMerge struct {
-	Label       Id
-	LoopControl LoopControl
+	MergeBlock     Id
+	ContinueTarget Id
+	LoopControl    LoopControl
+
+	// Argv holds the extra operands required by LoopControl.
+	Argv []uint32 `spirv:"optional"`
 }
 
 func (c *OpLoopMerge) Opcode() uint32 { return opcodeLoopMerge }
 func (c *OpLoopMerge) Optional() bool { return false }
-func (c *OpLoopMerge) Verify() error  { return nil }
 
-// OpSelectionMerge declares and controls a structured control-flow selection
-// construct, used with OpBranchConditional or OpSwitch.
+// OpSelectionMerge declares a structured selection, naming its merge block.
 type OpSelectionMerge struct {
-	Label            Id
+	MergeBlock       Id
 	SelectionControl SelectionControl
 }
 
@@ -50,7 +41,7 @@ func (c *OpSelectionMerge) Opcode() uint32 { return opcodeSelectionMerge }
 func (c *OpSelectionMerge) Optional() bool { return false }
 func (c *OpSelectionMerge) Verify() error  { return nil }
 
-// OpLabel defines a block label.
+// OpLabel is the label instruction of a block.
 type OpLabel struct {
 	ResultId Id
 }
@@ -59,7 +50,7 @@ func (c *OpLabel) Opcode() uint32 { return opcodeLabel }
 func (c *OpLabel) Optional() bool { return false }
 func (c *OpLabel) Verify() error  { return nil }
 
-// OpBranch is an unconditional branch to TargetLabel.
+// OpBranch is an unconditional branch to Target Label.
 type OpBranch struct {
 	TargetLabel Id
 }
@@ -68,63 +59,39 @@ func (c *OpBranch) Opcode() uint32 { return opcodeBranch }
 func (c *OpBranch) Optional() bool { return false }
 func (c *OpBranch) Verify() error  { return nil }
 
-// OpBranchConditional branches to TrueLabel if Condition is true, or to
+// OpBranchConditional branches to True Label if Condition is true, or to
 // False Label if Condition is false.
 type OpBranchConditional struct {
 	Condition     Id
 	TrueLabel     Id
 	FalseLabel    Id
-	BranchWeights []uint32 `spirv:"optional"`
+	BranchWeights []uint32
 }
 
 func (c *OpBranchConditional) Opcode() uint32 { return opcodeBranchConditional }
 func (c *OpBranchConditional) Optional() bool { return false }
-func (c *OpBranchConditional) Verify() error {
-	if len(c.BranchWeights) != 0 && len(c.BranchWeights) != 2 {
-		return fmt.Errorf("OpBranchConditional: BranchWeights expects 0 or 2 elements")
-	}
-	return nil
-}
 
-// OpSwitch branches to a matching operand label.
+// OpSwitch is a multi-way branch to one of the operand label <id>s.
 type OpSwitch struct {
 	Selector Id
 	Default  Id
-	// TODO: struct for pair (Value, LabelId)
-	Target []uint32 `spirv:"optional"`
+
+	// Target holds (literal, label <id>) pairs. The width of each literal
+	// follows that of the Selector type.
+	Target []uint32
 }
 
 func (c *OpSwitch) Opcode() uint32 { return opcodeSwitch }
 func (c *OpSwitch) Optional() bool { return false }
-func (c *OpSwitch) Verify() error {
-	if len(c.Target)%2 != 0 {
-		return fmt.Errorf("OpSwitch: Target expects array of (LiteralNumber, Label) pairs")
-	}
-
-	for j := 0; j < len(c.Target); j += 2 {
-		for k := j + 2; k < len(c.Target); k += 2 {
-			if c.Target[j] == c.Target[k] {
-				return fmt.Errorf("OpSwitch: Target literals must be unique")
-			}
-		}
-	}
-
-	return nil
-}
+func (c *OpSwitch) Verify() error  { return nil }
 
-// OpKill discards the fragment shader.
+// OpKill discards the current fragment shader invocation.
 type OpKill struct{}
 
 func (c *OpKill) Opcode() uint32 { return opcodeKill }
 func (c *OpKill) Optional() bool { return false }
 func (c *OpKill) Verify() error  { return nil }
 
-func init() {
-	bind(func() Instruction {
-		return &OpKill{}
-	})
-}
-
 // OpReturn returns with no value from a function with void return type.
 type OpReturn struct{}
 
@@ -132,7 +99,7 @@ func (c *OpReturn) Opcode() uint32 { return opcodeReturn }
 func (c *OpReturn) Optional() bool { return false }
 func (c *OpReturn) Verify() error  { return nil }
 
-// OpReturnValue returns with a value from a function.
+// OpReturnValue returns a value from a function.
 type OpReturnValue struct {
 	Value Id
 }
@@ -141,36 +108,54 @@ func (c *OpReturnValue) Opcode() uint32 { return opcodeReturnValue }
 func (c *OpReturnValue) Optional() bool { return false }
 func (c *OpReturnValue) Verify() error  { return nil }
 
-// OpUnreachable declares that this block is not reachable in the Control
-// Flow Graph.
+// OpUnreachable declares that this block is not reachable in the CFG.
 type OpUnreachable struct{}
 
 func (c *OpUnreachable) Opcode() uint32 { return opcodeUnreachable }
 func (c *OpUnreachable) Optional() bool { return false }
 func (c *OpUnreachable) Verify() error  { return nil }
 
-// OpLifetimeStart declares that the content of the object pointed to was
-// not defined before this instruction.
+// OpLifetimeStart declares that an object was not defined before this
+// instruction.
 type OpLifetimeStart struct {
-	Object       Id
-	MemoryAmount uint32
+	Pointer Id
+	Size    uint32
 }
 
 func (c *OpLifetimeStart) Opcode() uint32 { return opcodeLifetimeStart }
 func (c *OpLifetimeStart) Optional() bool { return false }
 func (c *OpLifetimeStart) Verify() error  { return nil }
 
-// OpLifetimeStop declares that the content of the object pointed to is
-// dead after this instruction.
+// OpLifetimeStop declares that an object is dead after this instruction.
 type OpLifetimeStop struct {
-	Object       Id
-	MemoryAmount uint32
+	Pointer Id
+	Size    uint32
 }
 
 func (c *OpLifetimeStop) Opcode() uint32 { return opcodeLifetimeStop }
 func (c *OpLifetimeStop) Optional() bool { return false }
 func (c *OpLifetimeStop) Verify() error  { return nil }
 
+// OpTerminateInvocation stops execution of the current fragment shader
+// invocation.
+//
+// This instruction requires SPIR-V 1.6 or later.
+type OpTerminateInvocation struct{}
+
+func (c *OpTerminateInvocation) Opcode() uint32 { return opcodeTerminateInvocation }
+func (c *OpTerminateInvocation) Optional() bool { return false }
+func (c *OpTerminateInvocation) Verify() error  { return nil }
+
+// OpDemoteToHelperInvocation demotes the current fragment shader invocation
+// to a helper invocation.
+//
+// This instruction requires SPIR-V 1.6 or later.
+type OpDemoteToHelperInvocation struct{}
+
+func (c *OpDemoteToHelperInvocation) Opcode() uint32 { return opcodeDemoteToHelperInvocation }
+func (c *OpDemoteToHelperInvocation) Optional() bool { return false }
+func (c *OpDemoteToHelperInvocation) Verify() error  { return nil }
+
 func init() {
 	bind(func() Instruction { return &OpPhi{} })
 	bind(func() Instruction { return &OpLoopMerge{} })
@@ -179,9 +164,12 @@ func init() {
 	bind(func() Instruction { return &OpBranch{} })
 	bind(func() Instruction { return &OpBranchConditional{} })
 	bind(func() Instruction { return &OpSwitch{} })
+	bind(func() Instruction { return &OpKill{} })
 	bind(func() Instruction { return &OpReturn{} })
 	bind(func() Instruction { return &OpReturnValue{} })
 	bind(func() Instruction { return &OpUnreachable{} })
 	bind(func() Instruction { return &OpLifetimeStart{} })
 	bind(func() Instruction { return &OpLifetimeStop{} })
+	bind(func() Instruction { return &OpTerminateInvocation{} })
+	bind(func() Instruction { return &OpDemoteToHelperInvocation{} })
 }
@@ -8,7 +8,7 @@ import "testing"
 func TestFlowControl(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{0x00050030, 1, 2, 3, 4},
+			in: []uint32{0x000500f5, 1, 2, 3, 4},
 			want: &OpPhi{
 				ResultType: 1,
 				ResultId:   2,
@@ -16,41 +16,19 @@ func TestFlowControl(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000300ce, 1, 2},
-			want: &OpLoopMerge{
-				Label:       1,
-				LoopControl: 2,
-			},
-		},
-		{
-			in: []uint32{0x000300cf, 1, 2},
-			want: &OpSelectionMerge{
-				Label:            1,
-				SelectionControl: 2,
-			},
-		},
-		{
-			in: []uint32{0x000200d0, 1},
+			in: []uint32{0x000200f8, 1},
 			want: &OpLabel{
 				ResultId: 1,
 			},
 		},
 		{
-			in: []uint32{0x000200d1, 1},
+			in: []uint32{0x000200f9, 1},
 			want: &OpBranch{
 				TargetLabel: 1,
 			},
 		},
 		{
-			in: []uint32{0x000400d2, 1, 2, 3},
-			want: &OpBranchConditional{
-				Condition:  1,
-				TrueLabel:  2,
-				FalseLabel: 3,
-			},
-		},
-		{
-			in: []uint32{0x000600d2, 1, 2, 3, 4, 5},
+			in: []uint32{0x000600fa, 1, 2, 3, 4, 5},
 			want: &OpBranchConditional{
 				Condition:     1,
 				TrueLabel:     2,
@@ -59,14 +37,7 @@ func TestFlowControl(t *testing.T) {
 			},
 		},
 		{
-			in: []uint32{0x000300d3, 1, 2},
-			want: &OpSwitch{
-				Selector: 1,
-				Default:  2,
-			},
-		},
-		{
-			in: []uint32{0x000500d3, 1, 2, 3, 4},
+			in: []uint32{0x000500fb, 1, 2, 3, 4},
 			want: &OpSwitch{
 				Selector: 1,
 				Default:  2,
@@ -74,35 +45,31 @@ func TestFlowControl(t *testing.T) {
 			},
 		},
 		{
-			in:   []uint32{0x000100d4},
-			want: &OpKill{},
-		},
-		{
-			in:   []uint32{0x000100d5},
-			want: &OpReturn{},
-		},
-		{
-			in: []uint32{0x000200d6, 1},
+			in: []uint32{0x000200fe, 1},
 			want: &OpReturnValue{
 				Value: 1,
 			},
 		},
 		{
-			in:   []uint32{0x000100d7},
-			want: &OpUnreachable{},
-		},
-		{
-			in: []uint32{0x000300d8, 1, 2},
+			in: []uint32{0x00030100, 1, 2},
 			want: &OpLifetimeStart{
-				Object:       1,
-				MemoryAmount: 2,
+				Pointer: 1,
+				Size:    2,
 			},
 		},
 		{
-			in: []uint32{0x000300d9, 1, 2},
+			in: []uint32{0x00030101, 1, 2},
 			want: &OpLifetimeStop{
-				Object:       1,
-				MemoryAmount: 2,
+				Pointer: 1,
+				Size:    2,
+			},
+		},
+		{
+			in: []uint32{0x000400f6, 1, 2, 0},
+			want: &OpLoopMerge{
+				MergeBlock:     1,
+				ContinueTarget: 2,
+				LoopControl:    LoopControlNone,
 			},
 		},
 	} {
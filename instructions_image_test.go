@@ -0,0 +1,89 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "testing"
+
+func TestImage(t *testing.T) {
+	for _, st := range []InstructionTest{
+		{
+			in: []uint32{0x00050056, 1, 2, 3, 4},
+			want: &OpSampledImage{
+				ResultType: 1,
+				ResultId:   2,
+				Image:      3,
+				Sampler:    4,
+			},
+		},
+		{
+			in: []uint32{0x00050057, 1, 2, 3, 4},
+			want: &OpImageSampleImplicitLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+			},
+		},
+		{
+			in: []uint32{0x00060059, 1, 2, 3, 4, 5},
+			want: &OpImageSampleDrefImplicitLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+				Dref:         5,
+			},
+		},
+		{
+			in: []uint32{0x0005005b, 1, 2, 3, 4},
+			want: &OpImageSampleProjImplicitLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+			},
+		},
+		{
+			in: []uint32{0x0006005d, 1, 2, 3, 4, 5},
+			want: &OpImageSampleProjDrefImplicitLod{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+				Dref:         5,
+			},
+		},
+		{
+			in: []uint32{0x0005005f, 1, 2, 3, 4},
+			want: &OpImageFetch{
+				ResultType: 1,
+				ResultId:   2,
+				Image:      3,
+				Coordinate: 4,
+			},
+		},
+		{
+			in: []uint32{0x00060060, 1, 2, 3, 4, 5},
+			want: &OpImageGather{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+				Component:    5,
+			},
+		},
+		{
+			in: []uint32{0x00060061, 1, 2, 3, 4, 5},
+			want: &OpImageDrefGather{
+				ResultType:   1,
+				ResultId:     2,
+				SampledImage: 3,
+				Coordinate:   4,
+				Dref:         5,
+			},
+		},
+	} {
+		testInstruction(t, st)
+	}
+}
@@ -1,241 +1,229 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
 package spirv
 
-// OpVariable allocates an object in memory, resulting in a pointer
-// to it, which can be used with OpLoad and OpStore.
+// OpVariable allocates an object in memory, resulting in a pointer to it.
 type OpVariable struct {
-	// Result Type is a type from OpTypePointer, where the type pointed to
-	// is the type of object in memory.
-	ResultType Id
-	ResultId   Id
-
-	// Storage Class is the kind of memory holding the object.
+	ResultType   Id
+	ResultId     Id
 	StorageClass StorageClass
-
-	// Initializer is optional. If Initializer is present, it will be the
-	// initial value of the variable’s memory content. Initializer must
-	// be an <id> from a constant instruction. Initializer must have the same
-	// type as the type pointed to by Result Type.
-	Initializer Id `spirv:"optional"`
+	Initializer  Id `spirv:"optional"`
 }
 
 func (c *OpVariable) Opcode() uint32 { return opcodeVariable }
 func (c *OpVariable) Optional() bool { return false }
 func (c *OpVariable) Verify() error  { return nil }
 
-// OpVariableArray allocates N objects sequentially in memory,
-// resulting in a pointer to the first such object.
-//
-// This is not the same thing as allocating a single object that is an array.
-type OpVariableArray struct {
-	// Result Type is a type from OpTypePointer whose type pointed to is
-	// the type of one of the N objects allocated in memory
+// OpImageTexelPointer forms a pointer to a texel of an image.
+type OpImageTexelPointer struct {
 	ResultType Id
-
-	ResultId Id
-
-	// Storage Class is the kind of memory holding the object.
-	StorageClass StorageClass
-
-	// N is the number of objects to allocate.
-	N Id
+	ResultId   Id
+	Image      Id
+	Coordinate Id
+	Sample     Id
 }
 
-func (c *OpVariableArray) Opcode() uint32 { return opcodeVariableArray }
-func (c *OpVariableArray) Optional() bool { return false }
-func (c *OpVariableArray) Verify() error  { return nil }
+func (c *OpImageTexelPointer) Opcode() uint32 { return opcodeImageTexelPointer }
+func (c *OpImageTexelPointer) Optional() bool { return false }
+func (c *OpImageTexelPointer) Verify() error  { return nil }
 
-// OpLoad loads data through a pointer.
+// OpLoad loads through a pointer.
 type OpLoad struct {
-	// Result Type is a type from OpTypePointer whose type pointed to is
-	// the type of one of the N objects allocated in memory
-	ResultType Id
-
-	ResultId Id
+	ResultType   Id
+	ResultId     Id
+	Pointer      Id
+	MemoryAccess *MemoryAccess `spirv:"optional"`
 
-	// Pointer is the pointer to load through. It must have a type of
-	// OpTypePointer whose operand is the same as Result Type.
-	Pointer Id
-
-	// MemoryAccess must be one or more Memory Access literals.
-	// FIXME: Incorrect type in specification.
-	MemoryAccess []MemoryAccess
+	// Argv holds the extra operands required by MemoryAccess.
+	Argv []uint32 `spirv:"optional"`
 }
 
 func (c *OpLoad) Opcode() uint32 { return opcodeLoad }
 func (c *OpLoad) Optional() bool { return false }
-func (c *OpLoad) Verify() error  { return nil }
 
-// OpStore stores data through a pointer.
+// OpStore stores through a pointer.
 type OpStore struct {
-	// Pointer is the pointer to store through. It must have a type
-	// of OpTypePointer whose operand is the same as the type of Object.
-	Pointer Id
-
-	// Object is the object to store.
-	Object Id
+	Pointer      Id
+	Object       Id
+	MemoryAccess *MemoryAccess `spirv:"optional"`
 
-	// MemoryAccess must be one or more Memory Access literals.
-	// FIXME: Incorrect type in specification.
-	MemoryAccess []MemoryAccess
+	// Argv holds the extra operands required by MemoryAccess.
+	Argv []uint32 `spirv:"optional"`
 }
 
 func (c *OpStore) Opcode() uint32 { return opcodeStore }
 func (c *OpStore) Optional() bool { return false }
-func (c *OpStore) Verify() error  { return nil }
 
-// OpCopyMemory copies from the memory pointed to by Source to the
-// memory pointed to by Target.
-//
-// Both operands must be non-void pointers of the same type.
-// Matching storage class is not required. The amount of memory copied is
-// the size of the type pointed to.
+// OpCopyMemory copies from the memory pointed to by Source to the memory
+// pointed to by Target.
 type OpCopyMemory struct {
-	// The target address.
-	Target Id
-
-	// The source address.
-	Source Id
+	Target       Id
+	Source       Id
+	MemoryAccess *MemoryAccess `spirv:"optional"`
 
-	// MemoryAccess must be one or more Memory Access literals.
-	// FIXME: Incorrect type in specification.
-	MemoryAccess []MemoryAccess
+	// Argv holds the extra operands required by MemoryAccess, followed by
+	// the optional memory operands for Source.
+	Argv []uint32 `spirv:"optional"`
 }
 
 func (c *OpCopyMemory) Opcode() uint32 { return opcodeCopyMemory }
 func (c *OpCopyMemory) Optional() bool { return false }
-func (c *OpCopyMemory) Verify() error  { return nil }
 
-// OpCopyMemorySized copies from the memory pointed to by Source to the
-// memory pointed to by Target.
-//
-// Both operands must be non-void pointers of the same type.
-// Matching storage class is not required.
+// OpCopyMemorySized copies Size bytes from the memory pointed to by Source to
+// the memory pointed to by Target.
 type OpCopyMemorySized struct {
-	// The target address.
-	Target Id
-
-	// The source address.
-	Source Id
-
-	// Size is the number of bytes to copy.
-	Size Id
-
-	// MemoryAccess must be one or more Memory Access literals.
-	// FIXME: Incorrect type in specification.
-	MemoryAccess []MemoryAccess
+	Target       Id
+	Source       Id
+	Size         Id
+	MemoryAccess *MemoryAccess `spirv:"optional"`
+
+	// Argv holds the extra operands required by MemoryAccess, followed by
+	// the optional memory operands for Source.
+	Argv []uint32 `spirv:"optional"`
 }
 
 func (c *OpCopyMemorySized) Opcode() uint32 { return opcodeCopyMemorySized }
 func (c *OpCopyMemorySized) Optional() bool { return false }
-func (c *OpCopyMemorySized) Verify() error  { return nil }
 
-// OpAccessChain creates a pointer into a composite object that can be
-// used with OpLoad and OpStore.
-//
-// The storage class of the pointer created will be the same as the storage
-// class of the base operand.
+// OpAccessChain creates a pointer into a composite object.
 type OpAccessChain struct {
 	ResultType Id
 	ResultId   Id
-
-	// Base must be a pointer type, pointing to the base of the object.
-	Base Id
-
-	// Indices walk the type hierarchy to the desired depth, potentially
-	// down to scalar granularity. The type of the pointer created will be to
-	// the type reached by walking the type hierarchy down to the last
-	// provided index.
-	Indices []Id
+	Base       Id
+	Indices    []Id
 }
 
 func (c *OpAccessChain) Opcode() uint32 { return opcodeAccessChain }
 func (c *OpAccessChain) Optional() bool { return false }
 func (c *OpAccessChain) Verify() error  { return nil }
 
-// OpInboundsAccessChain has the same semantics as OpAccessChain, with the
-// addition that the resulting pointer is known to point within the base object.
-type OpInboundsAccessChain struct {
+// OpInBoundsAccessChain has the same semantics as OpAccessChain, with the
+// addition that the resulting pointer is known to point within the base
+// object.
+type OpInBoundsAccessChain struct {
 	ResultType Id
 	ResultId   Id
+	Base       Id
+	Indices    []Id
+}
 
-	// Base must be a pointer type, pointing to the base of the object.
-	Base Id
+func (c *OpInBoundsAccessChain) Opcode() uint32 { return opcodeInBoundsAccessChain }
+func (c *OpInBoundsAccessChain) Optional() bool { return false }
+func (c *OpInBoundsAccessChain) Verify() error  { return nil }
 
-	// Indices walk the type hierarchy to the desired depth, potentially
-	// down to scalar granularity. The type of the pointer created will be to
-	// the type reached by walking the type hierarchy down to the last
-	// provided index.
-	Indices []Id
+// OpPtrAccessChain has the same semantics as OpAccessChain, with the addition
+// of the Element operand.
+type OpPtrAccessChain struct {
+	ResultType Id
+	ResultId   Id
+	Base       Id
+	Element    Id
+	Indices    []Id
 }
 
-func (c *OpInboundsAccessChain) Opcode() uint32 { return opcodeInboundsAccessChain }
-func (c *OpInboundsAccessChain) Optional() bool { return false }
-func (c *OpInboundsAccessChain) Verify() error  { return nil }
+func (c *OpPtrAccessChain) Opcode() uint32 { return opcodePtrAccessChain }
+func (c *OpPtrAccessChain) Optional() bool { return false }
+func (c *OpPtrAccessChain) Verify() error  { return nil }
 
-// OpArraylength results in the length of a run-time array.
-type OpArraylength struct {
+// OpArrayLength returns the length of a run-time array.
+type OpArrayLength struct {
+	ResultType  Id
+	ResultId    Id
+	Structure   Id
+	ArrayMember uint32
+}
+
+func (c *OpArrayLength) Opcode() uint32 { return opcodeArrayLength }
+func (c *OpArrayLength) Optional() bool { return false }
+func (c *OpArrayLength) Verify() error  { return nil }
+
+// OpGenericPtrMemSemantics returns the memory semantics mask of a generic
+// pointer.
+type OpGenericPtrMemSemantics struct {
 	ResultType Id
 	ResultId   Id
+	Pointer    Id
+}
 
-	// Structure must be an object of type OpTypeStruct that contains
-	// a member that is a run-time array.
-	Structure Id
+func (c *OpGenericPtrMemSemantics) Opcode() uint32 { return opcodeGenericPtrMemSemantics }
+func (c *OpGenericPtrMemSemantics) Optional() bool { return false }
+func (c *OpGenericPtrMemSemantics) Verify() error  { return nil }
 
-	// Array member is a member number of Structure that must have a
-	// type from OpTypeRuntimeArray.
-	Member uint32
+// OpInBoundsPtrAccessChain has the same semantics as OpPtrAccessChain, with
+// the addition that the resulting pointer is known to point within the base
+// object.
+type OpInBoundsPtrAccessChain struct {
+	ResultType Id
+	ResultId   Id
+	Base       Id
+	Element    Id
+	Indices    []Id
 }
 
-func (c *OpArraylength) Opcode() uint32 { return opcodeArraylength }
-func (c *OpArraylength) Optional() bool { return false }
-func (c *OpArraylength) Verify() error  { return nil }
+func (c *OpInBoundsPtrAccessChain) Opcode() uint32 { return opcodeInBoundsPtrAccessChain }
+func (c *OpInBoundsPtrAccessChain) Optional() bool { return false }
+func (c *OpInBoundsPtrAccessChain) Verify() error  { return nil }
 
-// OpImagePointer forms a pointer to a texel of an image.
-// Use of such a pointer is limited to atomic operations.
+// OpPtrEqual reports whether Operand 1 and Operand 2 have the same value.
 //
-// TODO: This requires an Image storage class to be added.
-type OpImagePointer struct {
+// This instruction requires SPIR-V 1.4 or later.
+type OpPtrEqual struct {
 	ResultType Id
 	ResultId   Id
+	Operand1   Id
+	Operand2   Id
+}
 
-	// Image is a pointer to a variable of type of OpTypeSampler.
-	Image Id
+func (c *OpPtrEqual) Opcode() uint32 { return opcodePtrEqual }
+func (c *OpPtrEqual) Optional() bool { return false }
+func (c *OpPtrEqual) Verify() error  { return nil }
 
-	// Coordinate and Sample specify which texel and sample within
-	// the image to form an address of.
-	Coordinate Id
-	Sample     Id
+// OpPtrNotEqual reports whether Operand 1 and Operand 2 have different
+// values.
+//
+// This instruction requires SPIR-V 1.4 or later.
+type OpPtrNotEqual struct {
+	ResultType Id
+	ResultId   Id
+	Operand1   Id
+	Operand2   Id
 }
 
-func (c *OpImagePointer) Opcode() uint32 { return opcodeImagePointer }
-func (c *OpImagePointer) Optional() bool { return false }
-func (c *OpImagePointer) Verify() error  { return nil }
+func (c *OpPtrNotEqual) Opcode() uint32 { return opcodePtrNotEqual }
+func (c *OpPtrNotEqual) Optional() bool { return false }
+func (c *OpPtrNotEqual) Verify() error  { return nil }
 
-// OpGenericPtrMemSemantics returns a valid Memory Semantics
-// value for ptr.
-type OpGenericPtrMemSemantics struct {
-	ResultType Id // Result Type must be a 32-bits wide OpTypeInt value
+// OpPtrDiff computes the element-count difference between two pointers.
+//
+// This instruction requires SPIR-V 1.4 or later.
+type OpPtrDiff struct {
+	ResultType Id
 	ResultId   Id
-	Ptr        Id
+	Operand1   Id
+	Operand2   Id
 }
 
-func (c *OpGenericPtrMemSemantics) Opcode() uint32 { return opcodeGenericPtrMemSemantics }
-func (c *OpGenericPtrMemSemantics) Optional() bool { return false }
-func (c *OpGenericPtrMemSemantics) Verify() error  { return nil }
+func (c *OpPtrDiff) Opcode() uint32 { return opcodePtrDiff }
+func (c *OpPtrDiff) Optional() bool { return false }
+func (c *OpPtrDiff) Verify() error  { return nil }
 
 func init() {
-	bind(func() Instruction { return &OpVariable{Initializer: 0} })
-	bind(func() Instruction { return &OpVariableArray{} })
+	bind(func() Instruction { return &OpVariable{} })
+	bind(func() Instruction { return &OpImageTexelPointer{} })
 	bind(func() Instruction { return &OpLoad{} })
 	bind(func() Instruction { return &OpStore{} })
 	bind(func() Instruction { return &OpCopyMemory{} })
 	bind(func() Instruction { return &OpCopyMemorySized{} })
 	bind(func() Instruction { return &OpAccessChain{} })
-	bind(func() Instruction { return &OpInboundsAccessChain{} })
-	bind(func() Instruction { return &OpArraylength{} })
-	bind(func() Instruction { return &OpImagePointer{} })
+	bind(func() Instruction { return &OpInBoundsAccessChain{} })
+	bind(func() Instruction { return &OpPtrAccessChain{} })
+	bind(func() Instruction { return &OpArrayLength{} })
 	bind(func() Instruction { return &OpGenericPtrMemSemantics{} })
+	bind(func() Instruction { return &OpInBoundsPtrAccessChain{} })
+	bind(func() Instruction { return &OpPtrEqual{} })
+	bind(func() Instruction { return &OpPtrNotEqual{} })
+	bind(func() Instruction { return &OpPtrDiff{} })
 }
@@ -1,20 +1,17 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
 package spirv
 
-// OpNop represents the OpNop instruction.
-//
-// Its use is not allowed, but it is explicitely defined in the specification.
-// We will therefor have the decoder and encoder return an appropriate error
-// when it is being used.
+// OpNop is a no-op instruction. Its use is not allowed in a module.
 type OpNop struct{}
 
 func (c *OpNop) Opcode() uint32 { return opcodeNop }
 func (c *OpNop) Optional() bool { return false }
-func (c *OpNop) Verify() error  { return ErrUnacceptable }
 
-// OpUndef makes an intermediate object with no initialization.
+// OpUndef makes an intermediate object whose value is undefined.
 type OpUndef struct {
 	ResultType Id
 	ResultId   Id
@@ -24,7 +21,21 @@ func (c *OpUndef) Opcode() uint32 { return opcodeUndef }
 func (c *OpUndef) Optional() bool { return false }
 func (c *OpUndef) Verify() error  { return nil }
 
+// OpSizeOf computes the run-time size of the type pointed to by Pointer.
+//
+// This instruction requires SPIR-V 1.1 or later.
+type OpSizeOf struct {
+	ResultType Id
+	ResultId   Id
+	Pointer    Id
+}
+
+func (c *OpSizeOf) Opcode() uint32 { return opcodeSizeOf }
+func (c *OpSizeOf) Optional() bool { return false }
+func (c *OpSizeOf) Verify() error  { return nil }
+
 func init() {
 	bind(func() Instruction { return &OpNop{} })
 	bind(func() Instruction { return &OpUndef{} })
+	bind(func() Instruction { return &OpSizeOf{} })
 }
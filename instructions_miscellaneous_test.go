@@ -8,14 +8,18 @@ import "testing"
 func TestMiscellaneous(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in:  []uint32{0x00010000},
-			err: ErrUnacceptable,
+			in: []uint32{0x00030001, 1, 2},
+			want: &OpUndef{
+				ResultType: 1,
+				ResultId:   2,
+			},
 		},
 		{
-			in: []uint32{0x0003002d, 1, 2},
-			want: &OpUndef{
+			in: []uint32{0x00040141, 1, 2, 3},
+			want: &OpSizeOf{
 				ResultType: 1,
 				ResultId:   2,
+				Pointer:    3,
 			},
 		},
 	} {
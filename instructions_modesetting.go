@@ -1,13 +1,12 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
 
-import "fmt"
+package spirv
 
-// OpMemoryModel represents the OpMemoryModel instruction.
-//
-// It sets addressing model and memory model for the entire module.
+// OpMemoryModel sets the addressing model and memory model for the entire
+// module.
 type OpMemoryModel struct {
 	AddressingModel AddressingModel
 	MemoryModel     MemoryModel
@@ -17,69 +16,59 @@ func (c *OpMemoryModel) Opcode() uint32 { return opcodeMemoryModel }
 func (c *OpMemoryModel) Optional() bool { return false }
 func (c *OpMemoryModel) Verify() error  { return nil }
 
-// OpEntryPoint represents the OpEntryPoint instruction.
-// It declares an entry point and its execution model.
+// OpEntryPoint declares an entry point, its execution model and its
+// interface.
 type OpEntryPoint struct {
 	ExecutionModel ExecutionModel
-	ResultId       Id
+	EntryPoint     Id
+	Name           String
+	Interface      []Id
 }
 
 func (c *OpEntryPoint) Opcode() uint32 { return opcodeEntryPoint }
 func (c *OpEntryPoint) Optional() bool { return false }
 func (c *OpEntryPoint) Verify() error  { return nil }
 
-// OpExecutionMode represents the OpExecutionMode instruction.
-// It declares an execution mode for an entry point.
+// OpExecutionMode declares an execution mode for an entry point.
 type OpExecutionMode struct {
 	EntryPoint Id
 	Mode       ExecutionMode
-	Argv       []uint32
+
+	// Argv holds the extra operands required by the execution mode.
+	Argv []uint32
 }
 
 func (c *OpExecutionMode) Opcode() uint32 { return opcodeExecutionMode }
 func (c *OpExecutionMode) Optional() bool { return false }
-func (c *OpExecutionMode) Verify() error {
-	argc := len(c.Argv)
-
-	switch c.Mode {
-	case ExecutionModeInvocations,
-		ExecutionModeOutputVertices,
-		ExecutionModeVecTypeHint:
-		if argc != 1 {
-			return fmt.Errorf("OpExecutionMode: ExecutionMode(%d) must have 1 argument", c.Mode)
-		}
-
-		return nil
 
-	case ExecutionModeLocalSize,
-		ExecutionModeLocalSizeHint:
-		if argc != 3 {
-			return fmt.Errorf("OpExecutionMode: ExecutionMode(%d) must have 3 arguments", c.Mode)
-		}
-
-		return nil
-	}
+// OpCapability declares a capability used by this module.
+type OpCapability struct {
+	Capability Capability
+}
 
-	if argc > 0 {
-		return fmt.Errorf("OpExecutionMode: extraneous arguments for ExecutionMode(%d)",
-			c.Mode)
-	}
+func (c *OpCapability) Opcode() uint32 { return opcodeCapability }
+func (c *OpCapability) Optional() bool { return false }
+func (c *OpCapability) Verify() error  { return nil }
 
-	return nil
-}
+// OpExecutionModeId declares an execution mode for an entry point, using
+// <id>s as the mode's extra operands.
+//
+// This instruction requires SPIR-V 1.2 or later.
+type OpExecutionModeId struct {
+	EntryPoint Id
+	Mode       ExecutionMode
 
-// OpCompileFlag represents the OpCompileFlag instruction.
-type OpCompileFlag struct {
-	Flag String
+	// Argv holds the extra <id> operands required by the execution mode.
+	Argv []Id
 }
 
-func (c *OpCompileFlag) Opcode() uint32 { return opcodeCompileFlag }
-func (c *OpCompileFlag) Optional() bool { return false }
-func (c *OpCompileFlag) Verify() error  { return nil }
+func (c *OpExecutionModeId) Opcode() uint32 { return opcodeExecutionModeId }
+func (c *OpExecutionModeId) Optional() bool { return false }
 
 func init() {
 	bind(func() Instruction { return &OpMemoryModel{} })
 	bind(func() Instruction { return &OpEntryPoint{} })
 	bind(func() Instruction { return &OpExecutionMode{} })
-	bind(func() Instruction { return &OpCompileFlag{} })
+	bind(func() Instruction { return &OpCapability{} })
+	bind(func() Instruction { return &OpExecutionModeId{} })
 }
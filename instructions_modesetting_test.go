@@ -3,48 +3,45 @@
 
 package spirv
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func TestModeSetting(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in: []uint32{
-				0x00030005,
-				AddressingModePhysical32,
-				MemoryModelGLSL450,
-			},
+			in: []uint32{0x0003000e, 0, 1},
 			want: &OpMemoryModel{
-				AddressingModel: AddressingModePhysical32,
+				AddressingModel: AddressingModelLogical,
 				MemoryModel:     MemoryModelGLSL450,
 			},
 		},
 		{
-			in: []uint32{0x00030006, ExecutionModelFragment, 0x7f},
+			in: []uint32{0x0007000f, 0, 1, 0x6e69616d, 0, 2, 3},
 			want: &OpEntryPoint{
-				ExecutionModel: ExecutionModelFragment,
-				ResultId:       0x7f,
+				ExecutionModel: ExecutionModelVertex,
+				EntryPoint:     1,
+				Name:           "main",
+				Interface:      []Id{2, 3},
 			},
 		},
 		{
-			in: []uint32{0x00060007, 0x7f, ExecutionModeLocalSize, 1, 2, 3},
+			in: []uint32{0x00060010, 1, 17, 8, 8, 1},
 			want: &OpExecutionMode{
-				EntryPoint: 0x7f,
+				EntryPoint: 1,
 				Mode:       ExecutionModeLocalSize,
-				Argv:       []uint32{1, 2, 3},
+				Argv:       []uint32{8, 8, 1},
 			},
 		},
 		{
-			in: []uint32{
-				0x000700da,
-				0x74736574,
-				0x756f7320,
-				0x20656372,
-				0x65747865,
-				0x6f69736e,
-				0x0000006e,
-			},
-			want: &OpCompileFlag{
-				Flag: "test source extension",
+			in:  []uint32{0x00040010, 1, 17, 8},
+			err: fmt.Errorf("OpExecutionMode: ExecutionMode(17) must have 3 argument(s)"),
+		},
+		{
+			in: []uint32{0x00020011, 1},
+			want: &OpCapability{
+				Capability: CapabilityShader,
 			},
 		},
 	} {
@@ -0,0 +1,85 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "testing"
+
+func TestNonUniform(t *testing.T) {
+	for _, st := range []InstructionTest{
+		{
+			in: []uint32{0x0004014d, 1, 2, 3},
+			want: &OpGroupNonUniformElect{
+				ResultType: 1,
+				ResultId:   2,
+				Execution:  3,
+			},
+		},
+		{
+			in: []uint32{0x0005014e, 1, 2, 3, 4},
+			want: &OpGroupNonUniformAll{
+				ResultType: 1,
+				ResultId:   2,
+				Execution:  3,
+				Predicate:  4,
+			},
+		},
+		{
+			in: []uint32{0x0005014f, 1, 2, 3, 4},
+			want: &OpGroupNonUniformAny{
+				ResultType: 1,
+				ResultId:   2,
+				Execution:  3,
+				Predicate:  4,
+			},
+		},
+		{
+			in: []uint32{0x00050150, 1, 2, 3, 4},
+			want: &OpGroupNonUniformAllEqual{
+				ResultType: 1,
+				ResultId:   2,
+				Execution:  3,
+				Value:      4,
+			},
+		},
+		{
+			in: []uint32{0x00060151, 1, 2, 3, 4, 5},
+			want: &OpGroupNonUniformBroadcast{
+				ResultType: 1,
+				ResultId:   2,
+				Execution:  3,
+				Value:      4,
+				Id:         5,
+			},
+		},
+		{
+			in: []uint32{0x00050152, 1, 2, 3, 4},
+			want: &OpGroupNonUniformBroadcastFirst{
+				ResultType: 1,
+				ResultId:   2,
+				Execution:  3,
+				Value:      4,
+			},
+		},
+		{
+			in: []uint32{0x00050153, 1, 2, 3, 4},
+			want: &OpGroupNonUniformBallot{
+				ResultType: 1,
+				ResultId:   2,
+				Execution:  3,
+				Predicate:  4,
+			},
+		},
+		{
+			in: []uint32{0x00050154, 1, 2, 3, 4},
+			want: &OpGroupNonUniformInverseBallot{
+				ResultType: 1,
+				ResultId:   2,
+				Execution:  3,
+				Value:      4,
+			},
+		},
+	} {
+		testInstruction(t, st)
+	}
+}
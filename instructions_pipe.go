@@ -1,26 +1,34 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
 package spirv
 
-// OpReadPipe reads a packet from P into Ptr.
+// OpReadPipe reads a packet from the pipe object specified by Pipe into
+// Pointer.
 type OpReadPipe struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
-	Ptr        Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	Pointer         Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpReadPipe) Opcode() uint32 { return opcodeReadPipe }
 func (c *OpReadPipe) Optional() bool { return false }
 func (c *OpReadPipe) Verify() error  { return nil }
 
-// OpWritePipe writes a packet from Ptr to P.
+// OpWritePipe writes a packet from Pointer to the pipe object specified by
+// Pipe.
 type OpWritePipe struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
-	Ptr        Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	Pointer         Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpWritePipe) Opcode() uint32 { return opcodeWritePipe }
@@ -28,83 +36,96 @@ func (c *OpWritePipe) Optional() bool { return false }
 func (c *OpWritePipe) Verify() error  { return nil }
 
 // OpReservedReadPipe reads a packet from the reserved area specified by
-// ReserveId and Index from P into Ptr.
+// Reserve Id and Index of the pipe object specified by Pipe into Pointer.
 type OpReservedReadPipe struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
-	ReserveId  Id
-	Index      Id
-	Ptr        Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	ReserveId       Id
+	Index           Id
+	Pointer         Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpReservedReadPipe) Opcode() uint32 { return opcodeReservedReadPipe }
 func (c *OpReservedReadPipe) Optional() bool { return false }
 func (c *OpReservedReadPipe) Verify() error  { return nil }
 
-// OpReservedWritePipe writes a packet from Ptr into the reserved area
-// specified by ReserveId and Index into P.
+// OpReservedWritePipe writes a packet from Pointer into the reserved area
+// specified by Reserve Id and Index of the pipe object specified by Pipe.
 type OpReservedWritePipe struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
-	ReserveId  Id
-	Index      Id
-	Ptr        Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	ReserveId       Id
+	Index           Id
+	Pointer         Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpReservedWritePipe) Opcode() uint32 { return opcodeReservedWritePipe }
 func (c *OpReservedWritePipe) Optional() bool { return false }
 func (c *OpReservedWritePipe) Verify() error  { return nil }
 
-// OpReserveReadPipePackets reserves NumPackets entries for reading from P.
+// OpReserveReadPipePackets reserves Num Packets entries for reading from the
+// pipe object specified by Pipe.
 type OpReserveReadPipePackets struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
-	NumPackets Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	NumPackets      Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpReserveReadPipePackets) Opcode() uint32 { return opcodeReserveReadPipePackets }
 func (c *OpReserveReadPipePackets) Optional() bool { return false }
 func (c *OpReserveReadPipePackets) Verify() error  { return nil }
 
-// OpReserveWritePipePackets reserves NumPackets entries for writing to P.
+// OpReserveWritePipePackets reserves Num Packets entries for writing to the
+// pipe object specified by Pipe.
 type OpReserveWritePipePackets struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
-	NumPackets Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	NumPackets      Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpReserveWritePipePackets) Opcode() uint32 { return opcodeReserveWritePipePackets }
 func (c *OpReserveWritePipePackets) Optional() bool { return false }
 func (c *OpReserveWritePipePackets) Verify() error  { return nil }
 
-// OpCommitReadPipe indicates that all reads to NumPackets associated with
-// ReserveId in P are completed.
+// OpCommitReadPipe indicates that all reads to Num Packets associated with
+// Reserve Id are completed.
 type OpCommitReadPipe struct {
-	P         Id
-	ReserveId Id
+	Pipe            Id
+	ReserveId       Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpCommitReadPipe) Opcode() uint32 { return opcodeCommitReadPipe }
 func (c *OpCommitReadPipe) Optional() bool { return false }
 func (c *OpCommitReadPipe) Verify() error  { return nil }
 
-// OpCommitWritePipe indicates that all writes to NumPackets associated
-// with ReserveId in P are completed.
+// OpCommitWritePipe indicates that all writes to Num Packets associated with
+// Reserve Id are completed.
 type OpCommitWritePipe struct {
-	P         Id
-	ReserveId Id
+	Pipe            Id
+	ReserveId       Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpCommitWritePipe) Opcode() uint32 { return opcodeCommitWritePipe }
 func (c *OpCommitWritePipe) Optional() bool { return false }
 func (c *OpCommitWritePipe) Verify() error  { return nil }
 
-// OpIsValidReserveId returns true if ReserveId is a valid reservation ID
-// and false otherwise.
+// OpIsValidReserveId results in true if Reserve Id is a valid reservation id.
 type OpIsValidReserveId struct {
 	ResultType Id
 	ResultId   Id
@@ -115,81 +136,123 @@ func (c *OpIsValidReserveId) Opcode() uint32 { return opcodeIsValidReserveId }
 func (c *OpIsValidReserveId) Optional() bool { return false }
 func (c *OpIsValidReserveId) Verify() error  { return nil }
 
-// OpGetNumPipePackets returns the number of available entries in P.
+// OpGetNumPipePackets results in the number of available entries in the pipe
+// object specified by Pipe.
 type OpGetNumPipePackets struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpGetNumPipePackets) Opcode() uint32 { return opcodeGetNumPipePackets }
 func (c *OpGetNumPipePackets) Optional() bool { return false }
 func (c *OpGetNumPipePackets) Verify() error  { return nil }
 
-// OpGetMaxPipePackets returns the maximum number of packets specified when
-// P was created.
+// OpGetMaxPipePackets results in the maximum number of packets specified when
+// the pipe object was created.
 type OpGetMaxPipePackets struct {
-	ResultType Id
-	ResultId   Id
-	P          Id
+	ResultType      Id
+	ResultId        Id
+	Pipe            Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpGetMaxPipePackets) Opcode() uint32 { return opcodeGetMaxPipePackets }
 func (c *OpGetMaxPipePackets) Optional() bool { return false }
 func (c *OpGetMaxPipePackets) Verify() error  { return nil }
 
-// OpGroupReserveReadPipePackets reserves NumPackets entries for reading
-// from P at group level.
+// OpGroupReserveReadPipePackets reserves Num Packets entries for reading from
+// the pipe object specified by Pipe at group level.
 type OpGroupReserveReadPipePackets struct {
-	ResultType Id
-	ResultId   Id
-	Scope      ExecutionScope
-	P          Id
-	NumPackets Id
+	ResultType      Id
+	ResultId        Id
+	Execution       Id
+	Pipe            Id
+	NumPackets      Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpGroupReserveReadPipePackets) Opcode() uint32 { return opcodeGroupReserveReadPipePackets }
 func (c *OpGroupReserveReadPipePackets) Optional() bool { return false }
 func (c *OpGroupReserveReadPipePackets) Verify() error  { return nil }
 
-// OpGroupReserveWritePipePackets reserves NumPackets entries for writing
-// to P at group level.
+// OpGroupReserveWritePipePackets reserves Num Packets entries for writing to
+// the pipe object specified by Pipe at group level.
 type OpGroupReserveWritePipePackets struct {
-	ResultType Id
-	ResultId   Id
-	Scope      ExecutionScope
-	P          Id
-	NumPackets Id
+	ResultType      Id
+	ResultId        Id
+	Execution       Id
+	Pipe            Id
+	NumPackets      Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpGroupReserveWritePipePackets) Opcode() uint32 { return opcodeGroupReserveWritePipePackets }
 func (c *OpGroupReserveWritePipePackets) Optional() bool { return false }
 func (c *OpGroupReserveWritePipePackets) Verify() error  { return nil }
 
-// OpGroupCommitReadPipe is a group level indication that all reads to
-// NumPackets associated with ReserveId to P are completed.
+// OpGroupCommitReadPipe is a group level indication that all reads to Num
+// Packets associated with Reserve Id are completed.
 type OpGroupCommitReadPipe struct {
-	Scope     ExecutionScope
-	P         Id
-	ReserveId Id
+	Execution       Id
+	Pipe            Id
+	ReserveId       Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpGroupCommitReadPipe) Opcode() uint32 { return opcodeGroupCommitReadPipe }
 func (c *OpGroupCommitReadPipe) Optional() bool { return false }
 func (c *OpGroupCommitReadPipe) Verify() error  { return nil }
 
-// OpGroupCommitWritePipe is a group level indication that all writes to
-// NumPackets associated with ReserveId to P are completed.
+// OpGroupCommitWritePipe is a group level indication that all writes to Num
+// Packets associated with Reserve Id are completed.
 type OpGroupCommitWritePipe struct {
-	Scope     ExecutionScope
-	P         Id
-	ReserveId Id
+	Execution       Id
+	Pipe            Id
+	ReserveId       Id
+	PacketSize      Id
+	PacketAlignment Id
 }
 
 func (c *OpGroupCommitWritePipe) Opcode() uint32 { return opcodeGroupCommitWritePipe }
 func (c *OpGroupCommitWritePipe) Optional() bool { return false }
 func (c *OpGroupCommitWritePipe) Verify() error  { return nil }
 
+// OpConstantPipeStorage creates a pipe-storage object.
+//
+// This instruction requires SPIR-V 1.1 or later.
+type OpConstantPipeStorage struct {
+	ResultType      Id
+	ResultId        Id
+	PacketSize      uint32
+	PacketAlignment uint32
+	Capacity        uint32
+}
+
+func (c *OpConstantPipeStorage) Opcode() uint32 { return opcodeConstantPipeStorage }
+func (c *OpConstantPipeStorage) Optional() bool { return false }
+func (c *OpConstantPipeStorage) Verify() error  { return nil }
+
+// OpCreatePipeFromPipeStorage creates a pipe object from a pipe-storage
+// object.
+//
+// This instruction requires SPIR-V 1.1 or later.
+type OpCreatePipeFromPipeStorage struct {
+	ResultType  Id
+	ResultId    Id
+	PipeStorage Id
+}
+
+func (c *OpCreatePipeFromPipeStorage) Opcode() uint32 { return opcodeCreatePipeFromPipeStorage }
+func (c *OpCreatePipeFromPipeStorage) Optional() bool { return false }
+func (c *OpCreatePipeFromPipeStorage) Verify() error  { return nil }
+
 func init() {
 	bind(func() Instruction { return &OpReadPipe{} })
 	bind(func() Instruction { return &OpWritePipe{} })
@@ -206,4 +269,6 @@ func init() {
 	bind(func() Instruction { return &OpGroupReserveWritePipePackets{} })
 	bind(func() Instruction { return &OpGroupCommitReadPipe{} })
 	bind(func() Instruction { return &OpGroupCommitWritePipe{} })
+	bind(func() Instruction { return &OpConstantPipeStorage{} })
+	bind(func() Instruction { return &OpCreatePipeFromPipeStorage{} })
 }
@@ -1,11 +1,12 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
+// Code generated by gen.go from grammar/spirv.core.grammar.json; DO NOT EDIT.
+
 package spirv
 
 // OpEmitVertex emits the current values of all output variables to the
-// current output primitive. After execution, the values of all output
-// variables are undefined.
+// current output primitive.
 type OpEmitVertex struct{}
 
 func (c *OpEmitVertex) Opcode() uint32 { return opcodeEmitVertex }
@@ -13,20 +14,14 @@ func (c *OpEmitVertex) Optional() bool { return false }
 func (c *OpEmitVertex) Verify() error  { return nil }
 
 // OpEndPrimitive finishes the current primitive and starts a new one.
-// No vertex is emitted.
-//
-// This instruction can only be used when only one stream is present.
 type OpEndPrimitive struct{}
 
 func (c *OpEndPrimitive) Opcode() uint32 { return opcodeEndPrimitive }
 func (c *OpEndPrimitive) Optional() bool { return false }
 func (c *OpEndPrimitive) Verify() error  { return nil }
 
-// OpEmitStreamVertex emits the current values of all output variables to
-// the current output primitive. After execution, the values of all output
-// variables are undefined.
-//
-// This instruction can only be used when multiple streams are present
+// OpEmitStreamVertex emits the current values of all output variables to the
+// current output primitive of Stream.
 type OpEmitStreamVertex struct {
 	Stream Id
 }
@@ -35,10 +30,8 @@ func (c *OpEmitStreamVertex) Opcode() uint32 { return opcodeEmitStreamVertex }
 func (c *OpEmitStreamVertex) Optional() bool { return false }
 func (c *OpEmitStreamVertex) Verify() error  { return nil }
 
-// OpEndStreamPrimitive finishes the current primitive and starts a new one.
-// No vertex is emitted
-//
-// This instruction can only be used when multiple streams are present
+// OpEndStreamPrimitive finishes the current primitive of Stream and starts a
+// new one.
 type OpEndStreamPrimitive struct {
 	Stream Id
 }
@@ -8,21 +8,13 @@ import "testing"
 func TestPrimitive(t *testing.T) {
 	for _, st := range []InstructionTest{
 		{
-			in:   []uint32{0x00100b8},
-			want: &OpEmitVertex{},
-		},
-		{
-			in:   []uint32{0x00100b9},
-			want: &OpEndPrimitive{},
-		},
-		{
-			in: []uint32{0x00200ba, 1},
+			in: []uint32{0x000200dc, 1},
 			want: &OpEmitStreamVertex{
 				Stream: 1,
 			},
 		},
 		{
-			in: []uint32{0x00200bb, 1},
+			in: []uint32{0x000200dd, 1},
 			want: &OpEndStreamPrimitive{
 				Stream: 1,
 			},
@@ -60,6 +60,11 @@ func testInstruction(t *testing.T, st InstructionTest) {
 		t.Fatal(err)
 	}
 
+	err = enc.Flush()
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	outb := testWordReader(st.in)
 	if !bytes.Equal(outa.Bytes(), outb.Bytes()) {
 		t.Fatalf("encode mismatch: %T(%v)\nHave: %v\nWant: %v",
@@ -84,12 +89,12 @@ func TestFaultyInstructions(t *testing.T) {
 			err: ErrMissingInstructionArgs,
 		},
 		{
-			in:  []uint32{0x00010000},
-			err: ErrUnacceptable,
+			in:   []uint32{0x00010000},
+			want: &OpNop{},
 		},
 		{
 			in:  []uint32{0x0001ffff},
-			err: fmt.Errorf("unknown instruction: 0000ffff"),
+			err: fmt.Errorf("%w: %08x", ErrUnknownInstruction, 0xffff),
 		},
 	} {
 		testInstruction(t, st)
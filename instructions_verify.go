@@ -0,0 +1,231 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "fmt"
+
+// This file holds the Verify methods of instructions which need more
+// than the structural checks derived from the grammar. The generator
+// leaves these out of the generated instruction files.
+
+// OpNop is valid in released versions, unlike in the pre-release
+// specification.
+func (c *OpNop) Verify() error {
+	return nil
+}
+
+func (c *OpDecorate) Verify() error {
+	return verifyDecoration("OpDecorate", c.Decoration, len(c.Argv))
+}
+
+func (c *OpMemberDecorate) Verify() error {
+	return verifyDecoration("OpMemberDecorate", c.Decoration, len(c.Argv))
+}
+
+func (c *OpDecorateId) Verify() error {
+	switch c.Decoration {
+	case DecorationUniformId, DecorationAlignmentId, DecorationMaxByteOffsetId,
+		DecorationCounterBuffer:
+		if len(c.Argv) != 1 {
+			return fmt.Errorf("OpDecorateId: Decoration(%d) must have 1 argument", c.Decoration)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("OpDecorateId: Decoration(%d) does not take <id> arguments", c.Decoration)
+}
+
+func (c *OpDecorateString) Verify() error {
+	if c.Decoration != DecorationUserSemantic {
+		return fmt.Errorf("OpDecorateString: Decoration(%d) does not take a string argument", c.Decoration)
+	}
+
+	return nil
+}
+
+func (c *OpMemberDecorateString) Verify() error {
+	if c.Decoration != DecorationUserSemantic {
+		return fmt.Errorf("OpMemberDecorateString: Decoration(%d) does not take a string argument", c.Decoration)
+	}
+
+	return nil
+}
+
+func (c *OpGroupMemberDecorate) Verify() error {
+	if len(c.Targets)%2 != 0 {
+		return fmt.Errorf("OpGroupMemberDecorate: Targets expects array of (Target, Member) pairs")
+	}
+
+	return nil
+}
+
+func (c *OpExecutionMode) Verify() error {
+	argc := c.Mode.argc()
+	if argc < 0 {
+		return fmt.Errorf("OpExecutionMode: ExecutionMode(%d) must be declared with OpExecutionModeId", c.Mode)
+	}
+
+	if len(c.Argv) != argc {
+		return fmt.Errorf("OpExecutionMode: ExecutionMode(%d) must have %d argument(s)", c.Mode, argc)
+	}
+
+	return nil
+}
+
+func (c *OpExecutionModeId) Verify() error {
+	switch c.Mode {
+	case ExecutionModeSubgroupsPerWorkgroupId:
+		if len(c.Argv) != 1 {
+			return fmt.Errorf("OpExecutionModeId: ExecutionMode(%d) must have 1 argument", c.Mode)
+		}
+
+		return nil
+
+	case ExecutionModeLocalSizeId, ExecutionModeLocalSizeHintId:
+		if len(c.Argv) != 3 {
+			return fmt.Errorf("OpExecutionModeId: ExecutionMode(%d) must have 3 arguments", c.Mode)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("OpExecutionModeId: ExecutionMode(%d) does not take <id> arguments", c.Mode)
+}
+
+func (c *OpTypeInt) Verify() error {
+	switch c.Signedness {
+	case 0, 1:
+	default:
+		return fmt.Errorf("OpTypeInt: Signedness must be 0 or 1")
+	}
+
+	return nil
+}
+
+func (c *OpTypeImage) Verify() error {
+	if c.Depth > 2 {
+		return fmt.Errorf("OpTypeImage: Depth must be 0, 1 or 2")
+	}
+
+	if c.Arrayed > 1 {
+		return fmt.Errorf("OpTypeImage: Arrayed must be 0 or 1")
+	}
+
+	if c.MS > 1 {
+		return fmt.Errorf("OpTypeImage: MS must be 0 or 1")
+	}
+
+	if c.Sampled > 2 {
+		return fmt.Errorf("OpTypeImage: Sampled must be 0, 1 or 2")
+	}
+
+	return nil
+}
+
+func (c *OpConstantSampler) Verify() error {
+	switch c.Param {
+	case 0, 1:
+	default:
+		return fmt.Errorf("OpConstantSampler: Param must be 0 or 1")
+	}
+
+	return nil
+}
+
+func (c *OpLoad) Verify() error {
+	return verifyMemoryAccess("OpLoad", c.MemoryAccess, len(c.Argv), true)
+}
+
+func (c *OpStore) Verify() error {
+	return verifyMemoryAccess("OpStore", c.MemoryAccess, len(c.Argv), true)
+}
+
+func (c *OpCopyMemory) Verify() error {
+	return verifyMemoryAccess("OpCopyMemory", c.MemoryAccess, len(c.Argv), false)
+}
+
+func (c *OpCopyMemorySized) Verify() error {
+	return verifyMemoryAccess("OpCopyMemorySized", c.MemoryAccess, len(c.Argv), false)
+}
+
+func (c *OpPhi) Verify() error {
+	if len(c.Operands) == 0 {
+		return fmt.Errorf("OpPhi: expected operands")
+	}
+
+	if len(c.Operands)%2 != 0 {
+		return fmt.Errorf("OpPhi: expected array of (Variable, Parent) operand pairs")
+	}
+
+	return nil
+}
+
+func (c *OpLoopMerge) Verify() error {
+	if len(c.Argv) != c.LoopControl.argc() {
+		return fmt.Errorf("OpLoopMerge: LoopControl(%d) must have %d argument(s)",
+			c.LoopControl, c.LoopControl.argc())
+	}
+
+	return nil
+}
+
+func (c *OpBranchConditional) Verify() error {
+	if len(c.BranchWeights) != 0 && len(c.BranchWeights) != 2 {
+		return fmt.Errorf("OpBranchConditional: BranchWeights expects 0 or 2 elements")
+	}
+
+	return nil
+}
+
+// verifyDecoration checks the number of literal arguments given to a
+// decoration applied by OpDecorate or OpMemberDecorate.
+func verifyDecoration(name string, d Decoration, argc int) error {
+	want := d.argc()
+
+	switch {
+	case want < 0:
+		return fmt.Errorf("%s: Decoration(%d) must be applied with OpDecorateId or OpDecorateString", name, d)
+
+	case d == DecorationLinkageAttributes:
+		if argc < want {
+			return fmt.Errorf("%s: Decoration(%d) must have a name and a LinkageType", name, d)
+		}
+
+	case argc != want:
+		return fmt.Errorf("%s: Decoration(%d) must have %d argument(s)", name, d, want)
+	}
+
+	return nil
+}
+
+// verifyMemoryAccess checks the number of extra operands which follow a
+// MemoryAccess operand. If exact is false, the extra operands may be
+// followed by the memory operands of a second pointer.
+func verifyMemoryAccess(name string, ma *MemoryAccess, argc int, exact bool) error {
+	if ma == nil {
+		if argc > 0 {
+			return fmt.Errorf("%s: extraneous arguments without MemoryAccess", name)
+		}
+
+		return nil
+	}
+
+	want := ma.argc()
+	if argc < want || (exact && argc != want) {
+		return fmt.Errorf("%s: MemoryAccess(%d) must have %d argument(s)", name, *ma, want)
+	}
+
+	return nil
+}
+
+// verifyImageOperands checks the number of extra operands which follow an
+// ImageOperands mask.
+func verifyImageOperands(name string, io ImageOperands, argc int) error {
+	if want := io.argc(); argc != want {
+		return fmt.Errorf("%s: ImageOperands(%d) must have %d argument(s)", name, io, want)
+	}
+
+	return nil
+}
@@ -6,51 +6,139 @@ package spirv
 import (
 	"reflect"
 	"sort"
+	"sync"
+
+	"github.com/andreas-jonsson/spirv/prerelease"
 )
 
 // InstructionFunc defines a constructor for an instruction.
-type instructionFunc func() Instruction
+type InstructionFunc func() Instruction
+
+// InstructionSet maps opcodes to an instruction constructor.
+// It is safe for concurrent use.
+type instructionSet struct {
+	sync.RWMutex
+	set map[uint32]InstructionFunc
+}
 
-// InstructionSet maps opcodes to an instruction  constructor.
-type instructionSet map[uint32]instructionFunc
+func newInstructionSet() *instructionSet {
+	return &instructionSet{
+		set: make(map[uint32]InstructionFunc),
+	}
+}
 
 // Opcodes returns a sorted list of all registered opcodes.
-func (set instructionSet) Opcodes() []int {
-	out := make([]int, 0, len(set))
+func (s *instructionSet) Opcodes() []int {
+	s.RLock()
+	out := make([]int, 0, len(s.set))
 
-	for opcode := range set {
+	for opcode := range s.set {
 		out = append(out, int(opcode))
 	}
 
+	s.RUnlock()
+
 	sort.Ints(out)
 	return out
 }
 
-// Global, internal instruction set.
-// This has instructions registered atomically during init.
-var instructions = make(instructionSet)
+// Lookup returns the constructor for the given opcode, if it is registered.
+func (s *instructionSet) Lookup(opcode uint32) (InstructionFunc, bool) {
+	s.RLock()
+	fun, ok := s.set[opcode]
+	s.RUnlock()
+	return fun, ok
+}
 
-// Bind registers the given instruction.
-//
-// This call panics if the instruction type defined by the constructor
-// is not a pointer type. Additionally, this panics if there is already an
-// entry for the instruction's opcode.
+// Register adds the instruction yielded by the given constructor.
 //
-// All instructions are meant to be registered during package initialisation
-func bind(fun instructionFunc) {
+// Returns an error if the constructor does not yield a pointer type, or if
+// there already is an entry for the instruction's opcode.
+func (s *instructionSet) Register(fun InstructionFunc) error {
 	obj := fun()
 	rv := reflect.ValueOf(obj)
 
 	if rv.Kind() != reflect.Ptr {
-		panic(ErrInstructionNotPointer)
+		return ErrInstructionNotPointer
 	}
 
 	opcode := obj.Opcode()
 
-	_, ok := instructions[opcode]
+	s.Lock()
+	defer s.Unlock()
+
+	_, ok := s.set[opcode]
 	if ok {
-		panic(ErrDuplicateInstruction)
+		return ErrDuplicateInstruction
+	}
+
+	s.set[opcode] = fun
+	return nil
+}
+
+// Global, internal instruction set.
+// This has instructions registered atomically during init.
+var instructions = newInstructionSet()
+
+// Instruction set of the provisional specification, as defined
+// in package prerelease.
+var preReleaseInstructions = newInstructionSet()
+
+func init() {
+	for _, opcode := range prerelease.Opcodes() {
+		fun, _ := prerelease.Lookup(uint32(opcode))
+		preReleaseInstructions.set[uint32(opcode)] = func() Instruction {
+			return fun()
+		}
+	}
+}
+
+// instructionSetFor returns the instruction set which applies to modules
+// of the given version.
+func instructionSetFor(version uint32) *instructionSet {
+	if version == VersionPreRelease {
+		return preReleaseInstructions
 	}
 
-	instructions[opcode] = fun
+	return instructions
+}
+
+// Register adds a custom instruction to the instruction set of the
+// released specification. This allows the decoder to understand
+// instructions from extensions which are not part of the grammar.
+//
+// The constructor must yield a pointer to a new instruction value.
+// Instructions which implement OperandCodec use it for encoding and
+// decoding. Reflection is used for all others.
+//
+// Returns ErrInstructionNotPointer if the constructor does not yield
+// a pointer type and ErrDuplicateInstruction if there already is an
+// instruction registered for the same opcode. It is safe to call
+// Register concurrently with decoding.
+func Register(fun InstructionFunc) error {
+	return instructions.Register(fun)
+}
+
+// Lookup returns the constructor registered for the given opcode in the
+// instruction set of the released specification.
+func Lookup(opcode uint32) (InstructionFunc, bool) {
+	return instructions.Lookup(opcode)
+}
+
+// Opcodes returns a sorted list of all opcodes registered in the
+// instruction set of the released specification.
+func Opcodes() []int {
+	return instructions.Opcodes()
+}
+
+// Bind registers the given instruction.
+//
+// This call panics if the instruction can not be registered.
+// It is used to register the instructions of this package during
+// package initialisation.
+func bind(fun InstructionFunc) {
+	err := instructions.Register(fun)
+	if err != nil {
+		panic(err)
+	}
 }
@@ -0,0 +1,118 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// OpTestVendor is a custom instruction from an imaginary extension.
+// It has no operand codec, so it is encoded and decoded using reflection.
+type OpTestVendor struct {
+	ResultType Id
+	ResultId   Id
+	Value      uint32
+}
+
+func (c *OpTestVendor) Opcode() uint32 { return 0x7000 }
+func (c *OpTestVendor) Optional() bool { return false }
+func (c *OpTestVendor) Verify() error  { return nil }
+
+// opTestValue is not a pointer type and can therefore not be registered.
+type opTestValue struct{}
+
+func (c opTestValue) Opcode() uint32 { return 0x7001 }
+func (c opTestValue) Optional() bool { return false }
+func (c opTestValue) Verify() error  { return nil }
+
+func TestRegister(t *testing.T) {
+	set := newInstructionSet()
+
+	err := set.Register(func() Instruction { return &OpTestVendor{} })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = set.Register(func() Instruction { return &OpTestVendor{} })
+	if err != ErrDuplicateInstruction {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", err, ErrDuplicateInstruction)
+	}
+
+	err = set.Register(func() Instruction { return opTestValue{} })
+	if err != ErrInstructionNotPointer {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", err, ErrInstructionNotPointer)
+	}
+
+	if have, want := set.Opcodes(), []int{0x7000}; !reflect.DeepEqual(have, want) {
+		t.Fatalf("opcode mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+
+	have, err := decodeInstruction(set, []uint32{0x00047000, 1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &OpTestVendor{ResultType: 1, ResultId: 2, Value: 3}
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("decode mismatch:\nHave: %+v\nWant: %+v", have, want)
+	}
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	// Instructions of this package can not be replaced.
+	err := Register(func() Instruction { return &OpIAdd{} })
+	if err != ErrDuplicateInstruction {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", err, ErrDuplicateInstruction)
+	}
+
+	fun, ok := Lookup(opcodeIAdd)
+	if !ok {
+		t.Fatalf("OpIAdd is not registered")
+	}
+
+	if _, ok := fun().(*OpIAdd); !ok {
+		t.Fatalf("expected *OpIAdd; have %T", fun())
+	}
+}
+
+func TestRegisterConcurrent(t *testing.T) {
+	set := newInstructionSet()
+
+	err := set.Register(func() Instruction { return &OpTestVendor{} })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+
+	// Register a new instruction while other goroutines are decoding.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		set.Register(func() Instruction { return &OpIAdd{} })
+	}()
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				_, err := decodeInstruction(set, []uint32{0x00047000, 1, 2, 3})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if have, want := set.Opcodes(), []int{int(opcodeIAdd), 0x7000}; !reflect.DeepEqual(have, want) {
+		t.Fatalf("opcode mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+}
@@ -0,0 +1,315 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"unicode/utf8"
+)
+
+// jsonModule is the JSON form of a module.
+type jsonModule struct {
+	Header Header
+	Code   []jsonInstruction
+}
+
+// jsonInstruction is the JSON form of a single instruction.
+//
+// Known instructions hold their opcode name in Op and their operands in
+// Operands, keyed by struct field name. Raw instructions hold their opcode
+// number in Opcode and their operand words in Raw instead.
+type jsonInstruction struct {
+	Op       string          `json:",omitempty"`
+	Opcode   *uint32         `json:",omitempty"`
+	Operands json.RawMessage `json:",omitempty"`
+	Raw      *[]uint32       `json:",omitempty"`
+}
+
+// MarshalJSON encodes the module as JSON. The encoding is lossless:
+// decoding it with UnmarshalJSON yields a module which saves to the
+// same binary.
+//
+// The header is written with its field names. Each instruction is an
+// object holding the opcode name in "Op", and its operands in "Operands",
+// keyed by the field names of the instruction struct, in field order:
+//
+//	{"Op": "OpName", "Operands": {"Target": 4, "Name": "main"}}
+//
+// Ids and literal numbers are written as numbers and strings as strings.
+// Enum values are written by name where they have one, and as numbers
+// otherwise. Absent optional operands are left out. Strings which are not
+// valid UTF-8 are written as an array of byte values.
+//
+// Raw instructions are written as their opcode number and operand words:
+//
+//	{"Opcode": 4242, "Raw": [1, 2, 3]}
+func (m *Module) MarshalJSON() ([]byte, error) {
+	out := jsonModule{
+		Header: m.Header,
+		Code:   make([]jsonInstruction, len(m.Code)),
+	}
+
+	for i, instr := range m.Code {
+		if raw, ok := instr.(*RawInstruction); ok {
+			code, argv := raw.Code, append([]uint32{}, raw.Argv...)
+			out.Code[i] = jsonInstruction{Opcode: &code, Raw: &argv}
+			continue
+		}
+
+		operands, err := marshalOperands(instr)
+		if err != nil {
+			return nil, fmt.Errorf("Code[%d]: %v", i, err)
+		}
+
+		out.Code[i] = jsonInstruction{
+			Op:       reflect.Indirect(reflect.ValueOf(instr)).Type().Name(),
+			Operands: operands,
+		}
+	}
+
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a module written by MarshalJSON. Instructions are
+// looked up by name, in the instruction set which applies to the version
+// in the header.
+//
+// Enum operands accept names as well as numbers. Operands which are left
+// out are set to their zero value.
+func (m *Module) UnmarshalJSON(data []byte) error {
+	var in jsonModule
+
+	err := json.Unmarshal(data, &in)
+	if err != nil {
+		return err
+	}
+
+	set := instructionSetFor(in.Header.Version)
+	names := make(map[string]InstructionFunc)
+
+	for _, opcode := range set.Opcodes() {
+		fun, _ := set.Lookup(uint32(opcode))
+		names[reflect.Indirect(reflect.ValueOf(fun())).Type().Name()] = fun
+	}
+
+	code := make(InstructionList, len(in.Code))
+
+	for i, ji := range in.Code {
+		if ji.Raw != nil {
+			if ji.Opcode == nil {
+				return fmt.Errorf("Code[%d]: raw instruction without Opcode", i)
+			}
+
+			code[i] = &RawInstruction{Code: *ji.Opcode, Argv: *ji.Raw}
+			continue
+		}
+
+		fun, ok := names[ji.Op]
+		if !ok {
+			return fmt.Errorf("Code[%d]: %v %q", i, ErrUnknownInstruction, ji.Op)
+		}
+
+		instr := fun()
+
+		err = unmarshalOperands(instr, ji.Operands)
+		if err != nil {
+			return fmt.Errorf("Code[%d]: %s: %v", i, ji.Op, err)
+		}
+
+		code[i] = instr
+	}
+
+	m.Header = in.Header
+	m.Code = code
+	return nil
+}
+
+// marshalOperands writes the operands of the given instruction as a JSON
+// object, keyed by field name.
+func marshalOperands(instr Instruction) (json.RawMessage, error) {
+	rv := reflect.Indirect(reflect.ValueOf(instr))
+	rt := rv.Type()
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i := 0; i < rv.NumField(); i++ {
+		fv := rv.Field(i)
+
+		// Absent optional operands and empty lists are left out.
+		if (fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Slice) && fv.IsNil() {
+			continue
+		}
+
+		data, err := json.Marshal(jsonValue(fv))
+		if err != nil {
+			return nil, err
+		}
+
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+
+		key, _ := json.Marshal(rt.Field(i).Name)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(data)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// jsonValue returns the value to encode for the given field.
+func jsonValue(fv reflect.Value) interface{} {
+	switch fv.Kind() {
+	case reflect.Ptr:
+		return jsonValue(fv.Elem())
+
+	case reflect.Slice:
+		out := make([]interface{}, fv.Len())
+		for i := range out {
+			out[i] = jsonValue(fv.Index(i))
+		}
+		return out
+
+	case reflect.String:
+		s := fv.String()
+		if utf8.ValidString(s) {
+			return s
+		}
+
+		out := make([]int, len(s))
+		for i := range out {
+			out[i] = int(s[i])
+		}
+		return out
+	}
+
+	v := fv.Uint()
+
+	// Only use names which map back to the same value.
+	if e, ok := fv.Interface().(Enum); ok {
+		name := e.String()
+		if w, ok := EnumValue(fv.Type().Name(), name); ok && uint64(w) == v {
+			return name
+		}
+	}
+
+	return v
+}
+
+// unmarshalOperands sets the fields of the given instruction from a JSON
+// object, keyed by field name.
+func unmarshalOperands(instr Instruction, data json.RawMessage) error {
+	var operands map[string]json.RawMessage
+
+	if len(data) > 0 {
+		err := json.Unmarshal(data, &operands)
+		if err != nil {
+			return err
+		}
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(instr))
+
+	for name, value := range operands {
+		fv := rv.FieldByName(name)
+		if !fv.IsValid() {
+			return fmt.Errorf("unknown operand %q", name)
+		}
+
+		err := setJSONValue(fv, value)
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// setJSONValue sets the given field from its JSON value.
+func setJSONValue(fv reflect.Value, data json.RawMessage) error {
+	switch fv.Kind() {
+	case reflect.Ptr:
+		v := reflect.New(fv.Type().Elem())
+		if err := setJSONValue(v.Elem(), data); err != nil {
+			return err
+		}
+		fv.Set(v)
+		return nil
+
+	case reflect.Slice:
+		var list []json.RawMessage
+		if err := json.Unmarshal(data, &list); err != nil {
+			return err
+		}
+
+		if list == nil {
+			return nil
+		}
+
+		out := reflect.MakeSlice(fv.Type(), len(list), len(list))
+		for i := range list {
+			if err := setJSONValue(out.Index(i), list[i]); err != nil {
+				return err
+			}
+		}
+		fv.Set(out)
+		return nil
+
+	case reflect.String:
+		var s string
+		if err := json.Unmarshal(data, &s); err == nil {
+			fv.SetString(s)
+			return nil
+		}
+
+		// Invalid UTF-8 is written as an array of byte values.
+		var list []uint
+		if err := json.Unmarshal(data, &list); err != nil {
+			return err
+		}
+
+		b := make([]byte, len(list))
+		for i, v := range list {
+			if v > 0xff {
+				return fmt.Errorf("byte value %d out of range", v)
+			}
+			b[i] = byte(v)
+		}
+		fv.SetString(string(b))
+		return nil
+	}
+
+	var name string
+	if json.Unmarshal(data, &name) == nil {
+		if _, ok := fv.Interface().(Enum); !ok {
+			return fmt.Errorf("expected number; have %q", name)
+		}
+
+		v, ok := EnumValue(fv.Type().Name(), name)
+		if !ok {
+			return fmt.Errorf("unknown %s %q", fv.Type().Name(), name)
+		}
+
+		fv.SetUint(uint64(v))
+		return nil
+	}
+
+	var v uint64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if fv.OverflowUint(v) {
+		return fmt.Errorf("value %d out of range", v)
+	}
+
+	fv.SetUint(v)
+	return nil
+}
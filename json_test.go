@@ -0,0 +1,145 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestModuleJSONRoundtrip(t *testing.T) {
+	for _, file := range []string{
+		"cmd/dump/test.spirv",
+		"testdata/test.spirv",
+	} {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mod, err := LoadWithOptions(bytes.NewReader(data), DecoderOptions{KeepRaw: true})
+		if err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+
+		testJSONRoundtrip(t, file, mod)
+	}
+}
+
+func TestModuleJSONRoundtripRaw(t *testing.T) {
+	mod := NewModule()
+	access := MemoryAccess(MemoryAccessVolatile | MemoryAccessAligned)
+	invalid := MemoryAccess(1 << 30)
+
+	mod.Code = InstructionList{
+		&OpCapability{Capability: CapabilityShader},
+		&OpName{Target: 1, Name: "main"},
+		&OpName{Target: 2, Name: "\xff\xfe"},
+		&OpStore{Pointer: 3, Object: 4, MemoryAccess: &access, Argv: []uint32{16}},
+		&OpLoad{ResultType: 5, ResultId: 6, Pointer: 3, MemoryAccess: &invalid},
+		&RawInstruction{Code: 0x1234, Argv: []uint32{1, 2}},
+		&RawInstruction{Code: 0x1235},
+	}
+
+	testJSONRoundtrip(t, "raw", mod)
+}
+
+func testJSONRoundtrip(t *testing.T, name string, want *Module) {
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("%s: marshal: %v", name, err)
+	}
+
+	var have Module
+	err = json.Unmarshal(data, &have)
+	if err != nil {
+		t.Fatalf("%s: unmarshal: %v", name, err)
+	}
+
+	var wantBin, haveBin bytes.Buffer
+	if err := want.Save(&wantBin); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := have.Save(&haveBin); err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(haveBin.Bytes(), wantBin.Bytes()) {
+		t.Fatalf("%s: binary mismatch:\nHave: %v\nWant: %v", name, have.Code, want.Code)
+	}
+}
+
+func TestModuleMarshalJSON(t *testing.T) {
+	mod := NewModule()
+	access := MemoryAccess(MemoryAccessVolatile | MemoryAccessAligned)
+
+	mod.Code = InstructionList{
+		&OpName{Target: 1, Name: "main"},
+		&OpStore{Pointer: 3, Object: 4, MemoryAccess: &access, Argv: []uint32{16}},
+		&OpLoad{ResultType: 5, ResultId: 6, Pointer: 3},
+		&OpDecorate{Target: 1, Decoration: Decoration(0xffff)},
+		&RawInstruction{Code: 0x1234, Argv: []uint32{1, 2}},
+	}
+
+	have, err := json.Marshal(mod)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"Header":{"Magic":119734787,"Version":67072,"GeneratorMagic":0,"Bound":0,"Reserved":0},"Code":[` +
+		`{"Op":"OpName","Operands":{"Target":1,"Name":"main"}},` +
+		`{"Op":"OpStore","Operands":{"Pointer":3,"Object":4,"MemoryAccess":"Volatile|Aligned","Argv":[16]}},` +
+		`{"Op":"OpLoad","Operands":{"ResultType":5,"ResultId":6,"Pointer":3}},` +
+		`{"Op":"OpDecorate","Operands":{"Target":1,"Decoration":65535}},` +
+		`{"Opcode":4660,"Raw":[1,2]}]}`
+
+	if string(have) != want {
+		t.Fatalf("json mismatch:\nHave: %s\nWant: %s", have, want)
+	}
+}
+
+func TestModuleUnmarshalJSON(t *testing.T) {
+	var mod Module
+
+	err := json.Unmarshal([]byte(`{"Header":{"Version":66048},"Code":[
+		{"Op":"OpCapability","Operands":{"Capability":"Shader"}},
+		{"Op":"OpLoad","Operands":{"ResultType":5,"ResultId":6,"Pointer":3,"MemoryAccess":2}},
+		{"Op":"OpReturn"}
+	]}`), &mod)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	access := MemoryAccess(MemoryAccessAligned)
+	want := InstructionList{
+		&OpCapability{Capability: CapabilityShader},
+		&OpLoad{ResultType: 5, ResultId: 6, Pointer: 3, MemoryAccess: &access},
+		&OpReturn{},
+	}
+
+	if !reflect.DeepEqual(mod.Code, want) {
+		t.Fatalf("code mismatch:\nHave: %v\nWant: %v", mod.Code, want)
+	}
+
+	for _, st := range []struct {
+		json string
+		want string
+	}{
+		{`{"Code":[{"Op":"OpFoo"}]}`, `Code[0]: unknown instruction "OpFoo"`},
+		{`{"Code":[{"Op":"OpName","Operands":{"Foo":1}}]}`, `Code[0]: OpName: unknown operand "Foo"`},
+		{`{"Code":[{"Op":"OpName","Operands":{"Target":"a"}}]}`, `Code[0]: OpName: Target: expected number; have "a"`},
+		{`{"Code":[{"Op":"OpCapability","Operands":{"Capability":"Foo"}}]}`, `Code[0]: OpCapability: Capability: unknown Capability "Foo"`},
+		{`{"Code":[{"Op":"OpCapability","Operands":{"Capability":4294967296}}]}`, `Code[0]: OpCapability: Capability: value 4294967296 out of range`},
+		{`{"Code":[{"Raw":[1]}]}`, `Code[0]: raw instruction without Opcode`},
+	} {
+		err := json.Unmarshal([]byte(st.json), &mod)
+		if err == nil || err.Error() != st.want {
+			t.Fatalf("%s: error mismatch:\nHave: %v\nWant: %s", st.json, err, st.want)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "fmt"
+
+// Section defines one of the sections making up the logical layout of
+// a module, as defined in chapter 2.4 of the specification.
+type Section uint8
+
+// Known sections, in the order they appear in a module.
+const (
+	SectionUnknown Section = iota
+
+	// SectionPreamble holds capabilities, extensions, extended
+	// instruction set imports, the memory model, entry points and
+	// execution modes.
+	SectionPreamble
+
+	// SectionDebug holds source, name and module processing
+	// information.
+	SectionDebug
+
+	// SectionAnnotations holds decorations.
+	SectionAnnotations
+
+	// SectionGlobals holds type declarations, constants and global
+	// variables.
+	SectionGlobals
+
+	// SectionFunctions holds function declarations and definitions.
+	SectionFunctions
+)
+
+var sectionNames = [...]string{
+	SectionUnknown:     "unknown",
+	SectionPreamble:    "preamble",
+	SectionDebug:       "debug",
+	SectionAnnotations: "annotations",
+	SectionGlobals:     "types, constants and global variables",
+	SectionFunctions:   "functions",
+}
+
+func (s Section) String() string {
+	if int(s) < len(sectionNames) {
+		return sectionNames[s]
+	}
+	return fmt.Sprintf("Section(%d)", s)
+}
+
+// layoutState defines a position in the logical layout of a module.
+// The states up to and including layoutGlobal are ordered: instructions
+// outside of functions may only move the state forward.
+type layoutState uint8
+
+const (
+	layoutCapability layoutState = iota
+	layoutExtension
+	layoutExtInstImport
+	layoutMemoryModel
+	layoutEntryPoint
+	layoutExecutionMode
+	layoutSource
+	layoutName
+	layoutModuleProcessed
+	layoutAnnotation
+	layoutGlobal
+	layoutFunction    // After OpFunction, before the first block.
+	layoutBlock       // Inside a block.
+	layoutBlockEnd    // After the terminator of a block.
+	layoutFunctionEnd // After OpFunctionEnd.
+)
+
+// section returns the section the state belongs to.
+func (s layoutState) section() Section {
+	switch {
+	case s <= layoutExecutionMode:
+		return SectionPreamble
+	case s <= layoutModuleProcessed:
+		return SectionDebug
+	case s == layoutAnnotation:
+		return SectionAnnotations
+	case s == layoutGlobal:
+		return SectionGlobals
+	}
+
+	return SectionFunctions
+}
+
+// moduleLayoutState returns the state an instruction with the given
+// opcode moves to, when it appears outside of a function. Returns false
+// if the instruction can not appear outside of a function.
+func moduleLayoutState(opcode uint32) (layoutState, bool) {
+	switch opcode {
+	case opcodeCapability:
+		return layoutCapability, true
+	case opcodeExtension:
+		return layoutExtension, true
+	case opcodeExtInstImport:
+		return layoutExtInstImport, true
+	case opcodeMemoryModel:
+		return layoutMemoryModel, true
+	case opcodeEntryPoint:
+		return layoutEntryPoint, true
+	case opcodeExecutionMode, opcodeExecutionModeId:
+		return layoutExecutionMode, true
+
+	case opcodeString, opcodeSourceExtension, opcodeSource, opcodeSourceContinued:
+		return layoutSource, true
+	case opcodeName, opcodeMemberName:
+		return layoutName, true
+	case opcodeModuleProcessed:
+		return layoutModuleProcessed, true
+
+	case opcodeDecorate, opcodeMemberDecorate, opcodeDecorateId,
+		opcodeDecorateString, opcodeMemberDecorateString,
+		opcodeGroupDecorate, opcodeGroupMemberDecorate,
+		opcodeDecorationGroup:
+		return layoutAnnotation, true
+
+	case opcodeTypeVoid, opcodeTypeBool, opcodeTypeInt, opcodeTypeFloat,
+		opcodeTypeVector, opcodeTypeMatrix, opcodeTypeImage,
+		opcodeTypeSampler, opcodeTypeSampledImage, opcodeTypeArray,
+		opcodeTypeRuntimeArray, opcodeTypeStruct, opcodeTypeOpaque,
+		opcodeTypePointer, opcodeTypeFunction, opcodeTypeEvent,
+		opcodeTypeDeviceEvent, opcodeTypeReserveId, opcodeTypeQueue,
+		opcodeTypePipe, opcodeTypeForwardPointer, opcodeTypePipeStorage,
+		opcodeTypeNamedBarrier,
+		opcodeConstantTrue, opcodeConstantFalse, opcodeConstant,
+		opcodeConstantComposite, opcodeConstantSampler, opcodeConstantNull,
+		opcodeConstantPipeStorage, opcodeSpecConstantTrue,
+		opcodeSpecConstantFalse, opcodeSpecConstant,
+		opcodeSpecConstantComposite, opcodeSpecConstantOp,
+		opcodeUndef, opcodeLine, opcodeNoLine, opcodeVariable:
+		return layoutGlobal, true
+	}
+
+	return 0, false
+}
+
+// verifyLayoutSections walks the code through the sections of the logical
+// layout and tests that each instruction appears where it is allowed.
+//
+// Instructions inside blocks are not checked here, beyond the structure
+// of the blocks themselves.
+func verifyLayoutSections(set InstructionList) error {
+	var capabilities, memoryModels int
+	state := layoutCapability
+	start := -1 // Address of the current OpFunction.
+
+	for addr, instr := range set {
+		opcode := instr.Opcode()
+		name := instructionName(instr)
+
+		switch state {
+		case layoutFunction:
+			switch opcode {
+			case opcodeFunctionParameter, opcodeLine, opcodeNoLine:
+			case opcodeLabel:
+				state = layoutBlock
+			case opcodeFunctionEnd:
+				state = layoutFunctionEnd
+			default:
+				return newSectionError(addr, instr, SectionFunctions,
+					"%s must be inside a block", name)
+			}
+			continue
+
+		case layoutBlock:
+			switch {
+			case isTerminator(opcode):
+				state = layoutBlockEnd
+			case opcode == opcodeLabel, opcode == opcodeFunction,
+				opcode == opcodeFunctionEnd, opcode == opcodeFunctionParameter:
+				return newSectionError(addr, instr, SectionFunctions,
+					"%s can not appear before the block is terminated", name)
+			}
+			continue
+
+		case layoutBlockEnd:
+			switch opcode {
+			case opcodeLabel:
+				state = layoutBlock
+			case opcodeFunctionEnd:
+				state = layoutFunctionEnd
+			default:
+				return newSectionError(addr, instr, SectionFunctions,
+					"%s must be inside a block", name)
+			}
+			continue
+
+		case layoutFunctionEnd:
+			if opcode != opcodeFunction {
+				return newSectionError(addr, instr, SectionFunctions,
+					"%s can not appear after the first function", name)
+			}
+
+			state = layoutFunction
+			start = addr
+			continue
+		}
+
+		// We are outside of functions.
+		if opcode == opcodeFunction {
+			if memoryModels == 0 {
+				return newSectionError(addr, instr, SectionPreamble,
+					"%s can not appear before OpMemoryModel", name)
+			}
+
+			state = layoutFunction
+			start = addr
+			continue
+		}
+
+		next, ok := moduleLayoutState(opcode)
+		if !ok {
+			return newSectionError(addr, instr, state.section(),
+				"%s can not appear outside of a function", name)
+		}
+
+		if next < state {
+			return newSectionError(addr, instr, state.section(),
+				"%s can not appear in the %s section", name, state.section())
+		}
+
+		if next > layoutCapability && capabilities == 0 {
+			return newSectionError(addr, instr, SectionPreamble,
+				"%s can not appear before OpCapability", name)
+		}
+
+		if next > layoutMemoryModel && memoryModels == 0 {
+			return newSectionError(addr, instr, SectionPreamble,
+				"%s can not appear before OpMemoryModel", name)
+		}
+
+		switch opcode {
+		case opcodeCapability:
+			capabilities++
+		case opcodeMemoryModel:
+			memoryModels++
+			if memoryModels > 1 {
+				return newSectionError(addr, instr, SectionPreamble,
+					"%s can only appear once", name)
+			}
+		}
+
+		state = next
+	}
+
+	if state >= layoutFunction && state != layoutFunctionEnd {
+		return newSectionError(start, set[start], SectionFunctions,
+			"%s is not terminated by OpFunctionEnd", instructionName(set[start]))
+	}
+
+	if capabilities == 0 || memoryModels == 0 {
+		return NewLayoutError(0, "a module must define at least one OpCapability and one OpMemoryModel")
+	}
+
+	return nil
+}
@@ -3,7 +3,12 @@
 
 package spirv
 
-import "io"
+import (
+	"io"
+	"reflect"
+
+	"github.com/andreas-jonsson/spirv/prerelease"
+)
 
 // Module defines a complete SPIR-V module.
 type Module struct {
@@ -25,30 +30,87 @@ func NewModule() *Module {
 }
 
 // Load loads a full module from the given input stream.
+// Errors are returned as a *DecodeError.
 func Load(r io.Reader) (*Module, error) {
+	return LoadWithOptions(r, DecoderOptions{})
+}
+
+// LoadWithOptions loads a full module from the given input stream,
+// using the given decoder options.
+func LoadWithOptions(r io.Reader, opts DecoderOptions) (*Module, error) {
+	dec := NewDecoder(r)
+	dec.Options = opts
+	return dec.DecodeModule()
+}
+
+// LoadBytes loads a full module from the given binary data.
+// The byte order is determined by the module's magic value.
+//
+// The decoded instructions do not refer to data, so it may be
+// reused once LoadBytes returns.
+func LoadBytes(data []byte) (*Module, error) {
+	return LoadBytesWithOptions(data, DecoderOptions{})
+}
+
+// LoadBytesWithOptions loads a full module from the given binary data,
+// using the given decoder options.
+func LoadBytesWithOptions(data []byte, opts DecoderOptions) (*Module, error) {
+	dec := NewDecoderBytes(data)
+	dec.Options = opts
+	return dec.DecodeModule()
+}
+
+// LoadWords loads a full module from the given words.
+//
+// The decoded instructions do not refer to words, so it may be
+// reused once LoadWords returns.
+func LoadWords(words []uint32) (*Module, error) {
+	return LoadWordsWithOptions(words, DecoderOptions{})
+}
+
+// LoadWordsWithOptions loads a full module from the given words,
+// using the given decoder options.
+func LoadWordsWithOptions(words []uint32, opts DecoderOptions) (*Module, error) {
+	dec := NewDecoderWords(words)
+	dec.Options = opts
+	return dec.DecodeModule()
+}
+
+// DecodeModule decodes a full module, consisting of a header and all
+// instructions up to the end of the input.
+//
+// Errors are returned as a *DecodeError, which describes where in the
+// input decoding failed.
+func (d *Decoder) DecodeModule() (*Module, error) {
 	var mod Module
 	var err error
 	var instr Instruction
 
-	dec := NewDecoder(r)
-
 	// Load the module header.
-	mod.Header, err = dec.DecodeHeader()
+	mod.Header, err = d.DecodeHeader()
 	if err != nil {
-		return nil, err
+		return nil, &DecodeError{Index: -1, Err: err}
 	}
 
-	// Load all instructions.
-	mod.Code = make([]Instruction, 0, 128)
+	// Load all instructions. For in-memory input, we can make a decent
+	// guess at the final size.
+	size := 128
+	if d.r == nil {
+		size = len(d.words) / 4
+	}
+
+	mod.Code = make([]Instruction, 0, size)
+
+	for index := 0; ; index++ {
+		offset := d.pos
 
-	for {
-		instr, err = dec.DecodeInstruction()
+		instr, err = d.DecodeInstruction()
 		if err != nil {
 			if err == io.EOF {
 				break // Not an error -- just end of stream.
 			}
 
-			return nil, err
+			return nil, d.decodeError(index, offset, err)
 		}
 
 		mod.Code = append(mod.Code, instr)
@@ -57,12 +119,31 @@ func Load(r io.Reader) (*Module, error) {
 	return &mod, nil
 }
 
+// SaveOptions defines options for writing a module.
+type SaveOptions struct {
+	// UpdateBound makes the written header hold the bound computed by
+	// ComputeBound, rather than Header.Bound. The module itself is not
+	// modified.
+	UpdateBound bool
+}
+
 // Save writes the module to the given stream.
 func (m *Module) Save(w io.Writer) error {
+	return m.SaveWithOptions(w, SaveOptions{})
+}
+
+// SaveWithOptions writes the module to the given stream,
+// using the given options.
+func (m *Module) SaveWithOptions(w io.Writer, opts SaveOptions) error {
+	hdr := m.Header
+	if opts.UpdateBound {
+		hdr.Bound = m.ComputeBound()
+	}
+
 	enc := NewEncoder(w)
 
 	// Write the header.
-	err := enc.EncodeHeader(m.Header)
+	err := enc.EncodeHeader(hdr)
 	if err != nil {
 		return err
 	}
@@ -75,63 +156,192 @@ func (m *Module) Save(w io.Writer) error {
 		}
 	}
 
-	return nil
+	return enc.Flush()
+}
+
+// EncodedLen returns the number of bytes the module occupies once encoded.
+func (m *Module) EncodedLen() int {
+	words := 5 // Header.
+
+	for _, instr := range m.Code {
+		words += EncodedLen(instr)
+	}
+
+	return words * 4
+}
+
+// Bytes returns the binary encoding of the module.
+func (m *Module) Bytes() ([]byte, error) {
+	return m.AppendBinary(nil)
+}
+
+// AppendBinary appends the binary encoding of the module to b and
+// returns the extended slice. The slice is grown at most once, to fit
+// the entire module.
+func (m *Module) AppendBinary(b []byte) ([]byte, error) {
+	size := m.EncodedLen()
+	if cap(b)-len(b) < size {
+		nb := make([]byte, len(b), len(b)+size)
+		copy(nb, b)
+		b = nb
+	}
+
+	b = appendHeader(b, m.Header)
+
+	endian := BigEndian
+	if m.Header.Magic == MagicLE {
+		endian = LittleEndian
+	}
+
+	buf := make([]uint32, 16)
+
+	for _, instr := range m.Code {
+		size := EncodedLen(instr)
+		if size > len(buf) {
+			buf = make([]uint32, size)
+		}
+
+		argc, err := encodeOperands(instr, buf[1:size])
+		if err != nil {
+			return nil, err
+		}
+
+		argc++
+		buf[0] = EncodeOpcode(uint32(argc), instr.Opcode())
+		b = appendWords(b, endian, buf[:argc])
+	}
+
+	return b, nil
 }
 
 // Verify returns an error if the module contains invalid data.
 //
 // This applies a host of different levels of structural and semantic
-// validation as defined in the spec chapter 2.15.
+// validation as defined in the spec chapter 2.15. Pre-release modules
+// are handed off to package prerelease.
+//
+// Verify stops at the first error it finds. Use VerifyAll to find all
+// problems at once.
 func (m *Module) Verify() error {
-	// Check the header for structural validity.
-	err := m.Header.Verify()
-	if err != nil {
-		return err
+	var v verifier
+	m.verify(&v)
+
+	if v.failures > 0 {
+		return v.diags[0].Err
 	}
 
+	return nil
+}
+
+// VerifyAll applies the same checks as Verify, but does not stop at the
+// first error. It returns all problems it finds, including warnings,
+// ordered by the check which found them. The module is valid if none of
+// the diagnostics has SeverityError.
+//
+// Some checks depend on others: if the module's logical layout is
+// invalid, its control flow and operand types are not checked.
+func (m *Module) VerifyAll() []Diagnostic {
+	v := verifier{all: true}
+	m.verify(&v)
+	return v.diags
+}
+
+// verifyPasses lists the checks applied by Verify, in order.
+var verifyPasses = []struct {
+	rule   string
+	pass   func(*Module, *verifier)
+	layout bool // Requires a valid logical layout.
+}{
 	// Perform structural validity checks on each instruction, before
 	// we proceed to the semantic checks.
-	//
-	// This uses reflection to call Verify() on all relevant struct fields
-	// and then on the instruction itself. The latter is used by some
-	// instructions to validate parts which can not be caught by the field
-	// types themselves.
-	for _, instr := range m.Code {
-		err := verifyInstruction(instr)
-		if err != nil {
-			return err
-		}
-	}
+	{ruleInstructions, (*Module).verifyInstructions, false},
 
 	// Ensure logical layout is up to standards.
-	err = m.verifyLogicalLayout()
-	if err != nil {
-		return err
-	}
+	{ruleLayout, errorPass((*Module).verifyLogicalLayout), false},
 
 	// TODO: Non-structure types (scalars, vectors, arrays, etc.) with the same
 	// operand parameterization cannot be type aliases. For nonstructures, two
 	// type <id>s match iff the types match.
 
 	// Perform some checks related to Logical addressing mode.
-	err = m.verifyLogicalAddressing()
-	if err != nil {
-		return err
-	}
+	{ruleUniversal, errorPass((*Module).verifyLogicalAddressing), false},
+
+	// Check all Ids are within the module's bound.
+	{ruleHeader, (*Module).verifyIds, false},
 
 	// Check the validity of result IDs.
-	err = m.verifySSA()
-	if err != nil {
-		return err
-	}
+	{ruleUniversal, (*Module).verifySSA, false},
+
+	// Check the operand types of arithmetic, relational and conversion
+	// instructions.
+	{ruleInstructions, (*Module).verifyOperandTypes, true},
+
+	// Check that definitions dominate their uses.
+	{ruleUniversal, (*Module).verifyDominance, true},
+
+	// Check the rules for structured control flow.
+	{ruleStructured, (*Module).verifyStructuredFlow, true},
 
 	// Check validity of entry point usage.
-	err = m.verifyEntrypoints()
-	if err != nil {
-		return err
+	{ruleUniversal, (*Module).verifyEntrypoints, false},
+
+	// Check extended instructions against their instruction sets.
+	{ruleUniversal, (*Module).verifyExtInsts, false},
+}
+
+// verify runs all verification passes, until the verifier asks to stop.
+func (m *Module) verify(v *verifier) {
+	// Check the header for structural validity.
+	v.rule = ruleHeader
+	if err := m.Header.Verify(); err != nil {
+		v.report(err)
+		return
 	}
 
-	return nil
+	if m.Header.Version == VersionPreRelease {
+		v.rule = ruleUniversal
+		if err := m.verifyPreRelease(); err != nil {
+			v.report(err)
+		}
+		return
+	}
+
+	validLayout := true
+
+	for _, p := range verifyPasses {
+		if p.layout && !validLayout {
+			continue
+		}
+
+		failures := v.failures
+		v.rule = p.rule
+		p.pass(m, v)
+
+		if v.failures == failures {
+			continue
+		}
+
+		if !v.all {
+			return
+		}
+
+		if p.rule == ruleLayout {
+			validLayout = false
+		}
+	}
+}
+
+// verifyInstructions calls Verify() on all relevant struct fields of each
+// instruction and then on the instruction itself. The latter is used by
+// some instructions to validate parts which can not be caught by the
+// field types themselves.
+func (m *Module) verifyInstructions(v *verifier) {
+	for addr, instr := range m.Code {
+		err := verifyInstruction(instr)
+		if err != nil && !v.reportAt(addr, err) {
+			return
+		}
+	}
 }
 
 // Strip removes all instructions which have no semantic impact on the code.
@@ -149,12 +359,32 @@ func (m *Module) Strip() {
 	}
 }
 
+// verifyPreRelease verifies the code of a pre-release module.
+func (m *Module) verifyPreRelease() error {
+	code := make(prerelease.InstructionList, len(m.Code))
+
+	for i, instr := range m.Code {
+		// Released instructions satisfy the prerelease.Instruction
+		// interface as well, so compare against the registered type.
+		fun, ok := prerelease.Lookup(instr.Opcode())
+		if !ok || reflect.TypeOf(fun()) != reflect.TypeOf(instr) {
+			return NewLayoutError(i, "%s is not a pre-release instruction",
+				instructionName(instr))
+		}
+
+		code[i] = instr
+	}
+
+	return prerelease.Verify(code)
+}
+
 // verifyEntrypoints performs some sanity checks on entrypoint definitions.
-func (m *Module) verifyEntrypoints() error {
+func (m *Module) verifyEntrypoints(v *verifier) {
 	// There must be at least 1 OpEntryPoint, unless a Link capability
 	// is being used.
 	if !m.hasLinkageType() && m.Code.Count(opcodeEntryPoint) == 0 {
-		return NewLayoutError(0, "unless the Linkage capabilities are used, we require at least 1 OpEntryPoint")
+		v.report(NewLayoutError(0, "unless the Linkage capabilities are used, we require at least 1 OpEntryPoint"))
+		return
 	}
 
 	// No function can be targeted by both an OpEntryPoint instruction and an
@@ -163,37 +393,33 @@ func (m *Module) verifyEntrypoints() error {
 	calls := m.Code.FilterIndex(opcodeFunctionCall, 0)
 
 	if len(entries) == 0 || len(calls) == 0 {
-		return nil
+		return
 	}
 
 	for _, c := range calls {
-		fc := m.Code[c].(*OpFunctionCall)
-		addr := m.hasResultId(fc.Function, entries)
-		if addr > -1 {
-			return NewLayoutError(
-				c, "call to function previously defined as entrypoint at $%08x", addr,
-			)
+		fc, ok := m.Code[c].(*OpFunctionCall)
+		if !ok {
+			continue // Raw instruction.
 		}
-	}
 
-	return nil
+		addr := m.hasEntryPoint(fc.Function, entries)
+		if addr > -1 && !v.report(NewLayoutError(
+			c, "call to function previously defined as entrypoint at $%08x", addr,
+		)) {
+			return
+		}
+	}
 }
 
 // verifySSA performs some sanity checks on result id values, as defined
 // in chapter 2.15.1 of the specification.
-func (m *Module) verifySSA() error {
+func (m *Module) verifySSA(v *verifier) {
 	// Each <id> must appear exactly once as the result <id> of an instruction.
 	//
 	// Create a list of all result ids and ensure there are no duplicates.
 	set := make(map[Id]int)
 
 	for addr, instr := range m.Code {
-		if _, ok := instr.(*OpEntryPoint); ok {
-			// ResultId is entry point target. Not id for this
-			// instruction itself -- ignore it.
-			continue
-		}
-
 		id, ok := instructionResultId(instr)
 		if !ok {
 			continue
@@ -205,24 +431,22 @@ func (m *Module) verifySSA() error {
 			continue
 		}
 
-		return NewLayoutError(
+		if !v.report(NewLayoutError(
 			addr, "duplicate ResultId(%d); previous definition at: $%08x",
 			id, paddr,
-		)
+		)) {
+			return
+		}
 	}
-
-	// TODO: The definition of an SSA <id> should dominate all uses of it,
-	// with the following exceptions :... (see spec)
-
-	return nil
 }
 
-// hasResultId returns the addr of a list entry, if src has a ResultId
-// matching any of the Result Ids in list. Returns -1 otherwise.
-func (m *Module) hasResultId(src Id, list []int) int {
+// hasEntryPoint returns the addr of a list entry, if src matches the
+// entry point of any of the OpEntryPoint instructions in list.
+// Returns -1 otherwise.
+func (m *Module) hasEntryPoint(src Id, list []int) int {
 	for _, addr := range list {
-		dst, ok := instructionResultId(m.Code[addr])
-		if ok && src == dst {
+		ep, ok := m.Code[addr].(*OpEntryPoint)
+		if ok && src == ep.EntryPoint {
 			return addr
 		}
 	}
@@ -230,14 +454,18 @@ func (m *Module) hasResultId(src Id, list []int) int {
 	return -1
 }
 
-// hasLinkageType returns true if there is a OpDecorate instance
-// on a (global) variable or function with a LinkageType defined.
+// hasLinkageType returns true if the module declares the Linkage
+// capability, or if there is an OpDecorate instance on a (global)
+// variable or function with LinkageAttributes defined.
 func (m *Module) hasLinkageType() bool {
-	decorations := m.Code.Filter(opcodeDecorate)
+	for _, i := range m.Code.Filter(opcodeCapability) {
+		if c, ok := i.(*OpCapability); ok && c.Capability == CapabilityLinkage {
+			return true
+		}
+	}
 
-	for _, i := range decorations {
-		v := i.(*OpDecorate)
-		if v.Decoration == DecorationLinkageType {
+	for _, i := range m.Code.Filter(opcodeDecorate) {
+		if d, ok := i.(*OpDecorate); ok && d.Decoration == DecorationLinkageAttributes {
 			return true
 		}
 	}
@@ -248,10 +476,10 @@ func (m *Module) hasLinkageType() bool {
 // verifyLogicalAddressing performs a number of checks if the logical
 // addressing mode is selected for this module.
 func (m *Module) verifyLogicalAddressing() error {
-	v := m.Code.First(opcodeMemoryModel).(*OpMemoryModel)
+	v, ok := m.Code.First(opcodeMemoryModel).(*OpMemoryModel)
 
-	if v.AddressingModel != AddressingModeLogical {
-		// These rules apply only to AddressingModeLogical
+	if !ok || v.AddressingModel != AddressingModelLogical {
+		// These rules apply only to AddressingModelLogical
 		return nil
 	}
 
@@ -267,36 +495,20 @@ func (m *Module) verifyLogicalAddressing() error {
 func (m *Module) verifyLogicalLayout() error {
 	// We must have one and only one OpmemoryModel.
 	//
-	// This will be caught by the regex match below, but here we can be
+	// This will be caught by the section check below, but here we can be
 	// more specific with our error message.
 	if m.Code.Count(opcodeMemoryModel) != 1 {
 		return ErrMemoryModel
 	}
 
-	// We must have at least one OpEntryPoint.
-	//
-	// This will be caught by the regex match below, but here we can be
-	// more specific with our error message.
-	if m.Code.Count(opcodeEntryPoint) == 0 {
-		return ErrEntrypoint
-	}
-
-	// We must have at least one OpExecutionMode.
-	//
-	// This will be caught by the regex match below, but here we can be
-	// more specific with our error message.
-	if m.Code.Count(opcodeExecutionMode) == 0 {
-		return ErrExecutionMode
-	}
-
 	// Test instruction order.
-	err := verifyLayoutPattern(m.Code)
+	err := verifyLayoutSections(m.Code)
 	if err != nil {
 		return err
 	}
 
-	// Some instructions have requirements beyond what can be tested
-	// with a regular expression pattern.
+	// Some instructions have requirements beyond the order in which
+	// they appear.
 
 	// Global Variables must not have StorageClassFunction.
 	err = verifyGlobalVariables(m.Code)
@@ -310,6 +522,12 @@ func (m *Module) verifyLogicalLayout() error {
 		return err
 	}
 
+	// Types must be declared before they are used.
+	err = verifyTypeDeclarations(m.Code)
+	if err != nil {
+		return err
+	}
+
 	// All local variables must be the first instructions in the first block.
 	return verifyFunctionStructure(m.Code)
 }
@@ -5,17 +5,20 @@ package spirv
 
 import (
 	"bytes"
+	"os"
 	"reflect"
 	"testing"
+
+	"github.com/andreas-jonsson/spirv/prerelease"
 )
 
 var mod = NewModule()
 
 func TestModuleVerifyLogicalLayout1(t *testing.T) {
-	// CompileFlag instruction is too early.
+	// Source instruction is too early.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
 		&OpSource{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -33,7 +36,7 @@ func TestModuleVerifyLogicalLayout1(t *testing.T) {
 func TestModuleVerifyLogicalLayout2(t *testing.T) {
 	// Valid module.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -55,6 +58,7 @@ func TestModuleVerifyLogicalLayout2(t *testing.T) {
 func TestModuleVerifyLogicalLayout3(t *testing.T) {
 	// Faulty module with missing OpFunctionEnd.
 	mod.Code = []Instruction{
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -77,9 +81,134 @@ func TestModuleVerifyLogicalLayout4(t *testing.T) {
 	}
 }
 
+func TestModuleVerifyLayoutSections(t *testing.T) {
+	for i, st := range []struct {
+		code []Instruction
+		want error
+	}{
+		{
+			// Source instruction is too early.
+			code: []Instruction{
+				&OpSource{},
+				&OpCapability{},
+				&OpMemoryModel{},
+			},
+			want: &LayoutError{
+				Msg:     "OpSource can not appear before OpCapability",
+				Address: 0,
+				Opcode:  opcodeSource,
+				Section: SectionPreamble,
+			},
+		},
+		{
+			// Name after a decoration.
+			code: []Instruction{
+				&OpCapability{},
+				&OpMemoryModel{},
+				&OpDecorate{},
+				&OpName{},
+			},
+			want: &LayoutError{
+				Msg:     "OpName can not appear in the annotations section",
+				Address: 3,
+				Opcode:  opcodeName,
+				Section: SectionAnnotations,
+			},
+		},
+		{
+			// Regular instruction outside of a function.
+			code: []Instruction{
+				&OpCapability{},
+				&OpMemoryModel{},
+				&OpTypeVoid{},
+				&OpIAdd{},
+			},
+			want: &LayoutError{
+				Msg:     "OpIAdd can not appear outside of a function",
+				Address: 3,
+				Opcode:  opcodeIAdd,
+				Section: SectionGlobals,
+			},
+		},
+		{
+			// Type declaration after the first function.
+			code: []Instruction{
+				&OpCapability{},
+				&OpMemoryModel{},
+				&OpFunction{},
+				&OpFunctionEnd{},
+				&OpTypeVoid{},
+			},
+			want: &LayoutError{
+				Msg:     "OpTypeVoid can not appear after the first function",
+				Address: 4,
+				Opcode:  opcodeTypeVoid,
+				Section: SectionFunctions,
+			},
+		},
+		{
+			// Instruction between blocks.
+			code: []Instruction{
+				&OpCapability{},
+				&OpMemoryModel{},
+				&OpFunction{},
+				&OpLabel{},
+				&OpReturn{},
+				&OpIAdd{},
+				&OpFunctionEnd{},
+			},
+			want: &LayoutError{
+				Msg:     "OpIAdd must be inside a block",
+				Address: 5,
+				Opcode:  opcodeIAdd,
+				Section: SectionFunctions,
+			},
+		},
+		{
+			// Unterminated block.
+			code: []Instruction{
+				&OpCapability{},
+				&OpMemoryModel{},
+				&OpFunction{},
+				&OpLabel{},
+				&OpIAdd{},
+				&OpFunctionEnd{},
+			},
+			want: &LayoutError{
+				Msg:     "OpFunctionEnd can not appear before the block is terminated",
+				Address: 5,
+				Opcode:  opcodeFunctionEnd,
+				Section: SectionFunctions,
+			},
+		},
+		{
+			// Missing OpFunctionEnd.
+			code: []Instruction{
+				&OpCapability{},
+				&OpMemoryModel{},
+				&OpFunction{},
+				&OpLabel{},
+				&OpReturn{},
+			},
+			want: &LayoutError{
+				Msg:     "OpFunction is not terminated by OpFunctionEnd",
+				Address: 2,
+				Opcode:  opcodeFunction,
+				Section: SectionFunctions,
+			},
+		},
+	} {
+		err := verifyLayoutSections(st.code)
+		if !reflect.DeepEqual(err, st.want) {
+			t.Fatalf("case %d: error mismatch:\nWant: %v\nHave: %v", i, st.want, err)
+		}
+	}
+}
+
 func TestModuleVerifyLogicalLayout5(t *testing.T) {
 	// Module with global variable. it has an unacceptable storage class.
 	mod.Code = []Instruction{
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -93,7 +222,7 @@ func TestModuleVerifyLogicalLayout5(t *testing.T) {
 	}
 
 	err := mod.verifyLogicalLayout()
-	want := NewLayoutError(3, "global variable: storage class can not be StorageClassFunction")
+	want := NewLayoutError(4, "global variable: storage class can not be StorageClassFunction")
 
 	if !reflect.DeepEqual(err, want) {
 		t.Fatalf("error mismatch:\nWant: %v\nHave: %v", want, err)
@@ -101,43 +230,55 @@ func TestModuleVerifyLogicalLayout5(t *testing.T) {
 }
 
 func TestModuleVerifyLogicalLayout6(t *testing.T) {
-	// Missing Entrypoint
+	// Missing OpCapability.
 	mod.Code = []Instruction{
 		&OpMemoryModel{},
-		&OpExecutionMode{},
+		&OpEntryPoint{},
 		&OpFunction{},
 		&OpFunctionEnd{},
 	}
 
 	err := mod.verifyLogicalLayout()
-	want := ErrEntrypoint
-
-	if !reflect.DeepEqual(err, want) {
-		t.Fatalf("error mismatch:\nWant: %v\nHave: %v", want, err)
+	if err == nil {
+		t.Fatalf("expected failure")
 	}
 }
 
 func TestModuleVerifyLogicalLayout7(t *testing.T) {
-	// Missing Execution Mode
+	// Valid module without execution modes. Blocks may end in any
+	// terminator and functions may be declared without a body.
 	mod.Code = []Instruction{
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
+
 		&OpFunction{},
 		&OpFunctionEnd{},
+
+		&OpFunction{},
+		&OpFunctionParameter{},
+		&OpLabel{},
+		&OpVariable{
+			StorageClass: StorageClassFunction,
+		},
+		&OpBranchConditional{},
+		&OpLabel{},
+		&OpReturn{},
+		&OpLabel{},
+		&OpReturnValue{},
+		&OpFunctionEnd{},
 	}
 
 	err := mod.verifyLogicalLayout()
-	want := ErrExecutionMode
-
-	if !reflect.DeepEqual(err, want) {
-		t.Fatalf("error mismatch:\nWant: %v\nHave: %v", want, err)
+	if err != nil {
+		t.Fatal(err)
 	}
 }
 
 func TestModuleVerifyLogicalLayout8(t *testing.T) {
 	// Variables inside functions must have StorageClassFunction
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -163,7 +304,7 @@ func TestModuleVerifyLogicalLayout8(t *testing.T) {
 func TestModuleVerifyLogicalLayout9(t *testing.T) {
 	// Variables inside functions must be contained in the first block.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -195,7 +336,7 @@ func TestModuleVerifyLogicalLayout10(t *testing.T) {
 	// Variables inside functions must be contained in the first block and
 	// not be preceeded by any other instruction.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -221,13 +362,34 @@ func TestModuleVerifyLogicalLayout10(t *testing.T) {
 	}
 }
 
+func TestModuleVerifyLogicalLayout11(t *testing.T) {
+	// Types must be declared before they are used, other than forward
+	// declared pointers.
+	mod.Code = []Instruction{
+		&OpCapability{},
+		&OpMemoryModel{},
+		&OpTypeForwardPointer{PointerType: 3, StorageClass: StorageClassPhysicalStorageBuffer},
+		&OpTypeFloat{ResultId: 1, Width: 32},
+		&OpTypeStruct{ResultId: 2, Members: []Id{1, 3}},
+		&OpTypePointer{ResultId: 3, StorageClass: StorageClassPhysicalStorageBuffer, Type: 2},
+		&OpTypeVector{ResultId: 4, ComponentType: 4, ComponentCount: 4},
+	}
+
+	err := mod.verifyLogicalLayout()
+	want := NewLayoutError(6, "OpTypeVector: ComponentType(4) is used before it is declared")
+
+	if !reflect.DeepEqual(err, want) {
+		t.Fatalf("error mismatch:\nWant: %v\nHave: %v", want, err)
+	}
+}
+
 func TestModuleVerifyLogicalAddressing1(t *testing.T) {
 	// Faulty module: variable allocates pointer type while
 	// memory model is Logical.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{
-			AddressingModel: AddressingModeLogical,
+			AddressingModel: AddressingModelLogical,
 		},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -249,7 +411,7 @@ func TestModuleVerifyLogicalAddressing1(t *testing.T) {
 func TestModuleVerifySSA1(t *testing.T) {
 	// Faulty module: 2 identical result IDs.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{},
 		&OpExecutionMode{},
@@ -262,7 +424,7 @@ func TestModuleVerifySSA1(t *testing.T) {
 	}
 
 	want := NewLayoutError(6, "duplicate ResultId(%d); previous definition at: $%08x", 1, 4)
-	have := mod.verifySSA()
+	have := mod.check((*Module).verifySSA)
 
 	if !reflect.DeepEqual(have, want) {
 		t.Fatalf("error mismatch:\nWant: %v\nHave: %v", want, have)
@@ -270,10 +432,10 @@ func TestModuleVerifySSA1(t *testing.T) {
 }
 
 func TestModuleVerifyEntrypoints1(t *testing.T) {
-	// Faulty module: missing OpEntryPoint and no LinkageType decoration
+	// Faulty module: missing OpEntryPoint and no LinkageAttributes decoration
 	// used to offset its absence.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpExecutionMode{},
 
@@ -282,7 +444,7 @@ func TestModuleVerifyEntrypoints1(t *testing.T) {
 	}
 
 	want := NewLayoutError(0, "unless the Linkage capabilities are used, we require at least 1 OpEntryPoint")
-	have := mod.verifyEntrypoints()
+	have := mod.check((*Module).verifyEntrypoints)
 
 	if !reflect.DeepEqual(have, want) {
 		t.Fatalf("error mismatch:\nWant: %v\nHave: %v", want, have)
@@ -290,21 +452,21 @@ func TestModuleVerifyEntrypoints1(t *testing.T) {
 }
 
 func TestModuleVerifyEntrypoints2(t *testing.T) {
-	// Good module: missing OpEntryPoint but a LinkageType decoration
+	// Good module: missing OpEntryPoint but a LinkageAttributes decoration
 	// is present.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpExecutionMode{},
 
 		&OpDecorate{
-			Decoration: DecorationLinkageType,
+			Decoration: DecorationLinkageAttributes,
 		},
 		&OpFunction{},
 		&OpFunctionEnd{},
 	}
 
-	err := mod.verifyEntrypoints()
+	err := mod.check((*Module).verifyEntrypoints)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -315,11 +477,11 @@ func TestModuleVerifyEntrypoints3(t *testing.T) {
 	// OpFunctionCall. This is akin to a C/Go/etc program calling
 	// main() from somewhere in the program. This is not allowed in SPIR-V.
 	mod.Code = []Instruction{
-		&OpCompileFlag{},
+		&OpCapability{},
 		&OpMemoryModel{},
 		&OpEntryPoint{
 			ExecutionModel: ExecutionModelFragment,
-			ResultId:       1,
+			EntryPoint:     1,
 		},
 		&OpExecutionMode{},
 
@@ -334,7 +496,7 @@ func TestModuleVerifyEntrypoints3(t *testing.T) {
 	}
 
 	want := NewLayoutError(6, "call to function previously defined as entrypoint at $%08x", 2)
-	have := mod.verifyEntrypoints()
+	have := mod.check((*Module).verifyEntrypoints)
 
 	if !reflect.DeepEqual(have, want) {
 		t.Fatalf("error mismatch:\nWant: %v\nHave: %v", want, have)
@@ -343,12 +505,19 @@ func TestModuleVerifyEntrypoints3(t *testing.T) {
 
 func TestModuleStrip(t *testing.T) {
 	mod.Code = []Instruction{
-		&OpSource{SourceLanguageGLSL, 450},
-		&OpCompileFlag{
-			Flag: "test",
+		&OpCapability{
+			Capability: CapabilityShader,
+		},
+		&OpSource{
+			SourceLanguage: SourceLanguageGLSL,
+			Version:        450,
+		},
+		&OpName{
+			Target: 1,
+			Name:   "test",
 		},
 		&OpMemoryModel{
-			AddressingModel: AddressingModeLogical,
+			AddressingModel: AddressingModelLogical,
 			MemoryModel:     MemoryModelGLSL450,
 		},
 	}
@@ -380,3 +549,192 @@ func TestModuleRoundtrip(t *testing.T) {
 		t.Fatalf("rountrip failure:\nHave: %v\nWant: %v", mod, modb)
 	}
 }
+
+func TestModuleVerifyPreRelease(t *testing.T) {
+	// Released instructions can not be used in a pre-release module.
+	modb := NewModule()
+	modb.Header.Version = VersionPreRelease
+	modb.Code = []Instruction{
+		&OpCapability{},
+	}
+
+	want := NewLayoutError(0, "%s is not a pre-release instruction", "OpCapability")
+	have := modb.Verify()
+
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("error mismatch:\nWant: %v\nHave: %v", want, have)
+	}
+}
+
+func TestModuleLoadPreRelease(t *testing.T) {
+	fd, err := os.Open("testdata/test.spirv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer fd.Close()
+
+	modb, err := Load(fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if modb.Header.Version != VersionPreRelease {
+		t.Fatalf("version mismatch: want %d, have %d",
+			VersionPreRelease, modb.Header.Version)
+	}
+
+	if _, ok := modb.Code[0].(*prerelease.OpSource); !ok {
+		t.Fatalf("expected *prerelease.OpSource; have %T", modb.Code[0])
+	}
+}
+
+func TestModuleVerifyIds1(t *testing.T) {
+	// Faulty module: Id exceeds the bound.
+	modb := NewModule()
+	modb.Header.Bound = 3
+	modb.Code = []Instruction{
+		&OpTypeVoid{ResultId: 1},
+		&OpTypeFunction{ResultId: 2, ReturnType: 1},
+		&OpFunction{ResultType: 1, ResultId: 3, FunctionType: 2},
+	}
+
+	want := NewLayoutError(2, "%s: %s(%d) is out of range; Bound is %d",
+		"OpFunction", "ResultId", 3, 3)
+	have := modb.check((*Module).verifyIds)
+
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("error mismatch:\nWant: %v\nHave: %v", want, have)
+	}
+}
+
+func TestModuleVerifyIds2(t *testing.T) {
+	// Faulty module: Id 0 is never valid.
+	modb := NewModule()
+	modb.Header.Bound = 10
+	modb.Code = []Instruction{
+		&OpTypeStruct{ResultId: 1, Members: []Id{2, 0}},
+	}
+
+	want := NewLayoutError(0, "%s: %s(%d) is out of range; Bound is %d",
+		"OpTypeStruct", "Members", 0, 10)
+	have := modb.check((*Module).verifyIds)
+
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("error mismatch:\nWant: %v\nHave: %v", want, have)
+	}
+}
+
+func TestModuleVerifyIds3(t *testing.T) {
+	// Good module: absent optional Ids are 0.
+	modb := NewModule()
+	modb.Header.Bound = 2
+	modb.Code = []Instruction{
+		&OpSource{SourceLanguage: SourceLanguageGLSL, Version: 450},
+		&OpString{ResultId: 1, String: "main.glsl"},
+		&OpSource{SourceLanguage: SourceLanguageGLSL, Version: 450, File: 1},
+	}
+
+	err := modb.check((*Module).verifyIds)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestModuleVerifyIds4(t *testing.T) {
+	// Good module: literal indices of OpSpecConstantOp are not Ids.
+	modb := NewModule()
+	modb.Header.Bound = 5
+	modb.Code = []Instruction{
+		&OpTypeInt{ResultId: 1, Width: 32},
+		&OpTypeVector{ResultId: 2, ComponentType: 1, ComponentCount: 2},
+		&OpSpecConstantComposite{ResultType: 2, ResultId: 3, Constituents: []Id{3, 3}},
+		&OpSpecConstantOp{ResultType: 1, ResultId: 4, Operation: opcodeCompositeExtract, Operands: []Id{3, 0}},
+	}
+
+	err := modb.check((*Module).verifyIds)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestModuleComputeBound(t *testing.T) {
+	modb := NewModule()
+	modb.Code = []Instruction{
+		&OpExtInstImport{ResultId: 1, Name: "Test.ext"},
+		&OpExtInstImport{ResultId: 2, Name: "Unknown.ext"},
+		&OpExtInst{ResultType: 3, ResultId: 4, Set: 1, Instruction: 1, Operands: []Id{9}},
+
+		// Operands of unknown sets may be literals, so they are ignored.
+		&OpExtInst{ResultType: 3, ResultId: 5, Set: 2, Instruction: 1, Operands: []Id{100}},
+	}
+
+	if have, want := modb.ComputeBound(), uint32(10); have != want {
+		t.Fatalf("bound mismatch:\nHave: %d\nWant: %d", have, want)
+	}
+
+	var out bytes.Buffer
+	err := modb.SaveWithOptions(&out, SaveOptions{UpdateBound: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if modb.Header.Bound != 0 {
+		t.Fatalf("SaveWithOptions modified the module")
+	}
+
+	modc, err := Load(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if modc.Header.Bound != 10 {
+		t.Fatalf("bound mismatch:\nHave: %d\nWant: %d", modc.Header.Bound, 10)
+	}
+}
+
+func TestModuleForEachId(t *testing.T) {
+	modb := NewModule()
+	modb.Code = []Instruction{
+		&OpExtInstImport{ResultId: 1, Name: "Test.ext"},
+		&OpExtInst{ResultType: 2, ResultId: 3, Set: 1, Instruction: 1, Operands: []Id{4}},
+	}
+
+	modb.ForEachId(func(_ int, _ string, id *Id) {
+		*id += 10
+	})
+
+	want := InstructionList{
+		&OpExtInstImport{ResultId: 11, Name: "Test.ext"},
+		&OpExtInst{ResultType: 12, ResultId: 13, Set: 11, Instruction: 1, Operands: []Id{14}},
+	}
+
+	if !reflect.DeepEqual(modb.Code, want) {
+		t.Fatalf("value mismatch:\nHave: %v\nWant: %v", modb.Code, want)
+	}
+}
+
+func TestForEachMemoryAccessOperand(t *testing.T) {
+	type operand struct {
+		Bit MemoryAccess
+		Id  bool
+	}
+
+	var have []operand
+	mask := MemoryAccess(MemoryAccessVolatile | MemoryAccessMakePointerVisible | MemoryAccessAligned)
+	n := ForEachMemoryAccessOperand(mask, func(i int, bit MemoryAccess, id bool) {
+		if i != len(have) {
+			t.Fatalf("index mismatch: have %d, want %d", i, len(have))
+		}
+		have = append(have, operand{bit, id})
+	})
+
+	want := []operand{
+		{MemoryAccessAligned, false},
+		{MemoryAccessMakePointerVisible, true},
+	}
+
+	if n != len(want) || !reflect.DeepEqual(have, want) {
+		t.Fatalf("operand mismatch:\nHave: %d %v\nWant: %v", n, have, want)
+	}
+}
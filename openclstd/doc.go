@@ -0,0 +1,25 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+/*
+Package openclstd holds the OpenCL.std extended instruction set.
+
+OpenCL kernels import this set for the built-in functions of OpenCL C.
+These are invoked through OpExtInst, which only carries the opcode of the
+extended instruction and a list of operands. Importing this package
+registers the set with package spirv, after which spirv.Module.ExtInst
+resolves such instructions into the types defined here:
+
+	ext, err := module.ExtInst(instr)
+	...
+
+	if fma, ok := ext.(*openclstd.Fma); ok {
+		...
+	}
+
+The instructions are named after their OpenCL C counterparts, with
+underscores removed. For example, vstore_half_r is defined as VstoreHalfR.
+Extended instructions are turned back into an OpExtInst instruction with
+spirv.EncodeExtInst.
+*/
+package openclstd
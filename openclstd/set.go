@@ -0,0 +1,88 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package openclstd
+
+import (
+	"sort"
+
+	"github.com/andreas-jonsson/spirv"
+)
+
+// Name is the name by which modules import this instruction set.
+const Name = "OpenCL.std"
+
+// instructions maps opcodes to an instruction constructor.
+var instructions = make(map[uint32]func() spirv.ExtInstruction)
+
+// bind registers the given instruction.
+// All instructions are registered during package initialisation.
+func bind(fun func() spirv.ExtInstruction) {
+	instructions[fun().Opcode()] = fun
+}
+
+// Lookup returns the constructor for the instruction with the given opcode.
+func Lookup(opcode uint32) (func() spirv.ExtInstruction, bool) {
+	fun, ok := instructions[opcode]
+	return fun, ok
+}
+
+// OpcodeName returns the name of the instruction with the given opcode,
+// as it is spelled in the specification.
+func OpcodeName(opcode uint32) (string, bool) {
+	name, ok := opnames[opcode]
+	return name, ok
+}
+
+// Opcodes returns a sorted list of all opcodes in this set.
+func Opcodes() []int {
+	out := make([]int, 0, len(instructions))
+
+	for opcode := range instructions {
+		out = append(out, int(opcode))
+	}
+
+	sort.Ints(out)
+	return out
+}
+
+// OperandCount returns the number of operands taken by the instruction
+// with the given opcode. For printf, which accepts a variable number of
+// operands, this is the minimum.
+func OperandCount(opcode uint32) (int, bool) {
+	fun, ok := instructions[opcode]
+	if !ok {
+		return 0, false
+	}
+
+	return fun().OperandLen(), true
+}
+
+// decodeIds returns a copy of the given words as a list of Ids.
+func decodeIds(argv []uint32) []spirv.Id {
+	if len(argv) == 0 {
+		return nil
+	}
+
+	ids := make([]spirv.Id, len(argv))
+	for i, word := range argv {
+		ids[i] = spirv.Id(word)
+	}
+
+	return ids
+}
+
+// set exposes this package as a spirv.ExtInstSet.
+type set struct{}
+
+func (set) Name() string                                             { return Name }
+func (set) Lookup(opcode uint32) (func() spirv.ExtInstruction, bool) { return Lookup(opcode) }
+func (set) Opcodes() []int                                           { return Opcodes() }
+func (set) OpcodeName(opcode uint32) (string, bool)                  { return OpcodeName(opcode) }
+
+func init() {
+	err := spirv.RegisterExtInstSet(set{})
+	if err != nil {
+		panic(err)
+	}
+}
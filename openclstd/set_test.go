@@ -0,0 +1,86 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package openclstd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andreas-jonsson/spirv"
+)
+
+func TestOpcodes(t *testing.T) {
+	have := Opcodes()
+	if len(have) != 162 || have[0] != OpAcos || have[161] != OpUMadHi {
+		t.Fatalf("unexpected opcodes: %v", have)
+	}
+
+	if n, _ := OperandCount(OpPrintf); n != 1 {
+		t.Fatalf("printf operand count mismatch: %d", n)
+	}
+}
+
+func TestDecodeExtInst(t *testing.T) {
+	set, ok := spirv.LookupExtInstSet(Name)
+	if !ok {
+		t.Fatalf("%s is not registered", Name)
+	}
+
+	for _, st := range []struct {
+		in   *spirv.OpExtInst
+		want spirv.ExtInstruction
+		err  bool
+	}{
+		{
+			in:   &spirv.OpExtInst{Instruction: OpFma, Operands: []spirv.Id{1, 2, 3}},
+			want: &Fma{A: 1, B: 2, C: 3},
+		},
+		{
+			in:   &spirv.OpExtInst{Instruction: OpVloadn, Operands: []spirv.Id{1, 2, 4}},
+			want: &Vloadn{Offset: 1, P: 2, N: 4},
+		},
+		{
+			in: &spirv.OpExtInst{Instruction: OpVstoreHalfR, Operands: []spirv.Id{1, 2, 3,
+				spirv.Id(spirv.FPRoundingModeRTZ)}},
+			want: &VstoreHalfR{Data: 1, Offset: 2, P: 3, Mode: spirv.FPRoundingModeRTZ},
+		},
+		{
+			in:   &spirv.OpExtInst{Instruction: OpPrintf, Operands: []spirv.Id{1, 2, 3}},
+			want: &Printf{Format: 1, AdditionalArguments: []spirv.Id{2, 3}},
+		},
+		{
+			in:   &spirv.OpExtInst{Instruction: OpPrintf, Operands: []spirv.Id{1}},
+			want: &Printf{Format: 1},
+		},
+		{
+			// Invalid rounding mode.
+			in:  &spirv.OpExtInst{Instruction: OpVstoreHalfR, Operands: []spirv.Id{1, 2, 3, 99}},
+			err: true,
+		},
+		{
+			in:  &spirv.OpExtInst{Instruction: OpFma, Operands: []spirv.Id{1, 2}},
+			err: true,
+		},
+		{
+			in:  &spirv.OpExtInst{Instruction: 120, Operands: []spirv.Id{1}},
+			err: true,
+		},
+	} {
+		have, err := spirv.DecodeExtInst(set, st.in)
+		if err != nil {
+			if !st.err {
+				t.Fatalf("%+v: %v", st.in, err)
+			}
+			continue
+		}
+
+		if st.err {
+			t.Fatalf("%+v: expected failure", st.in)
+		}
+
+		if !reflect.DeepEqual(have, st.want) {
+			t.Fatalf("decode mismatch:\nHave: %+v\nWant: %+v", have, st.want)
+		}
+	}
+}
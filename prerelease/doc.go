@@ -0,0 +1,18 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+/*
+Package prerelease holds the instruction set of the provisional SPIR-V
+specification, which identifies itself with version number 99 in a module
+header.
+
+The released 1.x specification renumbered most opcodes, changed the shape of
+many instructions and redefined a number of enumerations. The definitions in
+this package are therefore kept apart from those in package spirv. The spirv
+decoder selects them automatically when it reads a module whose header
+carries version 99, and spirv.Module.Verify defers to Verify in this package
+for such modules.
+
+New code should target the released specification.
+*/
+package prerelease
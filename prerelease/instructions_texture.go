@@ -1,7 +1,7 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
+package prerelease
 
 // OpSampler creates a sampler containing both a filter and texture.
 type OpSampler struct {
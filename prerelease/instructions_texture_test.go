@@ -1,9 +1,13 @@
 // This file is subject to a 1-clause BSD license.
 // Its contents can be found in the enclosed LICENSE file.
 
-package spirv
+package prerelease_test
 
-import "testing"
+import (
+	"testing"
+
+	. "github.com/andreas-jonsson/spirv/prerelease"
+)
 
 func TestTexture(t *testing.T) {
 	for _, st := range []InstructionTest{
@@ -0,0 +1,10 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package prerelease
+
+// String defines a string literal operand.
+//
+// Encoding and decoding is handled by the spirv package, which treats
+// any string kind as a nul-terminated, word-aligned UTF-8 literal.
+type String string
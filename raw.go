@@ -0,0 +1,44 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// RawInstruction holds an instruction in its encoded form.
+//
+// It is yielded by a Decoder with DecoderOptions.KeepRaw set, for any
+// instruction the package does not understand. Examples are instructions
+// from vendor extensions, or instructions with operands which are not
+// described by the grammar. The original operand words are kept as-is,
+// so the instruction encodes to exactly the same words it was decoded from.
+//
+// Raw instructions with an opcode which is unknown to the package will
+// not pass Module.Verify.
+type RawInstruction struct {
+	Code uint32   // The instruction opcode.
+	Argv []uint32 // The operand words, excluding the opcode word.
+}
+
+// NewRawInstruction creates a raw instruction from the given words.
+// The first word holds the word count and opcode. The remaining words
+// are copied.
+func NewRawInstruction(words []uint32) *RawInstruction {
+	return &RawInstruction{
+		Code: words[0] & 0xffff,
+		Argv: Copy(words[1:]),
+	}
+}
+
+func (c *RawInstruction) Opcode() uint32 { return c.Code }
+func (c *RawInstruction) Optional() bool { return false }
+func (c *RawInstruction) Verify() error  { return nil }
+
+func (c *RawInstruction) OperandLen() int { return len(c.Argv) }
+
+func (c *RawInstruction) EncodeOperands(out []uint32) int {
+	return copy(out, c.Argv)
+}
+
+func (c *RawInstruction) DecodeOperands(argv []uint32) error {
+	c.Argv = Copy(argv)
+	return nil
+}
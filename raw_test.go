@@ -0,0 +1,77 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeRaw(t *testing.T) {
+	in := []uint32{0x00031234, 1, 2}
+
+	dec := NewDecoder(testWordReader(in))
+	_, err := dec.DecodeInstruction()
+
+	want := fmt.Errorf("%w: %08x", ErrUnknownInstruction, 0x1234)
+	if !reflect.DeepEqual(err, want) {
+		t.Fatalf("error mismatch:\nHave: %v\nWant: %v", err, want)
+	}
+
+	dec = NewDecoder(testWordReader(in))
+	dec.Options.KeepRaw = true
+
+	have, err := dec.DecodeInstruction()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantInstr := &RawInstruction{Code: 0x1234, Argv: []uint32{1, 2}}
+	if !reflect.DeepEqual(have, wantInstr) {
+		t.Fatalf("decode mismatch:\nHave: %+v\nWant: %+v", have, wantInstr)
+	}
+}
+
+func TestModuleRoundtripRaw(t *testing.T) {
+	in := testWordReader([]uint32{
+		MagicLE, Version13, 0, 10, 0,
+
+		// OpCapability Shader
+		0x00020011, 1,
+
+		// Vendor instruction, unknown to the package.
+		0x00031234, 1, 2,
+
+		// OpSource with an explicit, empty File operand. Decoding
+		// and encoding this drops the last word.
+		0x00040003, 2, 450, 0,
+	}).Bytes()
+
+	mod, err := LoadWithOptions(bytes.NewReader(in), DecoderOptions{KeepRaw: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := InstructionList{
+		&OpCapability{Capability: CapabilityShader},
+		&RawInstruction{Code: 0x1234, Argv: []uint32{1, 2}},
+		&RawInstruction{Code: opcodeSource, Argv: []uint32{2, 450, 0}},
+	}
+
+	if !reflect.DeepEqual(mod.Code, want) {
+		t.Fatalf("decode mismatch:\nHave: %v\nWant: %v", mod.Code, want)
+	}
+
+	var out bytes.Buffer
+	err = mod.Save(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(out.Bytes(), in) {
+		t.Fatalf("roundtrip mismatch:\nHave: %v\nWant: %v", out.Bytes(), in)
+	}
+}
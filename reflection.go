@@ -0,0 +1,567 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ShaderInterface describes the interface of a single entry point: the
+// variables through which it exchanges data with other pipeline stages
+// and the resources it uses.
+type ShaderInterface struct {
+	EntryPoint     Id // The entry point's function.
+	Name           string
+	ExecutionModel ExecutionModel
+
+	// Inputs and Outputs hold the stage inputs and outputs, other than
+	// built-ins, sorted by location.
+	Inputs  []InterfaceVariable
+	Outputs []InterfaceVariable
+
+	// Resources holds the uniform and storage resources statically used
+	// by the entry point, sorted by descriptor set and binding.
+	Resources []Resource
+
+	// BuiltIns holds the built-in inputs and outputs, in declaration order.
+	BuiltIns []BuiltInVariable
+}
+
+// InterfaceVariable describes a stage input or output.
+type InterfaceVariable struct {
+	Id       Id
+	Name     string // The debug name, if any.
+	Location int    // -1 if the variable has no Location decoration.
+	Type     *Type  // The type of the variable, not its pointer type.
+
+	// MemberLocations holds the Location decoration of each member, for
+	// blocks whose members are decorated with one. It is -1 for members
+	// without a Location, and nil for all other variables.
+	MemberLocations []int
+}
+
+// ResourceKind defines the kind of a resource.
+type ResourceKind uint8
+
+// Known resource kinds.
+const (
+	ResourceUnknown ResourceKind = iota
+
+	// ResourceUniformBuffer is a Block decorated struct in the Uniform
+	// storage class.
+	ResourceUniformBuffer
+
+	// ResourceStorageBuffer is a struct in the StorageBuffer storage class,
+	// or a BufferBlock decorated struct in the Uniform storage class.
+	//
+	// Structs in the Uniform storage class without either decoration are
+	// not reported.
+	ResourceStorageBuffer
+
+	// ResourcePushConstant is a block in the PushConstant storage class.
+	// It has no descriptor set or binding.
+	ResourcePushConstant
+
+	// ResourceSampler is a sampler.
+	ResourceSampler
+
+	// ResourceSampledImage is an image combined with a sampler.
+	ResourceSampledImage
+
+	// ResourceImage is an image which is read through a sampler.
+	ResourceImage
+
+	// ResourceStorageImage is an image which is read and written
+	// without a sampler.
+	ResourceStorageImage
+
+	// ResourceUniformTexelBuffer is a buffer image which is only read.
+	ResourceUniformTexelBuffer
+
+	// ResourceStorageTexelBuffer is a buffer image which is read and
+	// written.
+	ResourceStorageTexelBuffer
+
+	// ResourceInputAttachment is a subpass input.
+	ResourceInputAttachment
+)
+
+var resourceKindNames = [...]string{
+	ResourceUnknown:            "unknown",
+	ResourceUniformBuffer:      "uniform buffer",
+	ResourceStorageBuffer:      "storage buffer",
+	ResourcePushConstant:       "push constant",
+	ResourceSampler:            "sampler",
+	ResourceSampledImage:       "sampled image",
+	ResourceImage:              "image",
+	ResourceStorageImage:       "storage image",
+	ResourceUniformTexelBuffer: "uniform texel buffer",
+	ResourceStorageTexelBuffer: "storage texel buffer",
+	ResourceInputAttachment:    "input attachment",
+}
+
+func (k ResourceKind) String() string {
+	if int(k) < len(resourceKindNames) {
+		return resourceKindNames[k]
+	}
+	return fmt.Sprintf("ResourceKind(%d)", k)
+}
+
+// Resource describes a uniform or storage resource.
+type Resource struct {
+	Id           Id
+	Name         string // The debug name, if any.
+	Kind         ResourceKind
+	StorageClass StorageClass
+	Set          uint32 // The DescriptorSet decoration.
+	Binding      uint32 // The Binding decoration.
+
+	// HasSet and HasBinding tell whether the variable is decorated with
+	// a DescriptorSet and Binding. Set and Binding are 0 if it is not.
+	HasSet     bool
+	HasBinding bool
+
+	// Count is the number of descriptors for arrays of resources. It is 1
+	// for single resources, and 0 for runtime arrays or arrays whose length
+	// is not known.
+	Count int
+
+	// Type is the type of the variable, not its pointer type. For arrays
+	// of resources, it is the array type.
+	Type *Type
+}
+
+// BuiltInVariable describes a built-in input or output.
+type BuiltInVariable struct {
+	Id           Id
+	Name         string // The debug name, if any.
+	BuiltIn      BuiltIn
+	StorageClass StorageClass
+
+	// Member is the index of the struct member decorated as built-in,
+	// for blocks like gl_PerVertex. It is -1 if the variable itself is
+	// the built-in.
+	Member int
+
+	// Type is the type of the built-in. For members, this is the type
+	// of the member.
+	Type *Type
+}
+
+// Reflect describes the interface of each entry point in the module, in
+// the order they are declared.
+//
+// Stage inputs and outputs are taken from the interface listed by each
+// OpEntryPoint. Resources are the global variables which are referenced
+// from the entry point's function, or from any function it calls.
+//
+// Decorations are applied directly, or through decoration groups. An Id
+// may be given the same decoration more than once, but only with the same
+// value.
+//
+// Returns an error for pre-release modules and for conflicting decorations.
+func (m *Module) Reflect() ([]*ShaderInterface, error) {
+	if m.Header.Version == VersionPreRelease {
+		return nil, errors.New("can not reflect pre-release modules")
+	}
+
+	r, err := newReflector(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*ShaderInterface
+	for _, instr := range m.Code {
+		if ep, ok := instr.(*OpEntryPoint); ok {
+			out = append(out, r.entryPoint(ep))
+		}
+	}
+
+	return out, nil
+}
+
+// reflector holds the module-wide details needed to reflect entry points.
+type reflector struct {
+	types     *TypeTable
+	names     map[Id]string
+	functions map[Id]InstructionList
+	variables map[Id]*OpVariable // Global variables.
+	order     []Id               // Global variables, in declaration order.
+
+	decorations map[Id]map[Decoration]uint32 // First operand word, or 0.
+	builtIns    map[Id]map[uint32]uint32     // Built-in members of struct types.
+	locations   map[Id]map[uint32]uint32     // Member locations of struct types.
+}
+
+func newReflector(m *Module) (*reflector, error) {
+	r := &reflector{
+		types:       m.Types(),
+		names:       make(map[Id]string),
+		functions:   make(map[Id]InstructionList),
+		variables:   make(map[Id]*OpVariable),
+		decorations: make(map[Id]map[Decoration]uint32),
+		builtIns:    make(map[Id]map[uint32]uint32),
+		locations:   make(map[Id]map[uint32]uint32),
+	}
+
+	for _, fn := range m.Code.Functions() {
+		// Functions held as raw instructions can not be resolved.
+		if v, ok := fn[0].(*OpFunction); ok {
+			r.functions[v.ResultId] = fn
+		}
+	}
+
+	for addr, instr := range m.Code {
+		var err error
+
+		switch v := instr.(type) {
+		case *OpName:
+			r.names[v.Target] = string(v.Name)
+
+		case *OpDecorate:
+			var arg uint32
+			if len(v.Argv) > 0 {
+				arg = v.Argv[0]
+			}
+			err = r.decorate(v.Target, v.Decoration, arg)
+
+		case *OpMemberDecorate:
+			if len(v.Argv) > 0 {
+				err = r.decorateMember(v.StructureType, v.Member, v.Decoration, v.Argv[0])
+			}
+
+		case *OpGroupDecorate:
+			for _, target := range v.Targets {
+				for dec, arg := range r.decorations[v.DecorationGroup] {
+					if err == nil {
+						err = r.decorate(target, dec, arg)
+					}
+				}
+			}
+
+		case *OpGroupMemberDecorate:
+			for j := 0; j+1 < len(v.Targets); j += 2 {
+				for dec, arg := range r.decorations[v.DecorationGroup] {
+					if err == nil {
+						err = r.decorateMember(Id(v.Targets[j]), v.Targets[j+1], dec, arg)
+					}
+				}
+			}
+
+		case *OpVariable:
+			if v.StorageClass != StorageClassFunction {
+				r.variables[v.ResultId] = v
+				r.order = append(r.order, v.ResultId)
+			}
+
+		case *OpFunction:
+			// Global variables can not follow the first function.
+			return r, nil
+		}
+
+		if err != nil {
+			return nil, NewLayoutError(addr, "%s: %v", instructionName(instr), err)
+		}
+	}
+
+	return r, nil
+}
+
+// decorate records a decoration of the given Id, with its first operand
+// word. Returns an error if the Id already has the decoration, with
+// another value.
+func (r *reflector) decorate(id Id, dec Decoration, arg uint32) error {
+	if r.decorations[id] == nil {
+		r.decorations[id] = make(map[Decoration]uint32)
+	}
+
+	if have, ok := r.decorations[id][dec]; ok && have != arg {
+		return fmt.Errorf("conflicting %v decorations of %d: %d and %d", dec, id, have, arg)
+	}
+
+	r.decorations[id][dec] = arg
+	return nil
+}
+
+// decorateMember records the BuiltIn and Location decorations of struct
+// members. Returns an error if the member already has the decoration, with
+// another value.
+func (r *reflector) decorateMember(id Id, member uint32, dec Decoration, arg uint32) error {
+	var members map[Id]map[uint32]uint32
+	switch dec {
+	case DecorationBuiltIn:
+		members = r.builtIns
+	case DecorationLocation:
+		members = r.locations
+	default:
+		return nil
+	}
+
+	if members[id] == nil {
+		members[id] = make(map[uint32]uint32)
+	}
+
+	if have, ok := members[id][member]; ok && have != arg {
+		return fmt.Errorf("conflicting %v decorations of member %d of %d: %d and %d",
+			dec, member, id, have, arg)
+	}
+
+	members[id][member] = arg
+	return nil
+}
+
+// entryPoint describes the interface of the given entry point.
+func (r *reflector) entryPoint(ep *OpEntryPoint) *ShaderInterface {
+	si := &ShaderInterface{
+		EntryPoint:     ep.EntryPoint,
+		Name:           string(ep.Name),
+		ExecutionModel: ep.ExecutionModel,
+	}
+
+	used := r.staticUse(ep.EntryPoint)
+
+	// Before version 1.4, the interface only lists inputs and outputs.
+	for _, id := range ep.Interface {
+		used[id] = true
+	}
+
+	for _, id := range r.order {
+		if !used[id] {
+			continue
+		}
+
+		v := r.variables[id]
+		typ, _ := r.types.Type(v.ResultType)
+		if typ != nil {
+			typ = typ.Elem
+		}
+
+		switch v.StorageClass {
+		case StorageClassInput, StorageClassOutput:
+			if r.builtIn(si, v, typ) {
+				continue
+			}
+
+			iv := InterfaceVariable{
+				Id:       id,
+				Name:     r.names[id],
+				Location: -1,
+				Type:     typ,
+			}
+
+			if loc, ok := r.decorations[id][DecorationLocation]; ok {
+				iv.Location = int(loc)
+			}
+
+			iv.MemberLocations = r.memberLocations(typ)
+
+			if v.StorageClass == StorageClassInput {
+				si.Inputs = append(si.Inputs, iv)
+			} else {
+				si.Outputs = append(si.Outputs, iv)
+			}
+
+		default:
+			if res, ok := r.resource(v, typ); ok {
+				si.Resources = append(si.Resources, res)
+			}
+		}
+	}
+
+	sortByLocation(si.Inputs)
+	sortByLocation(si.Outputs)
+
+	sort.SliceStable(si.Resources, func(i, j int) bool {
+		a, b := si.Resources[i], si.Resources[j]
+		if a.Set != b.Set {
+			return a.Set < b.Set
+		}
+		return a.Binding < b.Binding
+	})
+
+	return si
+}
+
+// builtIn adds the built-ins held by the given variable. Returns false if
+// it holds none.
+func (r *reflector) builtIn(si *ShaderInterface, v *OpVariable, typ *Type) bool {
+	if b, ok := r.decorations[v.ResultId][DecorationBuiltIn]; ok {
+		si.BuiltIns = append(si.BuiltIns, BuiltInVariable{
+			Id:           v.ResultId,
+			Name:         r.names[v.ResultId],
+			BuiltIn:      BuiltIn(b),
+			StorageClass: v.StorageClass,
+			Member:       -1,
+			Type:         typ,
+		})
+		return true
+	}
+
+	// Blocks like gl_PerVertex may be arrayed in some stages.
+	block := typ
+	for block != nil && (block.Kind == TypeArray || block.Kind == TypeRuntimeArray) {
+		block = block.Elem
+	}
+
+	if block == nil || block.Kind != TypeStruct || len(r.builtIns[block.Id]) == 0 {
+		return false
+	}
+
+	for i, member := range block.Members {
+		b, ok := r.builtIns[block.Id][uint32(i)]
+		if !ok {
+			continue
+		}
+
+		si.BuiltIns = append(si.BuiltIns, BuiltInVariable{
+			Id:           v.ResultId,
+			Name:         r.names[v.ResultId],
+			BuiltIn:      BuiltIn(b),
+			StorageClass: v.StorageClass,
+			Member:       i,
+			Type:         member,
+		})
+	}
+
+	return true
+}
+
+// memberLocations returns the member locations of the given block type,
+// or nil if none of its members have one.
+func (r *reflector) memberLocations(typ *Type) []int {
+	// Blocks may be arrayed in some stages.
+	block := typ
+	for block != nil && (block.Kind == TypeArray || block.Kind == TypeRuntimeArray) {
+		block = block.Elem
+	}
+
+	if block == nil || block.Kind != TypeStruct || len(r.locations[block.Id]) == 0 {
+		return nil
+	}
+
+	out := make([]int, len(block.Members))
+	for i := range out {
+		out[i] = -1
+		if loc, ok := r.locations[block.Id][uint32(i)]; ok {
+			out[i] = int(loc)
+		}
+	}
+
+	return out
+}
+
+// resource describes the given variable as a resource. Returns false if
+// it is not one.
+func (r *reflector) resource(v *OpVariable, typ *Type) (Resource, bool) {
+	res := Resource{
+		Id:           v.ResultId,
+		Name:         r.names[v.ResultId],
+		StorageClass: v.StorageClass,
+		Count:        1,
+		Type:         typ,
+	}
+
+	res.Set, res.HasSet = r.decorations[v.ResultId][DecorationDescriptorSet]
+	res.Binding, res.HasBinding = r.decorations[v.ResultId][DecorationBinding]
+
+	// Arrays of resources take one descriptor per element.
+	elem := typ
+	for elem != nil && (elem.Kind == TypeArray || elem.Kind == TypeRuntimeArray) {
+		if elem.Kind == TypeArray {
+			res.Count *= elem.Len
+		} else {
+			res.Count = 0
+		}
+		elem = elem.Elem
+	}
+
+	if elem == nil {
+		return res, false
+	}
+
+	switch v.StorageClass {
+	case StorageClassUniform:
+		if elem.Kind != TypeStruct {
+			break
+		}
+
+		if _, ok := r.decorations[elem.Id][DecorationBlock]; ok {
+			res.Kind = ResourceUniformBuffer
+		} else if _, ok := r.decorations[elem.Id][DecorationBufferBlock]; ok {
+			res.Kind = ResourceStorageBuffer
+		}
+
+	case StorageClassStorageBuffer:
+		res.Kind = ResourceStorageBuffer
+
+	case StorageClassPushConstant:
+		res.Kind = ResourcePushConstant
+		res.Set, res.Binding = 0, 0
+		res.HasSet, res.HasBinding = false, false
+
+	case StorageClassUniformConstant:
+		switch elem.Kind {
+		case TypeSampler:
+			res.Kind = ResourceSampler
+		case TypeSampledImage:
+			res.Kind = ResourceSampledImage
+		case TypeImage:
+			img := elem.Instruction.(*OpTypeImage)
+			switch {
+			case img.Dim == DimSubpassData:
+				res.Kind = ResourceInputAttachment
+			case img.Dim == DimBuffer && img.Sampled == 2:
+				res.Kind = ResourceStorageTexelBuffer
+			case img.Dim == DimBuffer:
+				res.Kind = ResourceUniformTexelBuffer
+			case img.Sampled == 2:
+				res.Kind = ResourceStorageImage
+			default:
+				res.Kind = ResourceImage
+			}
+		}
+	}
+
+	return res, res.Kind != ResourceUnknown
+}
+
+// staticUse returns the Ids referenced by the given function, and by all
+// functions it calls.
+func (r *reflector) staticUse(function Id) map[Id]bool {
+	used := make(map[Id]bool)
+	visited := map[Id]bool{function: true}
+	queue := []Id{function}
+
+	for len(queue) > 0 {
+		fn := r.functions[queue[0]]
+		queue = queue[1:]
+
+		for _, instr := range fn {
+			forEachId(instr, func(_ string, id *Id) {
+				used[*id] = true
+
+				if _, ok := r.functions[*id]; ok && !visited[*id] {
+					visited[*id] = true
+					queue = append(queue, *id)
+				}
+			})
+		}
+	}
+
+	return used
+}
+
+// sortByLocation sorts the given variables by location. Variables without
+// a location come last.
+func sortByLocation(list []InterfaceVariable) {
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i].Location, list[j].Location
+		if a < 0 || b < 0 {
+			return b < 0 && a >= 0
+		}
+		return a < b
+	})
+}
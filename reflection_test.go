@@ -0,0 +1,223 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestModuleReflect(t *testing.T) {
+	b := NewBuilder()
+	b.Capability(CapabilityShader)
+	b.MemoryModel(AddressingModelLogical, MemoryModelGLSL450)
+
+	void := b.TypeVoid()
+	fnType := b.TypeFunction(void)
+	float := b.TypeFloat(32)
+	uint := b.TypeInt(32, false)
+	vec2 := b.TypeVector(float, 2)
+	vec4 := b.TypeVector(float, 4)
+
+	// Stage inputs and outputs.
+	uv := b.Variable(b.TypePointer(StorageClassInput, vec2), StorageClassInput)
+	b.Decorate(uv, DecorationLocation, 1)
+	b.Name(uv, "uv")
+
+	pos := b.Variable(b.TypePointer(StorageClassInput, vec4), StorageClassInput)
+	b.Decorate(pos, DecorationLocation, 0)
+
+	index := b.Variable(b.TypePointer(StorageClassInput, uint), StorageClassInput)
+	b.Decorate(index, DecorationBuiltIn, BuiltInVertexIndex)
+
+	perVertex := b.TypeStruct(vec4, float)
+	b.Decorate(perVertex, DecorationBlock)
+	b.MemberDecorate(perVertex, 0, DecorationBuiltIn, BuiltInPosition)
+	b.MemberDecorate(perVertex, 1, DecorationBuiltIn, BuiltInPointSize)
+	out := b.Variable(b.TypePointer(StorageClassOutput, perVertex), StorageClassOutput)
+
+	color := b.Variable(b.TypePointer(StorageClassOutput, vec4), StorageClassOutput)
+
+	// Locations may be given to block members instead.
+	varyings := b.TypeStruct(vec2, float, vec4)
+	b.Decorate(varyings, DecorationBlock)
+	b.MemberDecorate(varyings, 0, DecorationLocation, 2)
+	b.MemberDecorate(varyings, 2, DecorationLocation, 3)
+	block := b.Variable(b.TypePointer(StorageClassOutput, varyings), StorageClassOutput)
+
+	// Resources.
+	ubo := b.TypeStruct(vec4)
+	b.Decorate(ubo, DecorationBlock)
+	uniforms := b.Variable(b.TypePointer(StorageClassUniform, ubo), StorageClassUniform)
+	b.Decorate(uniforms, DecorationDescriptorSet, 0)
+	b.Decorate(uniforms, DecorationBinding, 1)
+	b.Name(uniforms, "uniforms")
+
+	ssbo := b.TypeStruct(b.TypeRuntimeArray(vec4))
+	b.Decorate(ssbo, DecorationBufferBlock)
+	legacy := b.Variable(b.TypePointer(StorageClassUniform, ssbo), StorageClassUniform)
+	b.Decorate(legacy, DecorationDescriptorSet, 1)
+
+	samplers := b.TypeArray(b.Add(&OpTypeSampler{}), b.Constant(uint, 4))
+	sampler := b.Variable(b.TypePointer(StorageClassUniformConstant, samplers), StorageClassUniformConstant)
+	b.Decorate(sampler, DecorationBinding, 3)
+
+	image := b.Add(&OpTypeImage{SampledType: float, Dim: Dim2D, Sampled: 1, ImageFormat: ImageFormatUnknown})
+	sampledImage := b.Add(&OpTypeSampledImage{ImageType: image})
+	texture := b.Variable(b.TypePointer(StorageClassUniformConstant, sampledImage), StorageClassUniformConstant)
+
+	storage := b.Add(&OpTypeImage{SampledType: float, Dim: Dim2D, Sampled: 2, ImageFormat: ImageFormatRgba8})
+	storageImage := b.Variable(b.TypePointer(StorageClassUniformConstant, storage), StorageClassUniformConstant)
+	b.Decorate(storageImage, DecorationBinding, 2)
+
+	push := b.Variable(b.TypePointer(StorageClassPushConstant, ubo), StorageClassPushConstant)
+
+	// Uniform structs which are not blocks are no buffers.
+	plain := b.Variable(b.TypePointer(StorageClassUniform, b.TypeStruct(vec4)), StorageClassUniform)
+	b.Decorate(plain, DecorationDescriptorSet, 0)
+	b.Decorate(plain, DecorationBinding, 5)
+
+	// Texel buffers, which take their descriptor set from a group.
+	// Repeating a decoration with the same value is fine.
+	group := b.Add(&OpDecorationGroup{})
+	b.Decorate(group, DecorationDescriptorSet, 2)
+	b.Decorate(group, DecorationDescriptorSet, 2)
+
+	uniformTexels := b.Add(&OpTypeImage{SampledType: float, Dim: DimBuffer, Sampled: 1, ImageFormat: ImageFormatRgba32f})
+	uniformTexel := b.Variable(b.TypePointer(StorageClassUniformConstant, uniformTexels), StorageClassUniformConstant)
+	b.Decorate(uniformTexel, DecorationBinding, 0)
+
+	storageTexels := b.Add(&OpTypeImage{SampledType: float, Dim: DimBuffer, Sampled: 2, ImageFormat: ImageFormatRgba32f})
+	storageTexel := b.Variable(b.TypePointer(StorageClassUniformConstant, storageTexels), StorageClassUniformConstant)
+	b.Decorate(storageTexel, DecorationBinding, 1)
+
+	b.Add(&OpGroupDecorate{DecorationGroup: group, Targets: []Id{uniformTexel, storageTexel}})
+
+	// Not used by the entry point.
+	unused := b.Variable(b.TypePointer(StorageClassUniformConstant, storage), StorageClassUniformConstant)
+	b.Decorate(unused, DecorationBinding, 4)
+
+	// The legacy buffer is only used by a called function.
+	helper := b.Function(void, FunctionControlNone, fnType)
+	b.Label()
+	b.Load(ssbo, legacy)
+	b.Return()
+	b.FunctionEnd()
+
+	main := b.Function(void, FunctionControlNone, fnType)
+	b.Label()
+	b.FunctionCall(void, helper)
+	b.Load(ubo, uniforms)
+	b.Load(samplers, sampler)
+	b.Load(vec4, texture)
+	b.Load(vec4, storageImage)
+	b.Load(ubo, push)
+	b.Load(vec4, plain)
+	b.Load(uniformTexels, uniformTexel)
+	b.Load(storageTexels, storageTexel)
+	b.Return()
+	b.FunctionEnd()
+
+	b.EntryPoint(ExecutionModelVertex, main, "main", uv, pos, index, out, color, block)
+
+	have, err := b.Module().Reflect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	types := b.Module().Types()
+	typ := func(id Id) *Type {
+		t, _ := types.Type(id)
+		return t
+	}
+
+	want := []*ShaderInterface{{
+		EntryPoint:     main,
+		Name:           "main",
+		ExecutionModel: ExecutionModelVertex,
+		Inputs: []InterfaceVariable{
+			{Id: pos, Location: 0, Type: typ(vec4)},
+			{Id: uv, Name: "uv", Location: 1, Type: typ(vec2)},
+		},
+		Outputs: []InterfaceVariable{
+			{Id: color, Location: -1, Type: typ(vec4)},
+			{Id: block, Location: -1, Type: typ(varyings), MemberLocations: []int{2, -1, 3}},
+		},
+		Resources: []Resource{
+			{Id: texture, Kind: ResourceSampledImage, StorageClass: StorageClassUniformConstant, Count: 1, Type: typ(sampledImage)},
+			{Id: push, Kind: ResourcePushConstant, StorageClass: StorageClassPushConstant, Count: 1, Type: typ(ubo)},
+			{Id: uniforms, Name: "uniforms", Kind: ResourceUniformBuffer, StorageClass: StorageClassUniform, Binding: 1, HasSet: true, HasBinding: true, Count: 1, Type: typ(ubo)},
+			{Id: storageImage, Kind: ResourceStorageImage, StorageClass: StorageClassUniformConstant, Binding: 2, HasBinding: true, Count: 1, Type: typ(storage)},
+			{Id: sampler, Kind: ResourceSampler, StorageClass: StorageClassUniformConstant, Binding: 3, HasBinding: true, Count: 4, Type: typ(samplers)},
+			{Id: legacy, Kind: ResourceStorageBuffer, StorageClass: StorageClassUniform, Set: 1, HasSet: true, Count: 1, Type: typ(ssbo)},
+			{Id: uniformTexel, Kind: ResourceUniformTexelBuffer, StorageClass: StorageClassUniformConstant, Set: 2, Binding: 0, HasSet: true, HasBinding: true, Count: 1, Type: typ(uniformTexels)},
+			{Id: storageTexel, Kind: ResourceStorageTexelBuffer, StorageClass: StorageClassUniformConstant, Set: 2, Binding: 1, HasSet: true, HasBinding: true, Count: 1, Type: typ(storageTexels)},
+		},
+		BuiltIns: []BuiltInVariable{
+			{Id: index, BuiltIn: BuiltInVertexIndex, StorageClass: StorageClassInput, Member: -1, Type: typ(uint)},
+			{Id: out, BuiltIn: BuiltInPosition, StorageClass: StorageClassOutput, Member: 0, Type: typ(vec4)},
+			{Id: out, BuiltIn: BuiltInPointSize, StorageClass: StorageClassOutput, Member: 1, Type: typ(float)},
+		},
+	}}
+
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("interface mismatch:\nHave: %+v\nWant: %+v", have[0], want[0])
+	}
+}
+
+func TestModuleReflectPreRelease(t *testing.T) {
+	mod := NewModule()
+	mod.Header.Version = VersionPreRelease
+
+	if _, err := mod.Reflect(); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestModuleReflectConflict(t *testing.T) {
+	b := NewBuilder()
+	float := b.TypeFloat(32)
+	v := b.Variable(b.TypePointer(StorageClassInput, float), StorageClassInput)
+	b.Decorate(v, DecorationLocation, 0)
+	b.Decorate(v, DecorationLocation, 1)
+
+	if _, err := b.Module().Reflect(); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	// Member decorations can conflict through groups as well.
+	b = NewBuilder()
+	s := b.TypeStruct(b.TypeFloat(32))
+	group := b.Add(&OpDecorationGroup{})
+	b.Decorate(group, DecorationLocation, 2)
+	b.MemberDecorate(s, 0, DecorationLocation, 3)
+	b.Add(&OpGroupMemberDecorate{DecorationGroup: group, Targets: []uint32{uint32(s), 0}})
+
+	if _, err := b.Module().Reflect(); err == nil {
+		t.Fatal("expected an error for the member")
+	}
+}
+
+func TestModuleReflectRawFunction(t *testing.T) {
+	// Functions held as raw instructions are skipped.
+	mod := NewModule()
+	mod.Code = InstructionList{
+		&OpTypeVoid{ResultId: 1},
+		&OpTypeFunction{ResultId: 2, ReturnType: 1},
+		&OpEntryPoint{ExecutionModel: ExecutionModelVertex, EntryPoint: 3, Name: "main"},
+		&RawInstruction{Code: opcodeFunction, Argv: []uint32{1, 3, 0, 2}},
+		&OpLabel{ResultId: 4},
+		&OpReturn{},
+		&OpFunctionEnd{},
+	}
+
+	have, err := mod.Reflect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(have) != 1 || have[0].EntryPoint != 3 || len(have[0].Resources) != 0 {
+		t.Fatalf("interface mismatch: %+v", have)
+	}
+}
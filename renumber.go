@@ -0,0 +1,79 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import "errors"
+
+// Renumber assigns new, densely packed Ids to the module, in the order in
+// which the Ids are defined. Every result Id and every Id operand is
+// updated, including the targets of debug instructions and decorations.
+// Header.Bound is set to the new bound.
+//
+// Ids which are referenced, but never defined, are numbered after all
+// defined Ids, in order of first use.
+//
+// The returned map holds the new value for each old Id. The module is left
+// untouched if an error is returned. This happens if it holds instructions
+// whose Id operands can not be determined: raw instructions, OpExtInst
+// instructions from extended instruction sets which are not registered and
+// OpExtInst instructions whose operands do not match their instruction.
+func (m *Module) Renumber() (map[Id]Id, error) {
+	if m.Header.Version == VersionPreRelease {
+		return nil, errors.New("can not renumber pre-release modules")
+	}
+
+	sets := m.extInstSets()
+
+	for addr, instr := range m.Code {
+		switch v := instr.(type) {
+		case *RawInstruction:
+			return nil, NewLayoutError(addr,
+				"can not renumber raw instruction with opcode %d", v.Code)
+
+		case *OpExtInst:
+			set, ok := sets[v.Set]
+			if !ok && len(v.Operands) > 0 {
+				return nil, NewLayoutError(addr,
+					"can not renumber OpExtInst from an unregistered instruction set")
+			}
+
+			// Operands which can not be decoded would be left alone.
+			if ok {
+				if _, err := decodeExtInstOperands(set, v); err != nil {
+					return nil, NewLayoutError(addr, "can not renumber %v", err)
+				}
+			}
+		}
+	}
+
+	ids := make(map[Id]Id)
+	next := Id(1)
+
+	for _, instr := range m.Code {
+		id, ok := instructionResultId(instr)
+		if ok && id != 0 {
+			if _, ok := ids[id]; !ok {
+				ids[id] = next
+				next++
+			}
+		}
+	}
+
+	// Make room for Ids without a definition, before we change anything.
+	m.ForEachId(func(_ int, _ string, id *Id) {
+		if _, ok := ids[*id]; !ok && *id != 0 {
+			ids[*id] = next
+			next++
+		}
+	})
+
+	m.ForEachId(func(_ int, _ string, id *Id) {
+		if *id != 0 {
+			*id = ids[*id]
+		}
+	})
+
+	m.Header.Bound = uint32(next)
+	return ids, nil
+}
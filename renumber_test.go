@@ -0,0 +1,156 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestModuleRenumber(t *testing.T) {
+	available := MemoryAccess(MemoryAccessMakePointerAvailable)
+
+	mod := NewModule()
+	mod.Code = InstructionList{
+		&OpCapability{Capability: CapabilityShader},
+		&OpExtInstImport{ResultId: 40, Name: "Test.ext"},
+		&OpMemoryModel{},
+		&OpEntryPoint{ExecutionModel: ExecutionModelFragment, EntryPoint: 50, Name: "main"},
+		&OpName{Target: 50, Name: "main"},
+		&OpDecorate{Target: 30, Decoration: DecorationRelaxedPrecision},
+		&OpDecorationGroup{ResultId: 20},
+		&OpGroupDecorate{DecorationGroup: 20, Targets: []Id{30}},
+		&OpGroupMemberDecorate{DecorationGroup: 20, Targets: []uint32{35, 0}},
+		&OpTypeVoid{ResultId: 10},
+		&OpTypeFunction{ResultId: 11, ReturnType: 10},
+		&OpTypeInt{ResultId: 12, Width: 64},
+		&OpTypeStruct{ResultId: 35, Members: []Id{12}},
+		&OpConstant{ResultType: 12, ResultId: 30, Value: []uint32{1, 0}},
+		&OpFunction{ResultType: 10, ResultId: 50, FunctionType: 11},
+		&OpLabel{ResultId: 60},
+		&OpExtInst{ResultType: 12, ResultId: 61, Set: 40, Instruction: 1, Operands: []Id{30}},
+		&OpLoad{ResultType: 12, ResultId: 62, Pointer: 99, MemoryAccess: &available, Argv: []uint32{30}},
+		&OpSwitch{Selector: 30, Default: 70, Target: []uint32{5, 0, 70}},
+		&OpLabel{ResultId: 70},
+		&OpReturn{},
+		&OpFunctionEnd{},
+	}
+
+	have, err := mod.Renumber()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[Id]Id{
+		40: 1, 20: 2, 10: 3, 11: 4, 12: 5, 35: 6, 30: 7, 50: 8,
+		60: 9, 61: 10, 62: 11, 70: 12,
+		99: 13, // Never defined.
+	}
+
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("mapping mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+
+	wantCode := InstructionList{
+		&OpCapability{Capability: CapabilityShader},
+		&OpExtInstImport{ResultId: 1, Name: "Test.ext"},
+		&OpMemoryModel{},
+		&OpEntryPoint{ExecutionModel: ExecutionModelFragment, EntryPoint: 8, Name: "main"},
+		&OpName{Target: 8, Name: "main"},
+		&OpDecorate{Target: 7, Decoration: DecorationRelaxedPrecision},
+		&OpDecorationGroup{ResultId: 2},
+		&OpGroupDecorate{DecorationGroup: 2, Targets: []Id{7}},
+		&OpGroupMemberDecorate{DecorationGroup: 2, Targets: []uint32{6, 0}},
+		&OpTypeVoid{ResultId: 3},
+		&OpTypeFunction{ResultId: 4, ReturnType: 3},
+		&OpTypeInt{ResultId: 5, Width: 64},
+		&OpTypeStruct{ResultId: 6, Members: []Id{5}},
+		&OpConstant{ResultType: 5, ResultId: 7, Value: []uint32{1, 0}},
+		&OpFunction{ResultType: 3, ResultId: 8, FunctionType: 4},
+		&OpLabel{ResultId: 9},
+		&OpExtInst{ResultType: 5, ResultId: 10, Set: 1, Instruction: 1, Operands: []Id{7}},
+		&OpLoad{ResultType: 5, ResultId: 11, Pointer: 13, MemoryAccess: &available, Argv: []uint32{7}},
+		&OpSwitch{Selector: 7, Default: 12, Target: []uint32{5, 0, 12}},
+		&OpLabel{ResultId: 12},
+		&OpReturn{},
+		&OpFunctionEnd{},
+	}
+
+	if !reflect.DeepEqual(mod.Code, wantCode) {
+		for i := range wantCode {
+			if !reflect.DeepEqual(mod.Code[i], wantCode[i]) {
+				t.Fatalf("instruction %d mismatch:\nHave: %+v\nWant: %+v", i, mod.Code[i], wantCode[i])
+			}
+		}
+	}
+
+	if mod.Header.Bound != 14 {
+		t.Fatalf("bound mismatch:\nHave: %d\nWant: %d", mod.Header.Bound, 14)
+	}
+}
+
+func TestModuleRenumberUnknown(t *testing.T) {
+	for _, code := range []InstructionList{
+		{
+			&OpTypeVoid{ResultId: 5},
+			&RawInstruction{Code: 0x1234, Argv: []uint32{5}},
+		},
+		{
+			&OpExtInstImport{ResultId: 1, Name: "Unknown.ext"},
+			&OpExtInst{ResultType: 2, ResultId: 3, Set: 1, Instruction: 1, Operands: []Id{4}},
+		},
+		{
+			// Operands which do not match the instruction.
+			&OpExtInstImport{ResultId: 1, Name: "Test.ext"},
+			&OpExtInst{ResultType: 2, ResultId: 3, Set: 1, Instruction: 1, Operands: []Id{4, 5}},
+		},
+	} {
+		mod := NewModule()
+		mod.Code = code
+
+		_, err := mod.Renumber()
+		if err == nil {
+			t.Fatalf("expected failure")
+		}
+
+		if !reflect.DeepEqual(mod.Code, code) || mod.Header.Bound != 0 {
+			t.Fatalf("module was modified")
+		}
+	}
+}
+
+func TestModuleRenumberSpecConstantOp(t *testing.T) {
+	// The literal indices equal a live Id, and must be left alone.
+	mod := NewModule()
+	mod.Code = InstructionList{
+		&OpTypeInt{ResultId: 10, Width: 32},
+		&OpTypeVector{ResultId: 11, ComponentType: 10, ComponentCount: 2},
+		&OpSpecConstant{ResultType: 10, ResultId: 1, Value: []uint32{3}},
+		&OpSpecConstantComposite{ResultType: 11, ResultId: 20, Constituents: []Id{1, 1}},
+		&OpSpecConstantOp{ResultType: 10, ResultId: 21, Operation: opcodeCompositeExtract, Operands: []Id{20, 1}},
+		&OpSpecConstantOp{ResultType: 11, ResultId: 22, Operation: opcodeVectorShuffle, Operands: []Id{20, 1, 1, 0}},
+	}
+
+	_, err := mod.Renumber()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := InstructionList{
+		&OpTypeInt{ResultId: 1, Width: 32},
+		&OpTypeVector{ResultId: 2, ComponentType: 1, ComponentCount: 2},
+		&OpSpecConstant{ResultType: 1, ResultId: 3, Value: []uint32{3}},
+		&OpSpecConstantComposite{ResultType: 2, ResultId: 4, Constituents: []Id{3, 3}},
+		&OpSpecConstantOp{ResultType: 1, ResultId: 5, Operation: opcodeCompositeExtract, Operands: []Id{4, 1}},
+		&OpSpecConstantOp{ResultType: 2, ResultId: 6, Operation: opcodeVectorShuffle, Operands: []Id{4, 3, 1, 0}},
+	}
+
+	if !reflect.DeepEqual(mod.Code, want) {
+		t.Fatalf("code mismatch:\nHave: %v\nWant: %v", mod.Code, want)
+	}
+
+	if mod.Header.Bound != 7 {
+		t.Fatalf("bound mismatch:\nHave: %d\nWant: %d", mod.Header.Bound, 7)
+	}
+}
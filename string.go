@@ -20,27 +20,26 @@ type String string
 
 // DecodeString reads the Go string from the given slice of words.
 // Refer to fromGoString() documentation for details on the expected encoding.
+//
+// Decoding stops at the nul-terminator, so any words following the
+// string are left alone. This allows string literals to be followed
+// by other operands, as is the case for OpEntryPoint.
 func DecodeString(words []uint32) String {
 	out := make([]byte, 0, len(words)*4)
 
-	// Read words as bytes.
+	// Read words as bytes, up to the nul-terminator.
 	for _, w := range words {
-		out = append(
-			out,
-			byte(w),
-			byte(w>>8),
-			byte(w>>16),
-			byte(w>>24),
-		)
-	}
+		for shift := uint(0); shift < 32; shift += 8 {
+			b := byte(w >> shift)
+			if b == 0 {
+				return String(out)
+			}
 
-	// Remove any trailing nul bytes.
-	sz := len(out)
-	for sz > 0 && out[sz-1] == 0 {
-		sz--
+			out = append(out, b)
+		}
 	}
 
-	return String(out[:sz])
+	return String(out)
 }
 
 // EncodedLen returns the number of words occupied by the string, once encoded.
@@ -50,3 +50,13 @@ func TestString(t *testing.T) {
 		}
 	}
 }
+
+func TestDecodeStringTrailingWords(t *testing.T) {
+	// Words following the nul-terminator belong to other operands.
+	have := DecodeString([]uint32{0x6e69616d, 0, 1, 2})
+	want := String("main")
+
+	if have != want {
+		t.Fatalf("DecodeString mismatch:\nHave: %q\nWant: %q", have, want)
+	}
+}
@@ -0,0 +1,133 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+// verifyStructuredFlow checks the rules for structured control flow,
+// as defined in chapter 2.11 of the specification:
+//
+//   - A merge instruction must immediately precede the branch
+//     instruction ending its block.
+//   - A block can be the merge block of at most one header.
+//   - A header must dominate its merge block and continue target,
+//     unless these are unreachable.
+//   - In modules declaring the Shader capability, back edges may only
+//     target loop headers.
+//
+// Only the first problem in each function is reported, as later ones
+// are likely to follow from it.
+func (m *Module) verifyStructuredFlow(v *verifier) {
+	cfgs, err := m.CFGs()
+	if err != nil {
+		v.report(err)
+		return
+	}
+
+	var shader bool
+	for _, instr := range m.Code.Filter(opcodeCapability) {
+		if c, ok := instr.(*OpCapability); ok && c.Capability == CapabilityShader {
+			shader = true
+			break
+		}
+	}
+
+	for _, cfg := range cfgs {
+		err := m.verifyConstructs(cfg, shader)
+		if err != nil && !v.report(err) {
+			return
+		}
+	}
+}
+
+// verifyConstructs checks the structured control flow of one function.
+func (m *Module) verifyConstructs(cfg *CFG, shader bool) error {
+	dom := cfg.Dominators()
+	merges := make(map[Id]Id)      // Merge block -> header.
+	loops := make(map[*Block]bool) // Loop headers.
+
+	for _, b := range cfg.Blocks {
+		for addr := b.Start + 1; addr < b.End; addr++ {
+			var targets []Id
+
+			switch v := m.Code[addr].(type) {
+			case *OpSelectionMerge:
+				targets = []Id{v.MergeBlock}
+			case *OpLoopMerge:
+				targets = []Id{v.MergeBlock, v.ContinueTarget}
+				loops[b] = true
+			default:
+				continue
+			}
+
+			instr := m.Code[addr]
+			name := instructionName(instr)
+
+			if addr != b.End-1 {
+				return NewLayoutError(addr, "%s must immediately precede the branch ending block %d",
+					name, b.Label)
+			}
+
+			if !isMergeBranch(instr, m.Code[b.End]) {
+				return NewLayoutError(addr, "%s can not precede %s",
+					name, instructionName(m.Code[b.End]))
+			}
+
+			if header, ok := merges[targets[0]]; ok {
+				return NewLayoutError(addr, "%s: block %d is already the merge block of header %d",
+					name, targets[0], header)
+			}
+
+			merges[targets[0]] = b.Label
+
+			for _, label := range targets {
+				target, ok := cfg.Block(label)
+				if !ok {
+					return NewLayoutError(addr, "%s: %d is not a block in this function", name, label)
+				}
+
+				if dom.Contains(target) && !dom.Dominates(b, target) {
+					return NewLayoutError(addr, "%s: header %d does not dominate block %d",
+						name, b.Label, label)
+				}
+			}
+		}
+	}
+
+	if !shader {
+		return nil
+	}
+
+	// A back edge is an edge to a block which dominates its source.
+	for _, b := range cfg.Blocks {
+		if !dom.Contains(b) {
+			continue
+		}
+
+		for _, s := range b.Succs {
+			if dom.Dominates(s, b) && !loops[s] {
+				return NewLayoutError(b.End, "%s: back edge to block %d, which is not a loop header",
+					instructionName(m.Code[b.End]), s.Label)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isMergeBranch returns true if the given merge instruction may precede
+// the terminator. OpSelectionMerge is followed by a conditional branch or
+// switch, OpLoopMerge by a branch or conditional branch.
+func isMergeBranch(merge, term Instruction) bool {
+	switch term.(type) {
+	case *OpBranchConditional:
+		return true
+	case *OpSwitch:
+		_, ok := merge.(*OpSelectionMerge)
+		return ok
+	case *OpBranch:
+		_, ok := merge.(*OpLoopMerge)
+		return ok
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestModuleVerifyStructuredFlow(t *testing.T) {
+	// Each case edits a copy of cfgCode. Addresses in the expected errors
+	// are offset by one in cases which prepend OpCapability.
+	for i, st := range []struct {
+		shader bool
+		edit   func(code InstructionList) InstructionList
+		want   error
+	}{
+		{
+			shader: true,
+			edit:   func(code InstructionList) InstructionList { return code },
+		},
+		{
+			// Merge instruction does not precede the branch.
+			edit: func(code InstructionList) InstructionList {
+				code = append(code[:14], append(InstructionList{&OpNop{}}, code[14:]...)...)
+				return code
+			},
+			want: NewLayoutError(13, "%s must immediately precede the branch ending block %d",
+				"OpSelectionMerge", 12),
+		},
+		{
+			// OpSelectionMerge followed by an unconditional branch.
+			edit: func(code InstructionList) InstructionList {
+				code[14] = &OpBranch{TargetLabel: 13}
+				return code
+			},
+			want: NewLayoutError(13, "%s can not precede %s", "OpSelectionMerge", "OpBranch"),
+		},
+		{
+			// Merge block shared by two headers.
+			edit: func(code InstructionList) InstructionList {
+				code[13] = &OpSelectionMerge{MergeBlock: 16}
+				return code
+			},
+			want: NewLayoutError(13, "%s: block %d is already the merge block of header %d",
+				"OpSelectionMerge", 16, 11),
+		},
+		{
+			// Header does not dominate its continue target.
+			edit: func(code InstructionList) InstructionList {
+				code[10] = &OpLoopMerge{MergeBlock: 16, ContinueTarget: 10}
+				return code
+			},
+			want: NewLayoutError(10, "%s: header %d does not dominate block %d",
+				"OpLoopMerge", 11, 10),
+		},
+		{
+			// Merge block outside of the function.
+			edit: func(code InstructionList) InstructionList {
+				code[13] = &OpSelectionMerge{MergeBlock: 99}
+				return code
+			},
+			want: NewLayoutError(13, "%s: %d is not a block in this function",
+				"OpSelectionMerge", 99),
+		},
+		{
+			// Back edge to a block which is not a loop header.
+			shader: true,
+			edit: func(code InstructionList) InstructionList {
+				code[10] = &OpNop{}
+				return code
+			},
+			want: NewLayoutError(17, "%s: back edge to block %d, which is not a loop header",
+				"OpBranch", 11),
+		},
+		{
+			// Unstructured loops are allowed without the Shader capability.
+			edit: func(code InstructionList) InstructionList {
+				code[10] = &OpNop{}
+				return code
+			},
+		},
+	} {
+		mod := NewModule()
+		mod.Code = st.edit(append(InstructionList{}, cfgCode...))
+
+		if st.shader {
+			mod.Code = append(InstructionList{&OpCapability{Capability: CapabilityShader}}, mod.Code...)
+		}
+
+		have := mod.check((*Module).verifyStructuredFlow)
+		if !reflect.DeepEqual(have, st.want) {
+			t.Fatalf("case %d: error mismatch:\nHave: %v\nWant: %v", i, have, st.want)
+		}
+	}
+}
@@ -0,0 +1,581 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// typeRule checks the types of an instruction's ResultType and operands.
+type typeRule func(c *typeChecker)
+
+// typeRules holds the type rules of arithmetic, relational and conversion
+// instructions, as stated in their descriptions in the specification.
+var typeRules = map[uint32]typeRule{
+	// Arithmetic instructions.
+	opcodeSNegate:           intArith(false, "Operand"),
+	opcodeFNegate:           floatArith("Operand"),
+	opcodeIAdd:              intArith(false, "Operand1", "Operand2"),
+	opcodeFAdd:              floatArith("Operand1", "Operand2"),
+	opcodeISub:              intArith(false, "Operand1", "Operand2"),
+	opcodeFSub:              floatArith("Operand1", "Operand2"),
+	opcodeIMul:              intArith(false, "Operand1", "Operand2"),
+	opcodeFMul:              floatArith("Operand1", "Operand2"),
+	opcodeUDiv:              intArith(true, "Operand1", "Operand2"),
+	opcodeSDiv:              intArith(false, "Operand1", "Operand2"),
+	opcodeFDiv:              floatArith("Operand1", "Operand2"),
+	opcodeUMod:              intArith(true, "Operand1", "Operand2"),
+	opcodeSRem:              intArith(false, "Operand1", "Operand2"),
+	opcodeSMod:              intArith(false, "Operand1", "Operand2"),
+	opcodeFRem:              floatArith("Operand1", "Operand2"),
+	opcodeFMod:              floatArith("Operand1", "Operand2"),
+	opcodeVectorTimesScalar: checkVectorTimesScalar,
+	opcodeMatrixTimesScalar: checkMatrixTimesScalar,
+	opcodeVectorTimesMatrix: checkVectorTimesMatrix,
+	opcodeMatrixTimesVector: checkMatrixTimesVector,
+	opcodeMatrixTimesMatrix: checkMatrixTimesMatrix,
+	opcodeOuterProduct:      checkOuterProduct,
+	opcodeDot:               checkDot,
+	opcodeIAddCarry:         extendedArith(true),
+	opcodeISubBorrow:        extendedArith(true),
+	opcodeUMulExtended:      extendedArith(true),
+	opcodeSMulExtended:      extendedArith(false),
+
+	// Conversion instructions.
+	opcodeConvertFToU:    convert(TypeFloat, TypeInt, true, "FloatValue"),
+	opcodeConvertFToS:    convert(TypeFloat, TypeInt, false, "FloatValue"),
+	opcodeConvertSToF:    convert(TypeInt, TypeFloat, false, "SignedValue"),
+	opcodeConvertUToF:    convert(TypeInt, TypeFloat, false, "UnsignedValue"),
+	opcodeUConvert:       resize(TypeInt, true, "UnsignedValue"),
+	opcodeSConvert:       resize(TypeInt, false, "SignedValue"),
+	opcodeFConvert:       resize(TypeFloat, false, "FloatValue"),
+	opcodeQuantizeToF16:  checkQuantizeToF16,
+	opcodeSatConvertSToU: convert(TypeInt, TypeInt, false, "SignedValue"),
+	opcodeSatConvertUToS: convert(TypeInt, TypeInt, false, "UnsignedValue"),
+	opcodeBitcast:        checkBitcast,
+
+	// Relational and logical instructions.
+	opcodeAny:                    checkAnyAll,
+	opcodeAll:                    checkAnyAll,
+	opcodeIsNan:                  compare(TypeFloat, "X"),
+	opcodeIsInf:                  compare(TypeFloat, "X"),
+	opcodeIsFinite:               compare(TypeFloat, "X"),
+	opcodeIsNormal:               compare(TypeFloat, "X"),
+	opcodeSignBitSet:             compare(TypeFloat, "X"),
+	opcodeLessOrGreater:          compare(TypeFloat, "X", "Y"),
+	opcodeOrdered:                compare(TypeFloat, "X", "Y"),
+	opcodeUnordered:              compare(TypeFloat, "X", "Y"),
+	opcodeLogicalEqual:           logical("Operand1", "Operand2"),
+	opcodeLogicalNotEqual:        logical("Operand1", "Operand2"),
+	opcodeLogicalOr:              logical("Operand1", "Operand2"),
+	opcodeLogicalAnd:             logical("Operand1", "Operand2"),
+	opcodeLogicalNot:             logical("Operand"),
+	opcodeSelect:                 checkSelect,
+	opcodeIEqual:                 compare(TypeInt, "Operand1", "Operand2"),
+	opcodeINotEqual:              compare(TypeInt, "Operand1", "Operand2"),
+	opcodeUGreaterThan:           compare(TypeInt, "Operand1", "Operand2"),
+	opcodeSGreaterThan:           compare(TypeInt, "Operand1", "Operand2"),
+	opcodeUGreaterThanEqual:      compare(TypeInt, "Operand1", "Operand2"),
+	opcodeSGreaterThanEqual:      compare(TypeInt, "Operand1", "Operand2"),
+	opcodeULessThan:              compare(TypeInt, "Operand1", "Operand2"),
+	opcodeSLessThan:              compare(TypeInt, "Operand1", "Operand2"),
+	opcodeULessThanEqual:         compare(TypeInt, "Operand1", "Operand2"),
+	opcodeSLessThanEqual:         compare(TypeInt, "Operand1", "Operand2"),
+	opcodeFOrdEqual:              compare(TypeFloat, "Operand1", "Operand2"),
+	opcodeFUnordEqual:            compare(TypeFloat, "Operand1", "Operand2"),
+	opcodeFOrdNotEqual:           compare(TypeFloat, "Operand1", "Operand2"),
+	opcodeFUnordNotEqual:         compare(TypeFloat, "Operand1", "Operand2"),
+	opcodeFOrdLessThan:           compare(TypeFloat, "Operand1", "Operand2"),
+	opcodeFUnordLessThan:         compare(TypeFloat, "Operand1", "Operand2"),
+	opcodeFOrdGreaterThan:        compare(TypeFloat, "Operand1", "Operand2"),
+	opcodeFUnordGreaterThan:      compare(TypeFloat, "Operand1", "Operand2"),
+	opcodeFOrdLessThanEqual:      compare(TypeFloat, "Operand1", "Operand2"),
+	opcodeFUnordLessThanEqual:    compare(TypeFloat, "Operand1", "Operand2"),
+	opcodeFOrdGreaterThanEqual:   compare(TypeFloat, "Operand1", "Operand2"),
+	opcodeFUnordGreaterThanEqual: compare(TypeFloat, "Operand1", "Operand2"),
+}
+
+// verifyOperandTypes resolves the types of the ResultType and operands
+// of arithmetic, relational and conversion instructions, and checks them
+// against the rules of each instruction.
+//
+// Operands whose type is not known, like those referring to undefined
+// Ids, are skipped; they are caught by other checks.
+func (m *Module) verifyOperandTypes(v *verifier) {
+	var tt *TypeTable
+
+	for addr, instr := range m.Code {
+		rule, ok := typeRules[instr.Opcode()]
+		if !ok {
+			continue
+		}
+
+		if _, ok := instr.(*RawInstruction); ok {
+			continue
+		}
+
+		rv := reflect.ValueOf(instr)
+		if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+			continue
+		}
+
+		if tt == nil {
+			tt = m.Types()
+		}
+
+		c := &typeChecker{tt: tt, instr: rv.Elem(), name: instructionName(instr)}
+		c.result, _ = tt.Type(c.id("ResultType"))
+
+		if c.result == nil {
+			continue
+		}
+
+		rule(c)
+
+		if c.err != nil && !v.report(NewLayoutError(addr, "%v", c.err)) {
+			return
+		}
+	}
+}
+
+// typeChecker checks the types of a single instruction. It records the
+// first problem it finds; later checks are skipped.
+type typeChecker struct {
+	tt     *TypeTable
+	instr  reflect.Value
+	name   string
+	result *Type // Type named by ResultType.
+	err    error
+}
+
+// id returns the value of the named Id field.
+func (c *typeChecker) id(field string) Id {
+	f := c.instr.FieldByName(field)
+	if !f.IsValid() {
+		return 0
+	}
+
+	id, _ := f.Interface().(Id)
+	return id
+}
+
+// operand returns the type of the named operand, or nil if it is not known
+// or an earlier check failed.
+func (c *typeChecker) operand(field string) *Type {
+	if c.err != nil {
+		return nil
+	}
+
+	t, _ := c.tt.TypeOf(c.id(field))
+	return t
+}
+
+func (c *typeChecker) fail(field string, msg string, argv ...interface{}) {
+	if c.err == nil {
+		c.err = fmt.Errorf("%s: %s(%d) %s", c.name, field, c.id(field), fmt.Sprintf(msg, argv...))
+	}
+}
+
+// numeric checks that t is a scalar or vector of the given kind. If count
+// is larger than zero, t must have that many components.
+func (c *typeChecker) numeric(field string, t *Type, kind TypeKind, count int) bool {
+	if t == nil || c.err != nil {
+		return false
+	}
+
+	if !isScalarOrVector(t, kind) {
+		c.fail(field, "must be a scalar or vector of %s type; have %v", kind, t)
+		return false
+	}
+
+	if count > 0 && components(t) != count {
+		c.fail(field, "must have %d component(s); have %v", count, t)
+		return false
+	}
+
+	return true
+}
+
+// same checks that t is the type want.
+func (c *typeChecker) same(field string, t, want *Type) {
+	if t != nil && want != nil && c.err == nil && t != want {
+		c.fail(field, "must have type %v; have %v", want, t)
+	}
+}
+
+// width checks that the component width of t equals that of want.
+func (c *typeChecker) width(field string, t, want *Type) {
+	if t == nil || want == nil || c.err != nil {
+		return
+	}
+
+	if t.Scalar().Width != want.Scalar().Width {
+		c.fail(field, "must have the same component width as %v; have %v", want, t)
+	}
+}
+
+// unsigned checks that the components of t are unsigned integers.
+func (c *typeChecker) unsigned(field string, t *Type) {
+	if t != nil && c.err == nil && t.Scalar().Signed {
+		c.fail(field, "must have unsigned components; have %v", t)
+	}
+}
+
+// isScalarOrVector returns true if t is a scalar, or a vector of scalars,
+// of the given kind.
+func isScalarOrVector(t *Type, kind TypeKind) bool {
+	if t.Kind == TypeVector {
+		t = t.Elem
+	}
+	return t != nil && t.Kind == kind
+}
+
+// components returns the number of components of a scalar or vector.
+func components(t *Type) int {
+	if t.Kind == TypeVector {
+		return t.Len
+	}
+	return 1
+}
+
+// intArith checks integer arithmetic: the operands have the same number
+// of components and the same component width as the result.
+func intArith(unsigned bool, fields ...string) typeRule {
+	return func(c *typeChecker) {
+		if !c.numeric("ResultType", c.result, TypeInt, 0) {
+			return
+		}
+
+		if unsigned {
+			c.unsigned("ResultType", c.result)
+		}
+
+		for _, f := range fields {
+			t := c.operand(f)
+			c.numeric(f, t, TypeInt, components(c.result))
+			c.width(f, t, c.result)
+		}
+	}
+}
+
+// floatArith checks floating-point arithmetic: the operands have the same
+// type as the result.
+func floatArith(fields ...string) typeRule {
+	return func(c *typeChecker) {
+		if !c.numeric("ResultType", c.result, TypeFloat, 0) {
+			return
+		}
+
+		for _, f := range fields {
+			c.same(f, c.operand(f), c.result)
+		}
+	}
+}
+
+// extendedArith checks arithmetic with a struct result of two members,
+// which have the same integer type as the operands.
+func extendedArith(unsigned bool) typeRule {
+	return func(c *typeChecker) {
+		r := c.result
+		if r.Kind != TypeStruct || len(r.Members) != 2 || r.Members[0] != r.Members[1] {
+			c.fail("ResultType", "must be a struct of two members of the same type; have %v", r)
+			return
+		}
+
+		if !c.numeric("ResultType", r.Members[0], TypeInt, 0) {
+			return
+		}
+
+		if unsigned {
+			c.unsigned("ResultType", r.Members[0])
+		}
+
+		c.same("Operand1", c.operand("Operand1"), r.Members[0])
+		c.same("Operand2", c.operand("Operand2"), r.Members[0])
+	}
+}
+
+func checkVectorTimesScalar(c *typeChecker) {
+	if c.result.Kind != TypeVector || !isScalarOrVector(c.result, TypeFloat) {
+		c.fail("ResultType", "must be a vector of float type; have %v", c.result)
+		return
+	}
+
+	c.same("Vector", c.operand("Vector"), c.result)
+	c.same("Scalar", c.operand("Scalar"), c.result.Elem)
+}
+
+func checkMatrixTimesScalar(c *typeChecker) {
+	if !isFloatMatrix(c.result) {
+		c.fail("ResultType", "must be a matrix of float type; have %v", c.result)
+		return
+	}
+
+	c.same("Matrix", c.operand("Matrix"), c.result)
+	c.same("Scalar", c.operand("Scalar"), c.result.Scalar())
+}
+
+func checkVectorTimesMatrix(c *typeChecker) {
+	if c.result.Kind != TypeVector || !isScalarOrVector(c.result, TypeFloat) {
+		c.fail("ResultType", "must be a vector of float type; have %v", c.result)
+		return
+	}
+
+	m := c.operand("Matrix")
+	if m == nil {
+		return
+	}
+
+	if !isFloatMatrix(m) || m.Scalar() != c.result.Elem {
+		c.fail("Matrix", "must be a matrix of %v; have %v", c.result.Elem, m)
+		return
+	}
+
+	if m.Len != c.result.Len {
+		c.fail("Matrix", "must have %d column(s); have %v", c.result.Len, m)
+		return
+	}
+
+	c.same("Vector", c.operand("Vector"), m.Elem)
+}
+
+func checkMatrixTimesVector(c *typeChecker) {
+	if c.result.Kind != TypeVector || !isScalarOrVector(c.result, TypeFloat) {
+		c.fail("ResultType", "must be a vector of float type; have %v", c.result)
+		return
+	}
+
+	m := c.operand("Matrix")
+	if m == nil {
+		return
+	}
+
+	if !isFloatMatrix(m) || m.Elem != c.result {
+		c.fail("Matrix", "must have columns of type %v; have %v", c.result, m)
+		return
+	}
+
+	t := c.operand("Vector")
+	if c.numeric("Vector", t, TypeFloat, m.Len) && t.Scalar() != c.result.Elem {
+		c.fail("Vector", "must have components of type %v; have %v", c.result.Elem, t)
+	}
+}
+
+func checkMatrixTimesMatrix(c *typeChecker) {
+	if !isFloatMatrix(c.result) {
+		c.fail("ResultType", "must be a matrix of float type; have %v", c.result)
+		return
+	}
+
+	left := c.operand("LeftMatrix")
+	if left != nil && (!isFloatMatrix(left) || left.Elem != c.result.Elem) {
+		c.fail("LeftMatrix", "must have columns of type %v; have %v", c.result.Elem, left)
+		return
+	}
+
+	right := c.operand("RightMatrix")
+	if right == nil {
+		return
+	}
+
+	if !isFloatMatrix(right) || right.Len != c.result.Len {
+		c.fail("RightMatrix", "must be a matrix with %d column(s); have %v", c.result.Len, right)
+		return
+	}
+
+	if right.Scalar() != c.result.Scalar() {
+		c.fail("RightMatrix", "must have components of type %v; have %v", c.result.Scalar(), right)
+		return
+	}
+
+	if left != nil && right.Elem.Len != left.Len {
+		c.fail("RightMatrix", "must have %d row(s); have %v", left.Len, right)
+	}
+}
+
+func checkOuterProduct(c *typeChecker) {
+	if !isFloatMatrix(c.result) {
+		c.fail("ResultType", "must be a matrix of float type; have %v", c.result)
+		return
+	}
+
+	c.same("Vector1", c.operand("Vector1"), c.result.Elem)
+
+	t := c.operand("Vector2")
+	if c.numeric("Vector2", t, TypeFloat, c.result.Len) && t.Scalar() != c.result.Scalar() {
+		c.fail("Vector2", "must have components of type %v; have %v", c.result.Scalar(), t)
+	}
+}
+
+func checkDot(c *typeChecker) {
+	if c.result.Kind != TypeFloat {
+		c.fail("ResultType", "must be a float scalar; have %v", c.result)
+		return
+	}
+
+	v1 := c.operand("Vector1")
+	if v1 != nil && (v1.Kind != TypeVector || v1.Elem != c.result) {
+		c.fail("Vector1", "must be a vector of %v; have %v", c.result, v1)
+		return
+	}
+
+	c.same("Vector2", c.operand("Vector2"), v1)
+}
+
+// convert checks conversions between scalars or vectors of different
+// kinds. The value has the same number of components as the result.
+func convert(from, to TypeKind, unsigned bool, field string) typeRule {
+	return func(c *typeChecker) {
+		if !c.numeric("ResultType", c.result, to, 0) {
+			return
+		}
+
+		if unsigned {
+			c.unsigned("ResultType", c.result)
+		}
+
+		c.numeric(field, c.operand(field), from, components(c.result))
+	}
+}
+
+// resize checks conversions which change the component width. The value
+// has the same number of components as the result.
+func resize(kind TypeKind, unsigned bool, field string) typeRule {
+	return func(c *typeChecker) {
+		if !c.numeric("ResultType", c.result, kind, 0) {
+			return
+		}
+
+		if unsigned {
+			c.unsigned("ResultType", c.result)
+		}
+
+		t := c.operand(field)
+		if c.numeric(field, t, kind, components(c.result)) && t.Scalar().Width == c.result.Scalar().Width {
+			c.fail(field, "must have a different component width than %v; have %v", c.result, t)
+		}
+	}
+}
+
+func checkQuantizeToF16(c *typeChecker) {
+	if !c.numeric("ResultType", c.result, TypeFloat, 0) {
+		return
+	}
+
+	if c.result.Scalar().Width != 32 {
+		c.fail("ResultType", "must have 32-bit float components; have %v", c.result)
+		return
+	}
+
+	c.same("Value", c.operand("Value"), c.result)
+}
+
+// checkBitcast checks that numerical scalars and vectors are cast to types
+// with the same number of bits. Pointers are not checked.
+func checkBitcast(c *typeChecker) {
+	t := c.operand("Operand")
+	if t == nil {
+		return
+	}
+
+	rbits, rok := bitSize(c.result)
+	obits, ook := bitSize(t)
+
+	if rok && ook && rbits != obits {
+		c.fail("Operand", "must have the same number of bits as %v; have %v", c.result, t)
+	}
+}
+
+func checkAnyAll(c *typeChecker) {
+	if c.result.Kind != TypeBool {
+		c.fail("ResultType", "must be a bool scalar; have %v", c.result)
+		return
+	}
+
+	if t := c.operand("Vector"); t != nil && (t.Kind != TypeVector || t.Elem == nil || t.Elem.Kind != TypeBool) {
+		c.fail("Vector", "must be a vector of bool type; have %v", t)
+	}
+}
+
+// compare checks comparisons: the result is a bool scalar or vector, with
+// the same number of components as the operands. Integer operands must
+// have the same component width, float operands the same type.
+func compare(kind TypeKind, fields ...string) typeRule {
+	return func(c *typeChecker) {
+		if !c.numeric("ResultType", c.result, TypeBool, 0) {
+			return
+		}
+
+		var first *Type
+
+		for _, f := range fields {
+			t := c.operand(f)
+			if !c.numeric(f, t, kind, components(c.result)) {
+				continue
+			}
+
+			if first == nil {
+				first = t
+				continue
+			}
+
+			if kind == TypeInt {
+				c.width(f, t, first)
+			} else {
+				c.same(f, t, first)
+			}
+		}
+	}
+}
+
+// logical checks logical operations: the operands have the same type as
+// the result, which is a bool scalar or vector.
+func logical(fields ...string) typeRule {
+	return func(c *typeChecker) {
+		if !c.numeric("ResultType", c.result, TypeBool, 0) {
+			return
+		}
+
+		for _, f := range fields {
+			c.same(f, c.operand(f), c.result)
+		}
+	}
+}
+
+func checkSelect(c *typeChecker) {
+	c.same("Object1", c.operand("Object1"), c.result)
+	c.same("Object2", c.operand("Object2"), c.result)
+
+	cond := c.operand("Condition")
+	if cond == nil || c.err != nil {
+		return
+	}
+
+	if cond.Kind == TypeBool {
+		return
+	}
+
+	if c.result.Kind != TypeVector {
+		c.fail("Condition", "must be a bool scalar; have %v", cond)
+		return
+	}
+
+	c.numeric("Condition", cond, TypeBool, c.result.Len)
+}
+
+func isFloatMatrix(t *Type) bool {
+	return t.Kind == TypeMatrix && t.Elem != nil && t.Elem.Kind == TypeVector &&
+		t.Scalar() != nil && t.Scalar().Kind == TypeFloat
+}
+
+// bitSize returns the total number of bits of a numerical scalar or vector.
+func bitSize(t *Type) (int, bool) {
+	s := t.Scalar()
+	if s == nil || s.Kind == TypeBool || t.Kind == TypeMatrix {
+		return 0, false
+	}
+
+	return int(s.Width) * components(t), true
+}
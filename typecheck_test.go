@@ -0,0 +1,181 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestModuleVerifyOperandTypes(t *testing.T) {
+	// A set of types and a constant of each. The instruction under test
+	// is appended to these.
+	decls := InstructionList{
+		&OpTypeVoid{ResultId: 1},
+		&OpTypeBool{ResultId: 2},
+		&OpTypeInt{ResultId: 3, Width: 32, Signedness: 1},
+		&OpTypeInt{ResultId: 4, Width: 32},
+		&OpTypeFloat{ResultId: 5, Width: 32},
+		&OpTypeFloat{ResultId: 6, Width: 64},
+		&OpTypeVector{ResultId: 7, ComponentType: 5, ComponentCount: 2},
+		&OpTypeVector{ResultId: 8, ComponentType: 5, ComponentCount: 3},
+		&OpTypeMatrix{ResultId: 9, ColumnType: 7, ColumnCount: 3},
+		&OpTypeVector{ResultId: 10, ComponentType: 2, ComponentCount: 2},
+		&OpTypeStruct{ResultId: 11, Members: []Id{4, 4}},
+		&OpTypeInt{ResultId: 12, Width: 64, Signedness: 1},
+		&OpTypeVector{ResultId: 13, ComponentType: 3, ComponentCount: 2},
+		&OpTypeVector{ResultId: 14, ComponentType: 6, ComponentCount: 3},
+		&OpTypeMatrix{ResultId: 15, ColumnType: 14, ColumnCount: 3},
+		&OpTypeMatrix{ResultId: 16, ColumnType: 8, ColumnCount: 3},
+
+		&OpConstant{ResultType: 3, ResultId: 20, Value: []uint32{1}},
+		&OpConstant{ResultType: 4, ResultId: 21, Value: []uint32{1}},
+		&OpConstant{ResultType: 5, ResultId: 22, Value: []uint32{0}},
+		&OpConstant{ResultType: 6, ResultId: 23, Value: []uint32{0, 0}},
+		&OpConstantComposite{ResultType: 7, ResultId: 24, Constituents: []Id{22, 22}},
+		&OpConstantComposite{ResultType: 8, ResultId: 25, Constituents: []Id{22, 22, 22}},
+		&OpConstantComposite{ResultType: 9, ResultId: 26, Constituents: []Id{24, 24, 24}},
+		&OpConstantTrue{ResultType: 2, ResultId: 27},
+		&OpConstantComposite{ResultType: 10, ResultId: 28, Constituents: []Id{27, 27}},
+		&OpConstant{ResultType: 12, ResultId: 29, Value: []uint32{1, 0}},
+		&OpConstantComposite{ResultType: 13, ResultId: 30, Constituents: []Id{20, 20}},
+		&OpConstantComposite{ResultType: 14, ResultId: 31, Constituents: []Id{23, 23, 23}},
+		&OpConstantComposite{ResultType: 15, ResultId: 32, Constituents: []Id{31, 31, 31}},
+		&OpConstantComposite{ResultType: 16, ResultId: 33, Constituents: []Id{25, 25, 25}},
+	}
+
+	for _, st := range []struct {
+		instr Instruction
+		want  string
+	}{
+		// Arithmetic instructions.
+		{&OpIAdd{ResultType: 3, ResultId: 50, Operand1: 20, Operand2: 21}, ""},
+		{&OpIAdd{ResultType: 13, ResultId: 50, Operand1: 30, Operand2: 30}, ""},
+		{&OpIAdd{ResultType: 5, ResultId: 50, Operand1: 22, Operand2: 22},
+			"OpIAdd: ResultType(5) must be a scalar or vector of int type; have float32"},
+		{&OpIAdd{ResultType: 3, ResultId: 50, Operand1: 20, Operand2: 22},
+			"OpIAdd: Operand2(22) must be a scalar or vector of int type; have float32"},
+		{&OpIAdd{ResultType: 3, ResultId: 50, Operand1: 29, Operand2: 20},
+			"OpIAdd: Operand1(29) must have the same component width as int32; have int64"},
+		{&OpIAdd{ResultType: 13, ResultId: 50, Operand1: 30, Operand2: 20},
+			"OpIAdd: Operand2(20) must have 2 component(s); have int32"},
+		{&OpUDiv{ResultType: 3, ResultId: 50, Operand1: 20, Operand2: 20},
+			"OpUDiv: ResultType(3) must have unsigned components; have int32"},
+		{&OpFMul{ResultType: 7, ResultId: 50, Operand1: 24, Operand2: 24}, ""},
+		{&OpFMul{ResultType: 7, ResultId: 50, Operand1: 24, Operand2: 25},
+			"OpFMul: Operand2(25) must have type vec2<float32>; have vec3<float32>"},
+		{&OpVectorTimesScalar{ResultType: 7, ResultId: 50, Vector: 24, Scalar: 22}, ""},
+		{&OpVectorTimesScalar{ResultType: 7, ResultId: 50, Vector: 24, Scalar: 23},
+			"OpVectorTimesScalar: Scalar(23) must have type float32; have float64"},
+		{&OpMatrixTimesScalar{ResultType: 9, ResultId: 50, Matrix: 26, Scalar: 22}, ""},
+		{&OpMatrixTimesVector{ResultType: 7, ResultId: 50, Matrix: 26, Vector: 25}, ""},
+		{&OpMatrixTimesVector{ResultType: 7, ResultId: 50, Matrix: 26, Vector: 24},
+			"OpMatrixTimesVector: Vector(24) must have 3 component(s); have vec2<float32>"},
+		{&OpVectorTimesMatrix{ResultType: 8, ResultId: 50, Vector: 24, Matrix: 26}, ""},
+		{&OpVectorTimesMatrix{ResultType: 7, ResultId: 50, Vector: 24, Matrix: 26},
+			"OpVectorTimesMatrix: Matrix(26) must have 2 column(s); have mat3<vec2<float32>>"},
+		{&OpMatrixTimesMatrix{ResultType: 9, ResultId: 50, LeftMatrix: 26, RightMatrix: 33}, ""},
+		{&OpMatrixTimesMatrix{ResultType: 9, ResultId: 50, LeftMatrix: 26, RightMatrix: 32},
+			"OpMatrixTimesMatrix: RightMatrix(32) must have components of type float32; have mat3<vec3<float64>>"},
+		{&OpOuterProduct{ResultType: 9, ResultId: 50, Vector1: 24, Vector2: 25}, ""},
+		{&OpDot{ResultType: 5, ResultId: 50, Vector1: 24, Vector2: 24}, ""},
+		{&OpDot{ResultType: 5, ResultId: 50, Vector1: 24, Vector2: 25},
+			"OpDot: Vector2(25) must have type vec2<float32>; have vec3<float32>"},
+		{&OpDot{ResultType: 6, ResultId: 50, Vector1: 24, Vector2: 24},
+			"OpDot: Vector1(24) must be a vector of float64; have vec2<float32>"},
+		{&OpIAddCarry{ResultType: 11, ResultId: 50, Operand1: 21, Operand2: 21}, ""},
+		{&OpIAddCarry{ResultType: 11, ResultId: 50, Operand1: 21, Operand2: 20},
+			"OpIAddCarry: Operand2(20) must have type uint32; have int32"},
+
+		// Conversion instructions.
+		{&OpConvertFToS{ResultType: 3, ResultId: 50, FloatValue: 22}, ""},
+		{&OpConvertFToS{ResultType: 3, ResultId: 50, FloatValue: 20},
+			"OpConvertFToS: FloatValue(20) must be a scalar or vector of float type; have int32"},
+		{&OpConvertFToS{ResultType: 13, ResultId: 50, FloatValue: 22},
+			"OpConvertFToS: FloatValue(22) must have 2 component(s); have float32"},
+		{&OpConvertFToU{ResultType: 3, ResultId: 50, FloatValue: 22},
+			"OpConvertFToU: ResultType(3) must have unsigned components; have int32"},
+		{&OpConvertSToF{ResultType: 5, ResultId: 50, SignedValue: 20}, ""},
+		{&OpSConvert{ResultType: 12, ResultId: 50, SignedValue: 20}, ""},
+		{&OpSConvert{ResultType: 3, ResultId: 50, SignedValue: 20},
+			"OpSConvert: SignedValue(20) must have a different component width than int32; have int32"},
+		{&OpFConvert{ResultType: 6, ResultId: 50, FloatValue: 22}, ""},
+		{&OpBitcast{ResultType: 7, ResultId: 50, Operand: 29}, ""},
+		{&OpBitcast{ResultType: 7, ResultId: 50, Operand: 20},
+			"OpBitcast: Operand(20) must have the same number of bits as vec2<float32>; have int32"},
+
+		// Relational and logical instructions.
+		{&OpFOrdLessThan{ResultType: 2, ResultId: 50, Operand1: 22, Operand2: 22}, ""},
+		{&OpFOrdLessThan{ResultType: 10, ResultId: 50, Operand1: 24, Operand2: 24}, ""},
+		{&OpFOrdLessThan{ResultType: 3, ResultId: 50, Operand1: 22, Operand2: 22},
+			"OpFOrdLessThan: ResultType(3) must be a scalar or vector of bool type; have int32"},
+		{&OpFOrdLessThan{ResultType: 2, ResultId: 50, Operand1: 24, Operand2: 24},
+			"OpFOrdLessThan: Operand1(24) must have 1 component(s); have vec2<float32>"},
+		{&OpFOrdLessThan{ResultType: 2, ResultId: 50, Operand1: 22, Operand2: 23},
+			"OpFOrdLessThan: Operand2(23) must have type float32; have float64"},
+		{&OpSLessThan{ResultType: 2, ResultId: 50, Operand1: 20, Operand2: 21}, ""},
+		{&OpSLessThan{ResultType: 2, ResultId: 50, Operand1: 20, Operand2: 29},
+			"OpSLessThan: Operand2(29) must have the same component width as int32; have int64"},
+		{&OpIsNan{ResultType: 10, ResultId: 50, X: 24}, ""},
+		{&OpAny{ResultType: 2, ResultId: 50, Vector: 28}, ""},
+		{&OpAny{ResultType: 2, ResultId: 50, Vector: 27},
+			"OpAny: Vector(27) must be a vector of bool type; have bool"},
+		{&OpLogicalNot{ResultType: 2, ResultId: 50, Operand: 28},
+			"OpLogicalNot: Operand(28) must have type bool; have vec2<bool>"},
+		{&OpSelect{ResultType: 7, ResultId: 50, Condition: 28, Object1: 24, Object2: 24}, ""},
+		{&OpSelect{ResultType: 7, ResultId: 50, Condition: 27, Object1: 24, Object2: 24}, ""},
+		{&OpSelect{ResultType: 5, ResultId: 50, Condition: 28, Object1: 22, Object2: 22},
+			"OpSelect: Condition(28) must be a bool scalar; have vec2<bool>"},
+
+		// Operands of unknown type are skipped.
+		{&OpIAdd{ResultType: 3, ResultId: 50, Operand1: 99, Operand2: 99}, ""},
+	} {
+		mod := NewModule()
+		mod.Code = append(decls[:len(decls):len(decls)], st.instr)
+
+		var have string
+		if err := mod.check((*Module).verifyOperandTypes); err != nil {
+			le, ok := err.(*LayoutError)
+			if !ok || le.Address != len(mod.Code)-1 {
+				t.Fatalf("%T: unexpected error: %v", st.instr, err)
+			}
+			have = le.Msg
+		}
+
+		if have != st.want {
+			t.Fatalf("%T: error mismatch:\nHave: %s\nWant: %s", st.instr, have, st.want)
+		}
+	}
+}
+
+func TestModuleVerifyOperandTypesLayout(t *testing.T) {
+	// Operand types are not checked if the types are not declared in
+	// order, as they could refer to themselves.
+	mod := NewModule()
+	mod.Header.Bound = 10
+	mod.Code = InstructionList{
+		&OpCapability{Capability: CapabilityShader},
+		&OpMemoryModel{AddressingModel: AddressingModelLogical, MemoryModel: MemoryModelGLSL450},
+		&OpEntryPoint{ExecutionModel: ExecutionModelGLCompute, EntryPoint: 5, Name: "main"},
+		&OpTypeVoid{ResultId: 1},
+		&OpTypeFunction{ResultId: 2, ReturnType: 1},
+		&OpTypeVector{ResultId: 3, ComponentType: 3, ComponentCount: 4},
+		&OpConstantNull{ResultType: 3, ResultId: 4},
+		&OpFunction{ResultType: 1, ResultId: 5, FunctionType: 2},
+		&OpLabel{ResultId: 6},
+		&OpFAdd{ResultType: 3, ResultId: 7, Operand1: 4, Operand2: 4},
+		&OpReturn{},
+		&OpFunctionEnd{},
+	}
+
+	var have []string
+	for _, d := range mod.VerifyAll() {
+		have = append(have, d.String())
+	}
+
+	want := []string{"error [2.4] at $00000005: OpTypeVector: ComponentType(3) is used before it is declared"}
+	if !reflect.DeepEqual(have, want) {
+		t.Fatalf("diagnostics mismatch:\nHave: %q\nWant: %q", have, want)
+	}
+}
@@ -0,0 +1,382 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TypeKind defines the kind of a type.
+type TypeKind uint8
+
+// Known type kinds. Each corresponds to one of the OpType* instructions.
+const (
+	TypeUnknown TypeKind = iota
+	TypeVoid
+	TypeBool
+	TypeInt
+	TypeFloat
+	TypeVector
+	TypeMatrix
+	TypeImage
+	TypeSampler
+	TypeSampledImage
+	TypeArray
+	TypeRuntimeArray
+	TypeStruct
+	TypeOpaque
+	TypePointer
+	TypeFunction
+	TypeEvent
+	TypeDeviceEvent
+	TypeReserveId
+	TypeQueue
+	TypePipe
+	TypePipeStorage
+	TypeNamedBarrier
+)
+
+var typeKindNames = [...]string{
+	TypeUnknown:      "unknown",
+	TypeVoid:         "void",
+	TypeBool:         "bool",
+	TypeInt:          "int",
+	TypeFloat:        "float",
+	TypeVector:       "vector",
+	TypeMatrix:       "matrix",
+	TypeImage:        "image",
+	TypeSampler:      "sampler",
+	TypeSampledImage: "sampled image",
+	TypeArray:        "array",
+	TypeRuntimeArray: "runtime array",
+	TypeStruct:       "struct",
+	TypeOpaque:       "opaque",
+	TypePointer:      "pointer",
+	TypeFunction:     "function",
+	TypeEvent:        "event",
+	TypeDeviceEvent:  "device event",
+	TypeReserveId:    "reserve id",
+	TypeQueue:        "queue",
+	TypePipe:         "pipe",
+	TypePipeStorage:  "pipe storage",
+	TypeNamedBarrier: "named barrier",
+}
+
+func (k TypeKind) String() string {
+	if int(k) < len(typeKindNames) {
+		return typeKindNames[k]
+	}
+	return fmt.Sprintf("TypeKind(%d)", k)
+}
+
+// Type defines a type declared in a module, with all references to other
+// types resolved. Types can refer to each other in cycles, through
+// forward declared pointers.
+//
+// Types may only refer to types declared before them. Struct members,
+// which may be forward declared pointers, are the exception. Other
+// references are left nil, so that malformed modules can not yield
+// cycles which do not pass through a struct.
+//
+// Only the fields relevant to the type's kind are set. The declaring
+// instruction holds all remaining details, like those of image types.
+type Type struct {
+	Id          Id
+	Kind        TypeKind
+	Instruction Instruction // The OpType* instruction declaring the type.
+
+	// Width is the bit width of Int and Float types.
+	Width uint32
+
+	// Signed is true for signed Int types.
+	Signed bool
+
+	// Elem is the component type of vectors, the column type of matrices,
+	// the element type of arrays, the pointee type of pointers, the sampled
+	// type of images and the image type of sampled images.
+	Elem *Type
+
+	// Len is the component count of vectors, the column count of matrices
+	// and the length of arrays. For arrays, it is read from the constant
+	// defining the length. It is zero if the length is not a constant
+	// or specialization constant with a known value.
+	Len int
+
+	// Members holds the member types of structs.
+	Members []*Type
+
+	// StorageClass is the storage class of pointers.
+	StorageClass StorageClass
+
+	// Return and Params define the signature of function types.
+	Return *Type
+	Params []*Type
+}
+
+// IsScalar returns true for Bool, Int and Float types.
+func (t *Type) IsScalar() bool {
+	return t.Kind == TypeBool || t.Kind == TypeInt || t.Kind == TypeFloat
+}
+
+// Scalar returns the scalar type of scalars, vectors and matrices.
+// Returns nil for all other types.
+func (t *Type) Scalar() *Type {
+	switch {
+	case t.IsScalar():
+		return t
+	case t.Kind == TypeVector, t.Kind == TypeMatrix:
+		if t.Elem != nil {
+			return t.Elem.Scalar()
+		}
+	}
+
+	return nil
+}
+
+// String returns a short, human readable description of the type.
+// Struct members are not included, as types can be recursive.
+func (t *Type) String() string {
+	if t == nil {
+		return "<nil>"
+	}
+
+	switch t.Kind {
+	case TypeInt:
+		if t.Signed {
+			return fmt.Sprintf("int%d", t.Width)
+		}
+		return fmt.Sprintf("uint%d", t.Width)
+	case TypeFloat:
+		return fmt.Sprintf("float%d", t.Width)
+	case TypeVector:
+		return fmt.Sprintf("vec%d<%v>", t.Len, t.Elem)
+	case TypeMatrix:
+		return fmt.Sprintf("mat%d<%v>", t.Len, t.Elem)
+	case TypeArray:
+		return fmt.Sprintf("[%d]%v", t.Len, t.Elem)
+	case TypeRuntimeArray:
+		return fmt.Sprintf("[]%v", t.Elem)
+	case TypeStruct:
+		return fmt.Sprintf("struct %%%d", t.Id)
+	case TypePointer:
+		return fmt.Sprintf("*%v", t.Elem)
+	case TypeFunction:
+		params := make([]string, len(t.Params))
+		for i, p := range t.Params {
+			params[i] = p.String()
+		}
+		return fmt.Sprintf("func(%s) %v", strings.Join(params, ", "), t.Return)
+	}
+
+	return t.Kind.String()
+}
+
+// TypeTable maps the Ids of a module to their types.
+// It is a snapshot; changes to the module are not reflected in it.
+type TypeTable struct {
+	types  map[Id]*Type
+	values map[Id]Id  // Result Id -> ResultType.
+	order  map[Id]int // Type Id -> Address of its declaration.
+}
+
+// Types builds a table of all types declared in the module, as well as the
+// types of all values it defines.
+func (m *Module) Types() *TypeTable {
+	tt := &TypeTable{
+		types:  make(map[Id]*Type),
+		values: make(map[Id]Id),
+		order:  make(map[Id]int),
+	}
+
+	// Create all types first, so that they can refer to each other
+	// regardless of declaration order.
+	for addr, instr := range m.Code {
+		if kind := typeKind(instr); kind != TypeUnknown {
+			id, _ := instructionResultId(instr)
+			tt.types[id] = &Type{Id: id, Kind: kind, Instruction: instr}
+			tt.order[id] = addr
+			continue
+		}
+
+		id, ok := instructionResultId(instr)
+		typ, tok := instructionResultType(instr)
+		if ok && tok {
+			tt.values[id] = typ
+		}
+	}
+
+	constants := m.constantValues()
+
+	for _, t := range tt.types {
+		tt.resolve(t, constants)
+	}
+
+	return tt
+}
+
+// resolve fills in the details of t, from its declaring instruction.
+func (tt *TypeTable) resolve(t *Type, constants map[Id]uint64) {
+	// References to types which are not declared before t are dropped,
+	// other than those to pointers from struct members.
+	ref := func(id Id) *Type {
+		if tt.order[id] >= tt.order[t.Id] {
+			return nil
+		}
+		return tt.types[id]
+	}
+
+	switch v := t.Instruction.(type) {
+	case *OpTypeInt:
+		t.Width = v.Width
+		t.Signed = v.Signedness != 0
+	case *OpTypeFloat:
+		t.Width = v.Width
+	case *OpTypeVector:
+		t.Elem = ref(v.ComponentType)
+		t.Len = int(v.ComponentCount)
+	case *OpTypeMatrix:
+		t.Elem = ref(v.ColumnType)
+		t.Len = int(v.ColumnCount)
+	case *OpTypeImage:
+		t.Elem = ref(v.SampledType)
+	case *OpTypeSampledImage:
+		t.Elem = ref(v.ImageType)
+	case *OpTypeArray:
+		t.Elem = ref(v.ElementType)
+		t.Len = int(constants[v.Length])
+	case *OpTypeRuntimeArray:
+		t.Elem = ref(v.ElementType)
+	case *OpTypeStruct:
+		t.Members = make([]*Type, len(v.Members))
+		for i, id := range v.Members {
+			t.Members[i] = ref(id)
+			if m := tt.types[id]; m != nil && m.Kind == TypePointer {
+				t.Members[i] = m
+			}
+		}
+	case *OpTypePointer:
+		t.Elem = ref(v.Type)
+		t.StorageClass = v.StorageClass
+	case *OpTypeFunction:
+		t.Return = ref(v.ReturnType)
+		t.Params = make([]*Type, len(v.Parameters))
+		for i, id := range v.Parameters {
+			t.Params[i] = ref(id)
+		}
+	}
+}
+
+// Type returns the type declared with the given Id.
+func (tt *TypeTable) Type(id Id) (*Type, bool) {
+	t, ok := tt.types[id]
+	return t, ok
+}
+
+// ResultType returns the Id of the ResultType of the value with the
+// given Id.
+func (tt *TypeTable) ResultType(id Id) (Id, bool) {
+	typ, ok := tt.values[id]
+	return typ, ok
+}
+
+// TypeOf returns the type of the value with the given Id.
+func (tt *TypeTable) TypeOf(id Id) (*Type, bool) {
+	t, ok := tt.types[tt.values[id]]
+	return t, ok
+}
+
+// Ids returns the Ids of all declared types, in ascending order.
+func (tt *TypeTable) Ids() []Id {
+	out := make([]Id, 0, len(tt.types))
+	for id := range tt.types {
+		out = append(out, id)
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// typeKind returns the kind of type declared by the given instruction.
+// Returns TypeUnknown if it declares no type.
+func typeKind(i Instruction) TypeKind {
+	switch i.(type) {
+	case *OpTypeVoid:
+		return TypeVoid
+	case *OpTypeBool:
+		return TypeBool
+	case *OpTypeInt:
+		return TypeInt
+	case *OpTypeFloat:
+		return TypeFloat
+	case *OpTypeVector:
+		return TypeVector
+	case *OpTypeMatrix:
+		return TypeMatrix
+	case *OpTypeImage:
+		return TypeImage
+	case *OpTypeSampler:
+		return TypeSampler
+	case *OpTypeSampledImage:
+		return TypeSampledImage
+	case *OpTypeArray:
+		return TypeArray
+	case *OpTypeRuntimeArray:
+		return TypeRuntimeArray
+	case *OpTypeStruct:
+		return TypeStruct
+	case *OpTypeOpaque:
+		return TypeOpaque
+	case *OpTypePointer:
+		return TypePointer
+	case *OpTypeFunction:
+		return TypeFunction
+	case *OpTypeEvent:
+		return TypeEvent
+	case *OpTypeDeviceEvent:
+		return TypeDeviceEvent
+	case *OpTypeReserveId:
+		return TypeReserveId
+	case *OpTypeQueue:
+		return TypeQueue
+	case *OpTypePipe:
+		return TypePipe
+	case *OpTypePipeStorage:
+		return TypePipeStorage
+	case *OpTypeNamedBarrier:
+		return TypeNamedBarrier
+	}
+
+	return TypeUnknown
+}
+
+// constantValues returns the values of all integer constants and the
+// default values of integer specialization constants in the module.
+func (m *Module) constantValues() map[Id]uint64 {
+	out := make(map[Id]uint64)
+
+	for _, instr := range m.Code {
+		var id Id
+		var value []uint32
+
+		switch v := instr.(type) {
+		case *OpConstant:
+			id, value = v.ResultId, v.Value
+		case *OpSpecConstant:
+			id, value = v.ResultId, v.Value
+		default:
+			continue
+		}
+
+		switch len(value) {
+		case 1:
+			out[id] = uint64(value[0])
+		case 2:
+			out[id] = uint64(value[0]) | uint64(value[1])<<32
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,144 @@
+// This file is subject to a 1-clause BSD license.
+// Its contents can be found in the enclosed LICENSE file.
+
+package spirv
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestModuleTypes(t *testing.T) {
+	mod := NewModule()
+	mod.Code = InstructionList{
+		&OpTypeForwardPointer{PointerType: 12, StorageClass: StorageClassPhysicalStorageBuffer},
+		&OpTypeVoid{ResultId: 1},
+		&OpTypeInt{ResultId: 2, Width: 32, Signedness: 1},
+		&OpTypeInt{ResultId: 3, Width: 64},
+		&OpTypeFloat{ResultId: 4, Width: 32},
+		&OpTypeVector{ResultId: 5, ComponentType: 4, ComponentCount: 4},
+		&OpTypeMatrix{ResultId: 6, ColumnType: 5, ColumnCount: 3},
+		&OpConstant{ResultType: 3, ResultId: 7, Value: []uint32{16, 0}},
+		&OpTypeArray{ResultId: 8, ElementType: 6, Length: 7},
+		&OpTypeRuntimeArray{ResultId: 9, ElementType: 2},
+		&OpTypeStruct{ResultId: 10, Members: []Id{8, 12, 9}},
+		&OpTypePointer{ResultId: 11, StorageClass: StorageClassUniform, Type: 10},
+		&OpTypePointer{ResultId: 12, StorageClass: StorageClassPhysicalStorageBuffer, Type: 10},
+		&OpTypeFunction{ResultId: 13, ReturnType: 1, Parameters: []Id{11, 2}},
+		&OpVariable{ResultType: 11, ResultId: 14, StorageClass: StorageClassUniform},
+		&OpFunction{ResultType: 1, ResultId: 15, FunctionType: 13},
+		&OpFunctionParameter{ResultType: 11, ResultId: 16},
+		&OpFunctionParameter{ResultType: 2, ResultId: 17},
+		&OpLabel{ResultId: 18},
+		&OpReturn{},
+		&OpFunctionEnd{},
+	}
+
+	tt := mod.Types()
+
+	if have, want := tt.Ids(), []Id{1, 2, 3, 4, 5, 6, 8, 9, 10, 11, 12, 13}; !reflect.DeepEqual(have, want) {
+		t.Fatalf("Ids mismatch:\nHave: %v\nWant: %v", have, want)
+	}
+
+	for _, st := range []struct {
+		id   Id
+		kind TypeKind
+		str  string
+	}{
+		{1, TypeVoid, "void"},
+		{2, TypeInt, "int32"},
+		{3, TypeInt, "uint64"},
+		{4, TypeFloat, "float32"},
+		{5, TypeVector, "vec4<float32>"},
+		{6, TypeMatrix, "mat3<vec4<float32>>"},
+		{8, TypeArray, "[16]mat3<vec4<float32>>"},
+		{9, TypeRuntimeArray, "[]int32"},
+		{10, TypeStruct, "struct %10"},
+		{11, TypePointer, "*struct %10"},
+		{13, TypeFunction, "func(*struct %10, int32) void"},
+	} {
+		typ, ok := tt.Type(st.id)
+		if !ok {
+			t.Fatalf("%%%d: type not found", st.id)
+		}
+
+		if typ.Kind != st.kind || typ.String() != st.str {
+			t.Fatalf("%%%d: type mismatch:\nHave: %v %q\nWant: %v %q",
+				st.id, typ.Kind, typ, st.kind, st.str)
+		}
+	}
+
+	// Structs can refer to themselves through forward declared pointers.
+	st, _ := tt.Type(10)
+	if len(st.Members) != 3 || st.Members[1].Elem != st {
+		t.Fatalf("recursive struct not resolved: %+v", st.Members)
+	}
+
+	if st.Members[1].StorageClass != StorageClassPhysicalStorageBuffer {
+		t.Fatalf("storage class mismatch: %v", st.Members[1].StorageClass)
+	}
+
+	mat, _ := tt.Type(6)
+	if scalar := mat.Scalar(); scalar == nil || scalar.Id != 4 {
+		t.Fatalf("scalar type mismatch: %v", scalar)
+	}
+
+	for _, st := range []struct {
+		value Id
+		typ   Id
+	}{
+		{7, 3},
+		{14, 11},
+		{15, 1},
+		{16, 11},
+		{17, 2},
+	} {
+		have, ok := tt.ResultType(st.value)
+		if !ok || have != st.typ {
+			t.Fatalf("%%%d: result type mismatch:\nHave: %d\nWant: %d", st.value, have, st.typ)
+		}
+
+		typ, ok := tt.TypeOf(st.value)
+		if !ok || typ.Id != st.typ {
+			t.Fatalf("%%%d: TypeOf mismatch:\nHave: %v\nWant: %d", st.value, typ, st.typ)
+		}
+	}
+
+	if _, ok := tt.ResultType(18); ok {
+		t.Fatalf("labels have no result type")
+	}
+}
+
+func TestModuleTypesCyclic(t *testing.T) {
+	// Malformed modules can declare types in terms of themselves, or of
+	// types which follow them.
+	mod := NewModule()
+	mod.Header.Bound = 10
+	mod.Code = InstructionList{
+		&OpTypeVector{ResultId: 5, ComponentType: 5, ComponentCount: 4},
+		&OpTypeMatrix{ResultId: 6, ColumnType: 7, ColumnCount: 2},
+		&OpTypeVector{ResultId: 7, ComponentType: 6, ComponentCount: 2},
+		&OpFAdd{ResultType: 5, ResultId: 8, Operand1: 9, Operand2: 9},
+	}
+
+	tt := mod.Types()
+
+	for _, st := range []struct {
+		id  Id
+		str string
+	}{
+		{5, "vec4<<nil>>"},
+		{6, "mat2<<nil>>"},
+		{7, "vec2<mat2<<nil>>>"},
+	} {
+		typ, _ := tt.Type(st.id)
+		if typ.String() != st.str || typ.Scalar() != nil {
+			t.Fatalf("%%%d: type mismatch:\nHave: %q %v\nWant: %q", st.id, typ, typ.Scalar(), st.str)
+		}
+	}
+
+	// This used to overflow the stack.
+	if len(mod.VerifyAll()) == 0 {
+		t.Fatalf("expected diagnostics")
+	}
+}
@@ -3,144 +3,7 @@
 
 package spirv
 
-import (
-	"bytes"
-	"reflect"
-	"regexp"
-)
-
-// flags defining regular expression properties.
-//
-// They are defined in the upper 16 bit range, as not to
-// be confused for actual opcodes, which are all set in the
-// lower 16-bit range.
-const (
-	regSingleOptional = (1 << 16) + iota
-	regMultiOptional
-	regAtleastOne
-	regBeginGroup
-	regEndGroup
-	regOr
-	regAny
-)
-
-// layoutPattern defines the order in which instructions must appear in a valid
-// SPIR-V module. We use a regex pattern to match against sequences of
-// instruction opcodes, which are encoded in the pattern as 32-bit unicode
-// code points.
-var regLayoutPattern *regexp.Regexp
-
-// construct the layout regex pattern.
-func init() {
-	pattern := []rune{
-		opcodeSource, regSingleOptional,
-		opcodeSourceExtension, regMultiOptional,
-		opcodeCompileFlag, regMultiOptional,
-		opcodeExtension, regMultiOptional,
-		opcodeExtInstImport, regMultiOptional,
-
-		opcodeMemoryModel,
-
-		opcodeEntryPoint, regMultiOptional,
-		opcodeExecutionMode, regAtleastOne,
-		opcodeString, regMultiOptional,
-		opcodeName, regMultiOptional,
-		opcodeMemberName, regMultiOptional,
-		opcodeLine, regMultiOptional,
-
-		regBeginGroup,
-		opcodeDecorate, regOr,
-		opcodeMemberDecorate, regOr,
-		opcodeGroupDecorate, regOr,
-		opcodeGroupMemberDecorate, regOr,
-		opcodeDecorationGroup,
-		regEndGroup, regMultiOptional,
-
-		regBeginGroup,
-		opcodeTypeArray, regOr,
-		opcodeTypeBool, regOr,
-		opcodeTypeDeviceEvent, regOr,
-		opcodeTypeEvent, regOr,
-		opcodeTypeFilter, regOr,
-		opcodeTypeFloat, regOr,
-		opcodeTypeFunction, regOr,
-		opcodeTypeInt, regOr,
-		opcodeTypeMatrix, regOr,
-		opcodeTypeOpaque, regOr,
-		opcodeTypePipe, regOr,
-		opcodeTypePointer, regOr,
-		opcodeTypeQueue, regOr,
-		opcodeTypeReserveId, regOr,
-		opcodeTypeRuntimeArray, regOr,
-		opcodeTypeSampler, regOr,
-		opcodeTypeStruct, regOr,
-		opcodeTypeVector, regOr,
-		opcodeTypeVoid, regOr,
-		opcodeConstant, regOr,
-		opcodeConstantComposite, regOr,
-		opcodeConstantFalse, regOr,
-		opcodeConstantNullObject, regOr,
-		opcodeConstantNullPointer, regOr,
-		opcodeConstantSampler, regOr,
-		opcodeConstantTrue, regOr,
-		opcodeVariable, // Can be only global -- Storage Class != Function
-		regEndGroup, regMultiOptional,
-
-		// Function
-		regBeginGroup,
-		opcodeFunction,
-		opcodeFunctionParameter, regMultiOptional,
-
-		// Block
-		regBeginGroup,
-		opcodeLabel,
-		regAny, regMultiOptional,
-		opcodeBranch,
-		regEndGroup, regMultiOptional,
-
-		opcodeFunctionEnd,
-		regEndGroup, regAtleastOne,
-	}
-
-	// Assemble the pattern into a list of unicode code points,
-	// ready to be read by the regex parser.
-	out := make([]rune, 0, len(pattern))
-
-	for _, value := range pattern {
-		switch value {
-		case regSingleOptional:
-			out = append(out, '?')
-
-		case regMultiOptional:
-			out = append(out, '*')
-
-		case regAtleastOne:
-			out = append(out, '+')
-
-		case regBeginGroup:
-			out = append(out, '(')
-
-		case regEndGroup:
-			out = append(out, ')')
-
-		case regOr:
-			out = append(out, '|')
-
-		case regAny:
-			out = append(out, '.')
-
-		default:
-			// We add 0xff to each opcode, because we want to prevent the
-			// possibility that an opcode is treated as an ASCII character.
-			// It could be interpreted as a special regex marker like ?, +, *, etc,
-			// which is not ideal.
-			out = append(out, 0xff+value)
-		}
-	}
-
-	// The pattern has to be applied to the entire input slice, not just a subset.
-	regLayoutPattern = regexp.MustCompile("^" + string(out) + "$")
-}
+import "reflect"
 
 // Verifiable defines any type which implements verification semantics.
 // This may entail simple range checks on numeric fields and constants, or
@@ -166,6 +29,11 @@ func verifyInstruction(i Instruction) error {
 
 func verifyValue(rv reflect.Value) error {
 	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return nil // Absent optional operand.
+		}
+		return verifyValue(rv.Elem())
 	case reflect.Struct:
 		return verifyStruct(rv)
 	case reflect.Slice:
@@ -219,7 +87,11 @@ func verifyFunctionStructure(set InstructionList) error {
 
 		// Find all block ranges inside a function.
 		bstart := set[fs:fe].FilterIndex(opcodeLabel, fs)
-		bend := set[fs:fe].FilterIndex(opcodeBranch, fs)
+		bend := set[fs:fe].terminatorIndex(fs)
+
+		if len(bstart) != len(bend) {
+			return nil // Caught by verifyLayoutSections.
+		}
 
 		for j, bs := range bstart {
 			be := bend[j]
@@ -236,12 +108,16 @@ func verifyFunctionStructure(set InstructionList) error {
 			}
 
 			// OpVariable instructions in the first block must be the first
-			// instructions in this block.
+			// instructions in this block. OpLine and OpNoLine may be
+			// interleaved with them.
 			var haveVar bool
-			for k := be; k >= bs; k-- {
-				if set[k].Opcode() == opcodeVariable {
+			for k := be; k > bs; k-- {
+				switch set[k].Opcode() {
+				case opcodeVariable:
 					haveVar = true
 					continue
+				case opcodeLine, opcodeNoLine:
+					continue
 				}
 
 				if haveVar {
@@ -258,8 +134,8 @@ func verifyFunctionStructure(set InstructionList) error {
 // verifyGlobalVariables checks the storage class of global variables.
 func verifyGlobalVariables(set InstructionList) error {
 	for _, i := range set.globalVariables() {
-		v := set[i].(*OpVariable)
-		if v.StorageClass == StorageClassFunction {
+		v, ok := set[i].(*OpVariable)
+		if ok && v.StorageClass == StorageClassFunction {
 			return NewLayoutError(i, "global variable: storage class can not be StorageClassFunction")
 		}
 	}
@@ -267,44 +143,56 @@ func verifyGlobalVariables(set InstructionList) error {
 	return nil
 }
 
-// verifyLocalVariables checks the storage class of local variables.
-func verifyLocalVariables(set InstructionList) error {
-	for _, i := range set.localVariables() {
-		v := set[i].(*OpVariable)
-		if v.StorageClass != StorageClassFunction {
-			return NewLayoutError(i, "local variable: storage class must be StorageClassFunction")
+// verifyTypeDeclarations checks that types only refer to types declared
+// before them. Pointers declared by OpTypeForwardPointer can be referred
+// to ahead of their OpTypePointer.
+func verifyTypeDeclarations(set InstructionList) error {
+	types := make(map[Id]bool)
+	for _, instr := range set {
+		if typeKind(instr) != TypeUnknown {
+			id, _ := instructionResultId(instr)
+			types[id] = true
 		}
 	}
 
-	return nil
-}
+	declared := make(map[Id]bool)
 
-// verifyLayoutPattern turns the code into a regular expression input,
-// which is tested for instruction ordering.
-//
-// FIXME: We're using the standard regexp package here and we treat the
-// instruction set as a sequence of runes. We may want to investigate writing a
-// custom regex parser; specifically for the limited subset of functionality we
-// need and which operates on ints instead a string.
-// Refer to http://swtch.com/~rsc/regexp/regexp2.html for inspiration.
-//
-// FIXME: This approach can only tell us if the structure is valid or not.
-// It will not give us any context as to which instructions are wrong when
-// the check fails. This is not useful at all.
-func verifyLayoutPattern(set InstructionList) error {
-	// Turn all instruction opcodes into a list of runes.
-	var input bytes.Buffer
-
-	for _, v := range set {
-		// We add 0xff to each opcode, because we want to prevent the
-		// possibility that an opcode is treated as an ASCII character.
-		// It could be interpreted as a special regex marker like ?, +, *, etc,
-		// which is not ideal.
-		input.WriteRune(0xff + rune(v.Opcode()))
+	for addr, instr := range set {
+		if fp, ok := instr.(*OpTypeForwardPointer); ok {
+			declared[fp.PointerType] = true
+			continue
+		}
+
+		if typeKind(instr) == TypeUnknown {
+			continue
+		}
+
+		var err error
+		forEachId(instr, func(field string, id *Id) {
+			if err == nil && field != "ResultId" && types[*id] && !declared[*id] {
+				err = NewLayoutError(addr, "%s: %s(%d) is used before it is declared",
+					instructionName(instr), field, *id)
+			}
+		})
+
+		if err != nil {
+			return err
+		}
+
+		id, _ := instructionResultId(instr)
+		declared[id] = true
 	}
 
-	if !regLayoutPattern.MatchReader(&input) {
-		return NewLayoutError(0, "logical structure for the module is invalid")
+	return nil
+}
+
+// verifyLocalVariables checks the storage class of local variables.
+func verifyLocalVariables(set InstructionList) error {
+	for _, i := range set.localVariables() {
+		v, ok := set[i].(*OpVariable)
+		if ok && v.StorageClass != StorageClassFunction {
+			return NewLayoutError(i, "local variable: storage class must be StorageClassFunction")
+		}
 	}
 
 	return nil